@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"os"
 	"time"
 
@@ -12,21 +14,31 @@ import (
 	"github.com/Attamusc/weekly-report-cli/internal/ai"
 	"github.com/Attamusc/weekly-report-cli/internal/config"
 	"github.com/Attamusc/weekly-report-cli/internal/github"
+	"github.com/Attamusc/weekly-report-cli/internal/httpclient"
 	"github.com/Attamusc/weekly-report-cli/internal/input"
+	"github.com/Attamusc/weekly-report-cli/internal/logging"
 	"github.com/Attamusc/weekly-report-cli/internal/pipeline"
+	"github.com/Attamusc/weekly-report-cli/internal/progress"
 	"github.com/Attamusc/weekly-report-cli/internal/projects"
+	"github.com/Attamusc/weekly-report-cli/internal/report"
+	"github.com/Attamusc/weekly-report-cli/internal/throttle"
 	githubapi "github.com/google/go-github/v66/github"
 )
 
 // projectFlags holds project-related flag values shared across commands.
 type projectFlags struct {
-	URL         string
-	Field       string
-	FieldValues string
-	IncludePRs  bool
-	MaxItems    int
-	View        string
-	ViewID      string
+	URL          string
+	Field        string
+	FieldValues  string
+	IncludePRs   bool
+	MaxItems     int
+	View         string
+	ViewID       string
+	CacheTTL     time.Duration
+	NoCache      bool
+	FieldAlias   []string
+	Strict       bool
+	UpdatedSince string
 }
 
 // addProjectFlags registers project-related flags on a cobra command and returns
@@ -40,36 +52,106 @@ func addProjectFlags(cmd *cobra.Command) *projectFlags {
 	cmd.Flags().IntVar(&pf.MaxItems, "project-max-items", 100, "Maximum number of items to fetch from project board")
 	cmd.Flags().StringVar(&pf.View, "project-view", "", "GitHub project view name (e.g., 'Blocked Items')")
 	cmd.Flags().StringVar(&pf.ViewID, "project-view-id", "", "GitHub project view ID (e.g., 'PVT_kwDOABCDEF') - takes precedence over --project-view")
+	cmd.Flags().DurationVar(&pf.CacheTTL, "cache-ttl", 0, "Reuse project board items cached on disk for this long (e.g., '10m'); 0 disables")
+	cmd.Flags().BoolVar(&pf.NoCache, "no-cache", false, "Bypass the project board item cache")
+	cmd.Flags().StringArrayVar(&pf.FieldAlias, "field-alias", nil, "Treat a field value as an alias of another, e.g. 'In-Progress=In Progress' (repeatable)")
+	cmd.Flags().StringVar(&pf.UpdatedSince, "project-updated-since", "", "Only fetch project items updated on or after this date (YYYY-MM-DD), filtered by GitHub's 'updated:' qualifier")
+	cmd.Flags().BoolVar(&pf.Strict, "strict-fields", false, "Fail when a project item has two values for the same field (default: the last one wins)")
 	return pf
 }
 
+// fieldValues returns the parsed --project-field-values. When a view is selected
+// and neither field flag was set explicitly, the defaults are dropped so the view's
+// own filter decides which items are included.
+func (pf *projectFlags) fieldValues(cmd *cobra.Command) []string {
+	usingView := pf.View != "" || pf.ViewID != ""
+	if usingView && !cmd.Flags().Changed("project-field") && !cmd.Flags().Changed("project-field-values") {
+		return nil
+	}
+	return input.ParseFieldValues(pf.FieldValues)
+}
+
+// repoFilterFlags holds repository allow/deny list flag values shared across commands.
+type repoFilterFlags struct {
+	Allowlist string
+	Denylist  string
+}
+
+// addRepoFilterFlags registers repository filter flags on a cobra command.
+func addRepoFilterFlags(cmd *cobra.Command) *repoFilterFlags {
+	rf := &repoFilterFlags{}
+	cmd.Flags().StringVar(&rf.Allowlist, "repo-allowlist", "", "Comma-separated owner/repo list; issues from other repositories are skipped")
+	cmd.Flags().StringVar(&rf.Denylist, "repo-denylist", "", "Comma-separated owner/repo list of repositories to skip")
+	return rf
+}
+
+// addReportKeyFlags registers the report data key name flags on a cobra command
+// and returns the names that will be populated when the command runs.
+func addReportKeyFlags(cmd *cobra.Command) *report.KeyNames {
+	names := &report.KeyNames{}
+	defaults := report.DefaultKeyNames
+	cmd.Flags().StringVar(&names.Marker, "report-marker-key", defaults.Marker, "Data key whose value=\"true\" marks a comment as a report")
+	cmd.Flags().StringVar(&names.Trending, "trending-key", defaults.Trending, "Data key holding a report's status")
+	cmd.Flags().StringVar(&names.TargetDate, "target-date-key", defaults.TargetDate, "Data key holding a report's target date")
+	cmd.Flags().StringVar(&names.Update, "update-key", defaults.Update, "Data key holding a report's update text")
+	return names
+}
+
 // commandDeps holds initialized dependencies shared by generate and describe commands.
 type commandDeps struct {
 	Ctx        context.Context
+	Cancel     context.CancelFunc // Releases the run deadline; callers must defer it
 	Cfg        *config.Config
 	Logger     *slog.Logger
 	Fetcher    pipeline.IssueFetcher
 	Summarizer ai.Summarizer
 	IssueRefs  []input.IssueRef
+	Throttle   *throttle.Throttle // Collection permits, narrowed when GitHub rate limits the client
+
+	ProjectTitle string // Title of the project board, when one was used
 }
 
 // setupCommand initializes shared dependencies from config input and resolver config.
 // Returns config.ErrNoRows if no issue references are found.
 func setupCommand(cfgInput config.ConfigInput, resolverCfg input.ResolverConfig) (*commandDeps, error) {
-	ctx := context.Background()
-
 	cfg, err := config.FromEnvAndFlags(cfgInput)
 	if err != nil {
 		return nil, fmt.Errorf("configuration error: %w", err)
 	}
+	resolverCfg.GitHubHosts = cfg.EnterpriseHosts()
+
+	// Bound the whole run when --timeout is set; per-request timeouts still apply inside it
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if cfg.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(context.Background(), cfg.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	deps, err := setupCommandDeps(ctx, cfg, resolverCfg)
+	if err != nil {
+		cancel()
+		if timeoutErr := checkRunTimeout(ctx, cfg.Timeout); timeoutErr != nil {
+			return nil, timeoutErr
+		}
+		return nil, err
+	}
+	deps.Cancel = cancel
+	return deps, nil
+}
 
+// setupCommandDeps resolves issue references and builds clients under the run context.
+func setupCommandDeps(ctx context.Context, cfg *config.Config, resolverCfg input.ResolverConfig) (*commandDeps, error) {
 	logger := setupLogger(cfg)
 	ctx = context.WithValue(ctx, input.LoggerContextKey{}, logger)
 
+	// One proxy-aware transport is shared by every API client
+	transport := httpclient.NewTransport(cfg.Proxy)
+
 	var projectClient *projectClientAdapter
 	if cfg.Project.URL != "" {
 		logger.Debug("Initializing project client")
-		projectClient = &projectClientAdapter{token: cfg.GitHubToken, logger: logger}
+		projectClient = &projectClientAdapter{token: cfg.GitHubToken, logger: logger, cacheTTL: cfg.Project.CacheTTL, transport: transport}
 	}
 
 	logger.Info("Resolving issue references...")
@@ -78,6 +160,18 @@ func setupCommand(cfgInput config.ConfigInput, resolverCfg input.ResolverConfig)
 		return nil, fmt.Errorf("failed to resolve issue references: %w", err)
 	}
 
+	logger.Debug("Initializing GitHub client")
+	collectThrottle := throttle.New(cfg.Concurrency, logger)
+	fetcher, err := newGitHubFetcher(ctx, cfg, collectThrottle.Transport(transport))
+	if err != nil {
+		return nil, err
+	}
+
+	if resolverCfg.ExpandSubIssues {
+		logger.Info("Expanding sub-issues...")
+		issueRefs = input.ExpandSubIssues(ctx, resolverCfg, issueRefs, fetcher)
+	}
+
 	if len(issueRefs) == 0 {
 		if !cfg.Quiet {
 			fmt.Fprintf(os.Stderr, "No valid GitHub issue URLs found\n")
@@ -87,25 +181,39 @@ func setupCommand(cfgInput config.ConfigInput, resolverCfg input.ResolverConfig)
 
 	logger.Info("Found GitHub issues", "count", len(issueRefs))
 
-	logger.Debug("Initializing GitHub client")
-	fetcher := &githubFetcher{client: github.New(ctx, cfg.GitHubToken)}
-	summarizer := initSummarizer(cfg, logger)
+	summarizer := initSummarizer(cfg, logger, transport)
 
-	return &commandDeps{
+	deps := &commandDeps{
 		Ctx:        ctx,
 		Cfg:        cfg,
 		Logger:     logger,
 		Fetcher:    fetcher,
 		Summarizer: summarizer,
 		IssueRefs:  issueRefs,
-	}, nil
+		Throttle:   collectThrottle,
+	}
+	if projectClient != nil {
+		deps.ProjectTitle = projectClient.title
+	}
+	return deps, nil
+}
+
+// checkRunTimeout returns config.ErrRunTimedOut if the run deadline has passed.
+func checkRunTimeout(ctx context.Context, timeout time.Duration) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w after %s", config.ErrRunTimedOut, timeout)
+	}
+	return nil
 }
 
 // projectClientAdapter adapts the projects.Client to the input.ProjectClient interface.
 // This avoids circular dependencies between packages.
 type projectClientAdapter struct {
-	token  string
-	logger *slog.Logger
+	token     string
+	logger    *slog.Logger
+	cacheTTL  time.Duration
+	transport http.RoundTripper
+	title     string // Set from the fetched project
 }
 
 // FetchProjectItems implements input.ProjectClient interface
@@ -118,21 +226,41 @@ func (a *projectClientAdapter) FetchProjectItems(ctx context.Context, resolverCf
 
 	// Create project config
 	projectCfg := projects.ProjectConfig{
-		Ref:      projectRef,
-		ViewName: resolverCfg.ProjectView,
-		ViewID:   resolverCfg.ProjectViewID,
-		FieldFilters: []projects.FieldFilter{
+		Ref:          projectRef,
+		ViewName:     resolverCfg.ProjectView,
+		ViewID:       resolverCfg.ProjectViewID,
+		IncludePRs:   resolverCfg.ProjectIncludePRs,
+		MaxItems:     resolverCfg.ProjectMaxItems,
+		StrictFields: resolverCfg.ProjectStrict,
+		UpdatedSince: resolverCfg.ProjectUpdatedSince,
+	}
+
+	aliases, err := projects.ParseFieldAliases(resolverCfg.ProjectFieldAlias)
+	if err != nil {
+		return nil, err
+	}
+	projectCfg.FieldAliases = aliases
+
+	if len(resolverCfg.ProjectFieldValues) > 0 {
+		projectCfg.FieldFilters = []projects.FieldFilter{
 			{
 				FieldName: resolverCfg.ProjectFieldName,
 				Values:    resolverCfg.ProjectFieldValues,
 			},
-		},
-		IncludePRs: resolverCfg.ProjectIncludePRs,
-		MaxItems:   resolverCfg.ProjectMaxItems,
+		}
 	}
 
 	// Create projects client and fetch items
 	client := projects.NewClient(a.token)
+	client.SetTransport(a.transport)
+	if a.cacheTTL > 0 {
+		cacheDir, err := projects.DefaultCacheDir()
+		if err != nil {
+			a.logger.Warn("Project cache disabled", "error", err)
+		} else {
+			client.SetCache(projects.NewCache(cacheDir, a.cacheTTL))
+		}
+	}
 	projectItems, err := client.FetchProjectItems(ctx, projectCfg)
 	if err != nil {
 		return nil, err
@@ -141,48 +269,104 @@ func (a *projectClientAdapter) FetchProjectItems(ctx context.Context, resolverCf
 	// Extract issue refs from filtered items
 	var issueRefs []input.IssueRef
 	for _, item := range projectItems {
-		if item.IssueRef != nil {
-			ref := *item.IssueRef
-			if len(item.FieldValues) > 0 {
-				ref.FieldValues = make(map[string]string, len(item.FieldValues))
-				for k, v := range item.FieldValues {
-					ref.FieldValues[k] = v.String()
-				}
-			}
-			issueRefs = append(issueRefs, ref)
+		if ref := item.IssueRefWithFields(); ref != nil {
+			issueRefs = append(issueRefs, *ref)
 		}
 	}
 
-	a.logger.Info("Project items fetched and filtered", "project", projectRef.String(), "items", len(issueRefs))
+	a.title = client.ProjectTitle()
+	a.logger.Info("Project items fetched and filtered", "project", projectRef.String(), "title", a.title, "items", len(issueRefs))
 
 	return issueRefs, nil
 }
 
-// githubFetcher wraps a *github.Client to implement pipeline.IssueFetcher.
+// githubFetcher wraps GitHub clients to implement pipeline.IssueFetcher.
+// Refs on a GitHub Enterprise Server host are fetched with that host's client.
 type githubFetcher struct {
-	client *githubapi.Client
+	clients         *github.Clients
+	maxCommentPages int // 0 = fetch every page
+}
+
+// newGitHubFetcher builds the github.com client plus one client per
+// configured GitHub Enterprise Server host, all sending through transport
+func newGitHubFetcher(ctx context.Context, cfg *config.Config, transport http.RoundTripper) (*githubFetcher, error) {
+	clients, err := github.NewClients(ctx, cfg.GitHubToken, cfg.HostTokens, transport)
+	if err != nil {
+		return nil, err
+	}
+	return &githubFetcher{clients: clients, maxCommentPages: cfg.MaxCommentPages}, nil
+}
+
+// clientFor returns the client for the host ref lives on
+func (f *githubFetcher) clientFor(ref input.IssueRef) (*githubapi.Client, error) {
+	return f.clients.For(ref)
 }
 
 // FetchIssue implements pipeline.IssueFetcher.
 func (f *githubFetcher) FetchIssue(ctx context.Context, ref input.IssueRef) (github.IssueData, error) {
-	return github.FetchIssue(ctx, f.client, ref)
+	client, err := f.clientFor(ref)
+	if err != nil {
+		return github.IssueData{}, err
+	}
+	return github.FetchIssue(ctx, client, ref)
+}
+
+// FetchSubIssues implements input.SubIssueFetcher.
+func (f *githubFetcher) FetchSubIssues(ctx context.Context, ref input.IssueRef) ([]input.IssueRef, error) {
+	client, err := f.clientFor(ref)
+	if err != nil {
+		return nil, err
+	}
+	return github.FetchSubIssues(ctx, client, ref)
 }
 
 // FetchCommentsSince implements pipeline.IssueFetcher.
 func (f *githubFetcher) FetchCommentsSince(ctx context.Context, ref input.IssueRef, since time.Time) ([]github.Comment, error) {
-	return github.FetchCommentsSince(ctx, f.client, ref, since)
+	client, err := f.clientFor(ref)
+	if err != nil {
+		return nil, err
+	}
+	return github.FetchCommentsSince(ctx, client, ref, since, f.maxCommentPages)
 }
 
 // initSummarizer creates the appropriate AI summarizer based on configuration
-func initSummarizer(cfg *config.Config, logger *slog.Logger) ai.Summarizer {
+func initSummarizer(cfg *config.Config, logger *slog.Logger, transport http.RoundTripper) ai.Summarizer {
 	if cfg.Models.Enabled {
 		logger.Debug("AI summarization enabled", "model", cfg.Models.Model)
-		return ai.NewGHModelsClient(cfg.Models.BaseURL, cfg.Models.Model, cfg.GitHubToken, cfg.Models.SystemPrompt, cfg.Models.Timeout)
+		client := ai.NewGHModelsClient(cfg.Models.BaseURL, cfg.Models.Model, cfg.GitHubToken, cfg.Models.SystemPrompt, cfg.Models.Timeout)
+		client.HTTP.Transport = transport
+		client.BatchSize = cfg.Models.BatchSize
+		client.FallbackModel = cfg.Models.Fallback
+		client.MaxSummaryWords = cfg.Models.MaxWords
+		client.Language = cfg.Models.Language
+		return client
 	}
 	logger.Debug("AI summarization disabled")
 	return ai.NewNoopSummarizer()
 }
 
+// withSummaryCache wraps summarizer so unchanged issues reuse summaries cached on disk.
+// Caching is skipped, with a warning, if no cache directory is available.
+func withSummaryCache(cfg *config.Config, logger *slog.Logger, summarizer ai.Summarizer) ai.Summarizer {
+	dir, err := ai.DefaultSummaryCacheDir()
+	if err != nil {
+		logger.Warn("Summary cache disabled", "error", err)
+		return summarizer
+	}
+	logger.Debug("Summary cache enabled", "dir", dir)
+	// The language instruction changes the output, so it is part of the cached prompt
+	prompt := ai.AppendLanguage(cfg.Models.SystemPrompt, cfg.Models.Language)
+	return ai.NewCachingSummarizer(summarizer, ai.NewSummaryCache(dir), cfg.Models.Model, prompt, cfg.Models.MaxWords)
+}
+
+// newProgressReporter creates a progress reporter for the collection phase.
+// A single updating bar is drawn when stderr is a TTY; verbose mode and
+// non-interactive runs fall back to one log line per completed issue.
+func newProgressReporter(cfg *config.Config, logger *slog.Logger, total int) *progress.Reporter {
+	useBar := !cfg.Quiet && !cfg.Verbose && progress.IsTerminal(os.Stderr)
+	return progress.New(os.Stderr, logger, "Collecting issue data", total, useBar)
+}
+
 // setupLogger creates a logger configured for progress output
 func setupLogger(cfg *config.Config) *slog.Logger {
 	if cfg.Quiet {
@@ -198,14 +382,5 @@ func setupLogger(cfg *config.Config) *slog.Logger {
 	}
 
 	// Use stderr for progress so stdout stays clean for output
-	return slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-		Level: level,
-		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
-			// Remove time stamps for cleaner progress output
-			if a.Key == slog.TimeKey {
-				return slog.Attr{}
-			}
-			return a
-		},
-	}))
+	return slog.New(logging.NewHandler(os.Stderr, cfg.LogFormat, level))
 }
@@ -1,11 +1,14 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/Attamusc/weekly-report-cli/internal/ai"
 	"github.com/Attamusc/weekly-report-cli/internal/config"
@@ -17,15 +20,30 @@ import (
 
 var (
 	// Describe-specific flags
-	describeInputPath   string
-	describeConcurrency int
-	describeVerbose     bool
-	describeQuiet       bool
-	describePrompt      string
-	describeFormat      string
-	describeNoSummary   bool
+	describeInputPath     string
+	describeConcurrency   int
+	describeVerbose       bool
+	describeQuiet         bool
+	describePrompt        string
+	describePromptFile    string
+	describeFormat        string
+	describeJSONPretty    bool
+	describeChecklist     bool
+	describeSort          string
+	describeNoSummary     bool
+	describeExcerptChars  int
+	describeTimeout       time.Duration
+	describeModel         string
+	describeModelFallback string
+	describeBaseURL       string
+	describeGitHubHosts   string
+	describeMaxIssues     int
+	describeAIStrict      bool
+	describeAllowEmpty    bool
+	describeNoBatch       bool
 
 	describeProjectFlags *projectFlags
+	describeRepoFilters  *repoFilterFlags
 )
 
 var describeCmd = &cobra.Command{
@@ -44,6 +62,7 @@ summaries of project goals and objectives with optional AI-powered refinement.
 Output Formats:
   - table (default): Markdown table with Initiative, Labels, Assignee, Summary
   - detailed: Full markdown sections with complete information for each issue
+  - json: JSON array of issues with title, url, summary, labels, and assignees
 
 Examples:
   # From project board (using defaults: Status field, "In Progress,Done,Blocked")
@@ -63,6 +82,12 @@ Examples:
   # Detailed format output
   weekly-report-cli describe --project "org:my-org/5" --format detailed
 
+  # JSON output for downstream tooling
+  weekly-report-cli describe --project "org:my-org/5" --format json
+
+  # Order rows by first assignee instead of title
+  weekly-report-cli describe --project "org:my-org/5" --sort assignee
+
   # From URL list (stdin)
   cat issues.txt | weekly-report-cli describe
 
@@ -85,22 +110,47 @@ func init() {
 	describeCmd.Flags().BoolVar(&describeVerbose, "verbose", false, "Enable verbose progress output")
 	describeCmd.Flags().BoolVar(&describeQuiet, "quiet", false, "Suppress all progress output")
 	describeCmd.Flags().StringVar(&describePrompt, "describe-prompt", "", "Custom prompt for AI description (uses default if empty)")
-	describeCmd.Flags().StringVar(&describeFormat, "format", "table", "Output format: 'table' or 'detailed'")
+	describeCmd.Flags().StringVar(&describePromptFile, "describe-prompt-file", "", "Read the AI description prompt from a file (overrides --describe-prompt)")
+	describeCmd.Flags().StringVar(&describeFormat, "format", "table", "Output format: 'table', 'detailed', or 'json'")
+	describeCmd.Flags().BoolVar(&describeJSONPretty, "json-pretty", false, "Indent --format json output for readability")
+	describeCmd.Flags().BoolVar(&describeChecklist, "detailed-checklist", false, "Render list items in --format detailed summaries as '- [ ]' task-list checkboxes")
+	describeCmd.Flags().StringVar(&describeSort, "sort", "title", "Row order: 'title', 'label' (first label) or 'assignee' (first assignee); rows without one come last")
+	describeCmd.Flags().BoolVar(&describeNoBatch, "no-batch", false, "Describe issues with one AI call each instead of a single batch call")
 	describeCmd.Flags().BoolVar(&describeNoSummary, "no-summary", false, "Disable AI summarization (output raw body excerpt)")
+	describeCmd.Flags().IntVar(&describeExcerptChars, "body-excerpt-chars", pipeline.DefaultDescribeExcerptChars, "Characters of the issue body shown when there is no AI description (0 shows the whole body)")
+
+	describeCmd.Flags().DurationVar(&describeTimeout, "timeout", 0, "Overall deadline for the whole run (e.g., '5m'); 0 disables")
+	describeCmd.Flags().StringVar(&describeModel, "model", "", "AI model to use (overrides GITHUB_MODELS_MODEL)")
+	describeCmd.Flags().StringVar(&describeModelFallback, "model-fallback", "", "Model to try once when the primary model is still rate limited after retries")
+	describeCmd.Flags().BoolVar(&describeAIStrict, "ai-strict", false, "Fail instead of falling back to the raw body when the AI returns no description for an issue")
+	describeCmd.Flags().BoolVar(&describeAllowEmpty, "allow-empty", false, "Print an empty table (or '[]' with --format json) and exit 0 instead of exiting 2 when there are no rows")
+	describeCmd.Flags().StringVar(&describeBaseURL, "base-url", "", "GitHub Models API base URL (overrides GITHUB_MODELS_BASE_URL)")
+	describeCmd.Flags().IntVar(&describeMaxIssues, "max-issues", 0, "Keep only the first N issues after merging the project board and URL list (0 = no cap)")
+	describeCmd.Flags().StringVar(&describeGitHubHosts, "github-hosts", "", "Comma-separated GitHub Enterprise Server hosts whose issue URLs may appear alongside github.com ones (overrides GITHUB_HOSTS)")
 
 	describeProjectFlags = addProjectFlags(describeCmd)
+	describeRepoFilters = addRepoFilterFlags(describeCmd)
 }
 
 func runDescribe(cmd *cobra.Command, args []string) error {
 	// Validate format flag
-	if describeFormat != "table" && describeFormat != "detailed" {
-		return fmt.Errorf("invalid format '%s': must be 'table' or 'detailed'", describeFormat)
+	if describeFormat != "table" && describeFormat != "detailed" && describeFormat != "json" {
+		return fmt.Errorf("invalid format '%s': must be 'table', 'detailed', or 'json'", describeFormat)
 	}
-
-	var projectFieldValuesList []string
-	if describeProjectFlags.FieldValues != "" {
-		projectFieldValuesList = input.ParseFieldValues(describeProjectFlags.FieldValues)
+	if describeExcerptChars < 0 {
+		return fmt.Errorf("invalid --body-excerpt-chars %d: must be 0 or more", describeExcerptChars)
+	}
+	if describeJSONPretty && describeFormat != "json" {
+		return fmt.Errorf("--json-pretty requires --format json")
+	}
+	if describeChecklist && describeFormat != "detailed" {
+		return fmt.Errorf("--detailed-checklist requires --format detailed")
 	}
+	if describeSort != "title" && describeSort != "label" && describeSort != "assignee" {
+		return fmt.Errorf("invalid sort '%s': must be 'title', 'label', or 'assignee'", describeSort)
+	}
+
+	projectFieldValuesList := describeProjectFlags.fieldValues(cmd)
 
 	cfgInput := config.ConfigInput{
 		SinceDays:          0,
@@ -108,8 +158,15 @@ func runDescribe(cmd *cobra.Command, args []string) error {
 		NoNotes:            true,
 		Verbose:            describeVerbose,
 		Quiet:              describeQuiet,
+		LogFormat:          logFormat,
+		TokenFile:          tokenFile,
+		Proxy:              proxyURL,
+		ConfigURL:          configURL,
+		GitHubHosts:        input.ParseFieldValues(describeGitHubHosts),
 		InputPath:          describeInputPath,
 		SummaryPrompt:      describePrompt,
+		SummaryPromptFile:  describePromptFile,
+		DescribePrompts:    true,
 		ProjectURL:         describeProjectFlags.URL,
 		ProjectField:       describeProjectFlags.Field,
 		ProjectFieldValues: projectFieldValuesList,
@@ -117,24 +174,41 @@ func runDescribe(cmd *cobra.Command, args []string) error {
 		ProjectMaxItems:    describeProjectFlags.MaxItems,
 		ProjectView:        describeProjectFlags.View,
 		ProjectViewID:      describeProjectFlags.ViewID,
+		ProjectCacheTTL:    describeProjectFlags.CacheTTL,
+		ProjectNoCache:     describeProjectFlags.NoCache,
 		NoSentiment:        true,
+		Timeout:            describeTimeout,
+		Model:              describeModel,
+		ModelsBaseURL:      describeBaseURL,
+		ModelFallback:      describeModelFallback,
+		AIStrict:           describeAIStrict,
 	}
 	resolverCfg := input.ResolverConfig{
-		ProjectURL:         describeProjectFlags.URL,
-		ProjectFieldName:   describeProjectFlags.Field,
-		ProjectFieldValues: projectFieldValuesList,
-		ProjectIncludePRs:  describeProjectFlags.IncludePRs,
-		ProjectMaxItems:    describeProjectFlags.MaxItems,
-		ProjectView:        describeProjectFlags.View,
-		ProjectViewID:      describeProjectFlags.ViewID,
-		URLListPath:        describeInputPath,
-		UseStdin:           describeInputPath == "" && describeProjectFlags.URL == "",
+		ProjectURL:          describeProjectFlags.URL,
+		ProjectFieldName:    describeProjectFlags.Field,
+		ProjectFieldValues:  projectFieldValuesList,
+		ProjectIncludePRs:   describeProjectFlags.IncludePRs,
+		ProjectMaxItems:     describeProjectFlags.MaxItems,
+		ProjectView:         describeProjectFlags.View,
+		ProjectViewID:       describeProjectFlags.ViewID,
+		ProjectFieldAlias:   describeProjectFlags.FieldAlias,
+		ProjectStrict:       describeProjectFlags.Strict,
+		ProjectUpdatedSince: describeProjectFlags.UpdatedSince,
+		URLListPath:         describeInputPath,
+		UseStdin:            describeInputPath == "" && describeProjectFlags.URL == "",
+		RepoAllowlist:       input.ParseFieldValues(describeRepoFilters.Allowlist),
+		RepoDenylist:        input.ParseFieldValues(describeRepoFilters.Denylist),
+		MaxIssues:           describeMaxIssues,
 	}
 
 	deps, err := setupCommand(cfgInput, resolverCfg)
 	if err != nil {
+		if describeAllowEmpty && errors.Is(err, config.ErrNoRows) {
+			return printEmptyDescribe(describeFormat)
+		}
 		return err
 	}
+	defer deps.Cancel()
 
 	// Override AI enabled based on --no-summary flag
 	if describeNoSummary {
@@ -151,21 +225,25 @@ func runDescribe(cmd *cobra.Command, args []string) error {
 
 	var completed atomic.Int32
 	var wg sync.WaitGroup
+	reporter := newProgressReporter(cfg, logger, len(issueRefs))
 
 	for _, ref := range issueRefs {
 		wg.Add(1)
 		go func(ref input.IssueRef) {
 			defer wg.Done()
-			semaphore <- struct{}{}
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				dataResults <- pipeline.DescribeIssueDataResult{Err: ctx.Err()}
+				return
+			}
 			defer func() { <-semaphore }()
 
-			data, err := pipeline.CollectDescribeIssueData(ctx, fetcher, ref)
+			data, err := pipeline.CollectDescribeIssueData(ctx, fetcher, ref, describeExcerptChars)
 
 			current := completed.Add(1)
 			if !cfg.Quiet {
-				logger.Info("Collecting issue data",
-					"completed", int(current),
-					"total", len(issueRefs))
+				reporter.Update(int(current))
 			}
 
 			dataResults <- pipeline.DescribeIssueDataResult{Data: data, Err: err}
@@ -174,6 +252,7 @@ func runDescribe(cmd *cobra.Command, args []string) error {
 
 	go func() {
 		wg.Wait()
+		reporter.Done()
 		close(dataResults)
 	}()
 
@@ -198,12 +277,35 @@ func runDescribe(cmd *cobra.Command, args []string) error {
 		logger.Info("Data collection completed successfully", "issues", len(allData))
 	}
 
+	if err := checkRunTimeout(ctx, cfg.Timeout); err != nil {
+		return err
+	}
+
+	rows, err := describeRows(ctx, cfg, logger, summarizer, allData, describeNoBatch)
+	if err != nil {
+		return err
+	}
+
+	// Generate output
+	return renderDescribeOutput(rows, describeFormat, cfg, logger)
+}
+
+// describeRows runs the description and assembly phases over collected issue
+// data; generate --with-goals reuses it for the goals section
+func describeRows(ctx context.Context, cfg *config.Config, logger *slog.Logger, summarizer ai.Summarizer, allData []pipeline.DescribeIssueData, noBatch bool) ([]format.DescribeRow, error) {
 	// ========== PHASE B: Batch description (single API call) ==========
 	var descriptions map[string]string
 	if cfg.Models.Enabled {
+		describe := pipeline.BatchDescribe
+		if noBatch {
+			describe = pipeline.DescribeEach
+		}
 		var err error
-		descriptions, err = pipeline.BatchDescribe(ctx, summarizer, allData, logger)
+		descriptions, err = describe(ctx, summarizer, allData, logger)
 		if err != nil {
+			if cfg.Models.Strict {
+				return nil, fmt.Errorf("%w: batch description failed: %v", config.ErrAIIncomplete, err)
+			}
 			logger.Warn("Batch description failed, using fallbacks", "error", err)
 			descriptions = make(map[string]string)
 		}
@@ -212,29 +314,70 @@ func runDescribe(cmd *cobra.Command, args []string) error {
 		descriptions = make(map[string]string)
 	}
 
+	if err := checkRunTimeout(ctx, cfg.Timeout); err != nil {
+		return nil, err
+	}
+
 	// ========== PHASE C: Create final results ==========
-	rows := pipeline.AssembleDescribeResults(allData, descriptions, logger)
+	if cfg.Models.Enabled && cfg.Models.Strict {
+		if err := config.CheckAIResults(pipeline.MissingDescriptions(allData, descriptions), len(allData)); err != nil {
+			return nil, err
+		}
+	}
 
-	// Generate output
-	return renderDescribeOutput(rows, describeFormat, cfg, logger)
+	return pipeline.AssembleDescribeResults(allData, descriptions, logger), nil
+}
+
+// printEmptyDescribe prints the empty form of outputFormat for --allow-empty
+func printEmptyDescribe(outputFormat string) error {
+	switch outputFormat {
+	case "detailed":
+		// Detailed output has no header; nothing to print
+	case "json":
+		output, err := format.RenderDescribeJSONWithOptions(nil, format.JSONOptions{Pretty: describeJSONPretty})
+		if err != nil {
+			return err
+		}
+		fmt.Print(output)
+	default:
+		fmt.Print(format.RenderEmptyDescribeTable())
+	}
+	return nil
 }
 
 // renderDescribeOutput sorts, renders, and prints describe output
 func renderDescribeOutput(rows []format.DescribeRow, outputFormat string, cfg *config.Config, logger *slog.Logger) error {
 	if len(rows) == 0 {
+		if describeAllowEmpty {
+			return printEmptyDescribe(outputFormat)
+		}
 		if !cfg.Quiet {
 			fmt.Fprintf(os.Stderr, "No describe rows generated\n")
 		}
 		return config.ErrNoRows
 	}
 
+	// Title order first, so rows sharing a label or assignee stay alphabetical
 	format.SortDescribeRowsByTitle(rows)
+	switch describeSort {
+	case "label":
+		format.SortDescribeRowsByLabel(rows)
+	case "assignee":
+		format.SortDescribeRowsByAssignee(rows)
+	}
 
 	logger.Info("Rendering output...", "rows", len(rows), "format", outputFormat)
 	var output string
-	if outputFormat == "detailed" {
-		output = format.RenderDescribeDetailed(rows)
-	} else {
+	switch outputFormat {
+	case "detailed":
+		output = format.RenderDescribeDetailedWithOptions(rows, format.DetailedOptions{Checklist: describeChecklist})
+	case "json":
+		var err error
+		output, err = format.RenderDescribeJSONWithOptions(rows, format.JSONOptions{Pretty: describeJSONPretty})
+		if err != nil {
+			return err
+		}
+	default:
 		output = format.RenderDescribeTable(rows)
 	}
 	fmt.Print(output)
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Attamusc/weekly-report-cli/internal/ai"
+	"github.com/Attamusc/weekly-report-cli/internal/config"
+	"github.com/Attamusc/weekly-report-cli/internal/github"
+	"github.com/Attamusc/weekly-report-cli/internal/httpclient"
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+	"github.com/Attamusc/weekly-report-cli/internal/projects"
+)
+
+var (
+	doctorProject string
+	doctorModel   string
+	doctorBaseURL string
+	doctorVerbose bool
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the token, project access and GitHub Models access",
+	Long: `Doctor makes a few lightweight API calls to check that the setup generate and
+describe rely on works: it verifies the GitHub token, checks access to a project
+board when --project is given, and sends a tiny prompt to GitHub Models (skipped
+when DISABLE_SUMMARY is set). Each check prints pass or fail with a hint on how
+to fix it. Exits non-zero when any check fails.
+
+Examples:
+  weekly-report-cli doctor
+  weekly-report-cli doctor --project "org:my-org/5"
+  DISABLE_SUMMARY=1 weekly-report-cli doctor`,
+	Args: cobra.NoArgs,
+	RunE: runDoctor,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().StringVar(&doctorProject, "project", "", "GitHub project board URL or identifier to check access to (e.g., 'org:my-org/5')")
+	doctorCmd.Flags().StringVar(&doctorModel, "model", "", "AI model to check (overrides GITHUB_MODELS_MODEL)")
+	doctorCmd.Flags().StringVar(&doctorBaseURL, "base-url", "", "GitHub Models API base URL (overrides GITHUB_MODELS_BASE_URL)")
+	doctorCmd.Flags().BoolVar(&doctorVerbose, "verbose", false, "Enable verbose progress output")
+}
+
+// doctorCheck is the outcome of one doctor check
+type doctorCheck struct {
+	Name   string
+	Passed bool
+	Detail string // What was found, or the remediation hint on failure
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	cfg, err := config.FromEnvAndFlags(config.ConfigInput{
+		Verbose:       doctorVerbose,
+		LogFormat:     logFormat,
+		TokenFile:     tokenFile,
+		Proxy:         proxyURL,
+		ConfigURL:     configURL,
+		Model:         doctorModel,
+		ModelsBaseURL: doctorBaseURL,
+	})
+	if err != nil {
+		return fmt.Errorf("configuration error: %w", err)
+	}
+
+	var projectRef projects.ProjectRef
+	if doctorProject != "" {
+		projectRef, err = projects.ParseProjectURL(doctorProject)
+		if err != nil {
+			return fmt.Errorf("invalid project URL: %w", err)
+		}
+	}
+
+	logger := setupLogger(cfg)
+	ctx := context.WithValue(context.Background(), input.LoggerContextKey{}, logger)
+	transport := httpclient.NewTransport(cfg.Proxy)
+
+	var checks []doctorCheck
+
+	token, tokenErr := github.CheckToken(ctx, github.New(ctx, cfg.GitHubToken, transport))
+	if tokenErr != nil {
+		checks = append(checks, doctorCheck{Name: "GitHub token", Detail: tokenErr.Error()})
+	} else {
+		checks = append(checks, doctorCheck{Name: "GitHub token", Passed: true, Detail: "authenticated as " + token.Login})
+	}
+
+	if doctorProject != "" {
+		checks = append(checks, checkDoctorProject(ctx, cfg, transport, projectRef, token, tokenErr == nil))
+	}
+
+	if cfg.Models.Enabled {
+		client := ai.NewGHModelsClient(cfg.Models.BaseURL, cfg.Models.Model, cfg.GitHubToken, "", cfg.Models.Timeout)
+		client.HTTP.Transport = transport
+		if err := client.Ping(ctx); err != nil {
+			checks = append(checks, doctorCheck{Name: "GitHub Models", Detail: err.Error()})
+		} else {
+			checks = append(checks, doctorCheck{Name: "GitHub Models", Passed: true, Detail: "model " + cfg.Models.Model + " responded"})
+		}
+	} else {
+		checks = append(checks, doctorCheck{Name: "GitHub Models", Passed: true, Detail: "skipped (DISABLE_SUMMARY is set)"})
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), renderDoctorChecks(checks))
+
+	failed := 0
+	for _, check := range checks {
+		if !check.Passed {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(checks))
+	}
+	return nil
+}
+
+// checkDoctorProject checks the token's scopes (when it reports them) and
+// queries the project board
+func checkDoctorProject(ctx context.Context, cfg *config.Config, transport http.RoundTripper, ref projects.ProjectRef, token github.TokenInfo, tokenOK bool) doctorCheck {
+	name := "Project " + ref.String()
+	if tokenOK && !token.HasScope("read:project") {
+		return doctorCheck{Name: name, Detail: fmt.Sprintf("token scopes are %q; the 'read:project' scope is required.\nVisit https://github.com/settings/tokens to update your token", strings.Join(token.Scopes, ", "))}
+	}
+
+	client := projects.NewClient(cfg.GitHubToken)
+	client.SetTransport(transport)
+	title, err := client.CheckAccess(ctx, ref)
+	if err != nil {
+		return doctorCheck{Name: name, Detail: err.Error()}
+	}
+	return doctorCheck{Name: name, Passed: true, Detail: fmt.Sprintf("readable (%q)", title)}
+}
+
+// renderDoctorChecks formats one line per check, indenting multi-line hints
+func renderDoctorChecks(checks []doctorCheck) string {
+	var builder strings.Builder
+	for _, check := range checks {
+		result := "PASS"
+		if !check.Passed {
+			result = "FAIL"
+		}
+		detail := strings.ReplaceAll(check.Detail, "\n", "\n       ")
+		builder.WriteString(fmt.Sprintf("[%s] %s: %s\n", result, check.Name, detail))
+	}
+	return builder.String()
+}
@@ -1,8 +1,12 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"strings"
 	"sync"
@@ -13,29 +17,87 @@ import (
 	"github.com/Attamusc/weekly-report-cli/internal/config"
 	"github.com/Attamusc/weekly-report-cli/internal/diff"
 	"github.com/Attamusc/weekly-report-cli/internal/format"
+	"github.com/Attamusc/weekly-report-cli/internal/github"
 	"github.com/Attamusc/weekly-report-cli/internal/input"
 	"github.com/Attamusc/weekly-report-cli/internal/pipeline"
+	"github.com/Attamusc/weekly-report-cli/internal/progress"
+	"github.com/Attamusc/weekly-report-cli/internal/report"
 	"github.com/spf13/cobra"
 )
 
 var (
-	sinceDays        int
-	inputPath        string
-	concurrency      int
-	noNotes          bool
-	collapsibleNotes bool
-	noSentiment      bool
-	verbose          bool
-	quiet            bool
-	summaryPrompt    string
-	summaryHeader    bool
+	sinceDays         int
+	inputPath         string
+	concurrency       int
+	noNotes           bool
+	collapsibleNotes  bool
+	plainNotes        bool
+	legend            bool
+	fullLegend        bool
+	noSentiment       bool
+	verbose           bool
+	quiet             bool
+	summaryPrompt     string
+	summaryPromptFile string
+	summaryHeader     bool
 
 	previousReportPath string
-
-	groupBy string
-	columns string
+	previousReportURL  string
+
+	groupBy         string
+	groupByAssignee bool
+	columns         string
+
+	statusLabelPrefix string
+	reportAuthors     string
+	minUpdateWords    int
+	maxCommentPages   int
+	bodyFallback      bool
+	noUpdateMsg       string
+	noStructuredMsg   string
+	doneSinceDays     int
+	timezone          string
+	statusFromField   string
+	targetDateField   string
+	targetDatePolicy  string
+	summarizeKey      string
+	noDedupUpdates    bool
+	parallelFetch     bool
+	runTimeout        time.Duration
+	model             string
+	modelFallback     string
+	modelsBaseURL     string
+	githubHosts       string
+	maxIssues         int
+	countOnly         bool
+	aiStrict          bool
+	noSummaryCache    bool
+	tableHeaders      string
+	noDateColumn      bool
+	autoTitle         bool
+	splitByStatus     bool
+	outputDir         string
+	failOnErrors      bool
+	failOnStale       bool
+	expandSubIssues   bool
+	showIssueNumber   bool
+	outputFormat      string
+	linkUpdates       bool
+	listUpdates       bool
+	showUpdateCount   bool
+	markFallbacks     bool
+	noEscape          bool
+	mergeByTitle      bool
+	allowEmpty        bool
+	stream            bool
+	withGoals         bool
+	summaryMaxWords   int
+	noTrim            bool
+	summaryLanguage   string
 
 	generateProjectFlags *projectFlags
+	generateRepoFilters  *repoFilterFlags
+	generateReportKeys   *report.KeyNames
 )
 
 var generateCmd = &cobra.Command{
@@ -90,7 +152,10 @@ Examples:
   weekly-report-cli generate \
     --project "org:my-org/5" \
     --group-by "label:team-*" \
-    --columns "Priority,Sprint"`,
+    --columns "Priority,Sprint"
+
+  # Quick per-status tally (no table, no AI)
+  weekly-report-cli generate --project "org:my-org/5" --count-only`,
 	RunE: runGenerate,
 }
 
@@ -98,27 +163,159 @@ func init() {
 	rootCmd.AddCommand(generateCmd)
 
 	// Add flags
-	generateCmd.Flags().IntVar(&sinceDays, "since-days", 7, "Number of days to look back for updates")
+	generateCmd.Flags().IntVar(&sinceDays, "since-days", 7, "Number of days to look back for updates (issues with none fetch their full comment history to report when they were last updated)")
+	generateCmd.Flags().IntVar(&doneSinceDays, "done-since-days", 0, "Number of days to look back for updates on closed issues (0 uses --since-days)")
 	generateCmd.Flags().StringVar(&inputPath, "input", "", "Input file path (default: stdin)")
-	generateCmd.Flags().IntVar(&concurrency, "concurrency", 4, "Number of concurrent workers")
+	generateCmd.Flags().IntVar(&concurrency, "concurrency", 4, "Maximum number of concurrent workers; lowered automatically while GitHub rate limits requests")
 	generateCmd.Flags().BoolVar(&noNotes, "no-notes", false, "Disable notes section in output")
 	generateCmd.Flags().BoolVar(&noSentiment, "no-sentiment", false, "Disable AI sentiment analysis")
 	generateCmd.Flags().BoolVar(&verbose, "verbose", false, "Enable verbose progress output")
 	generateCmd.Flags().BoolVar(&quiet, "quiet", false, "Suppress all progress output")
 	generateCmd.Flags().StringVar(&summaryPrompt, "summary-prompt", "", "Custom prompt for AI summarization (uses default if empty)")
-	generateCmd.Flags().StringVar(&previousReportPath, "previous-report", "", "Path to previous report file for week-over-week diff")
+	generateCmd.Flags().StringVar(&summaryPromptFile, "summary-prompt-file", "", "Read the AI summarization prompt from a file (overrides --summary-prompt)")
+	generateCmd.Flags().StringVar(&previousReportPath, "previous-report", "", "Path to previous report (markdown table or JSON array of rows) for week-over-week diff")
+	generateCmd.Flags().StringVar(&previousReportPath, "previous", "", "Alias for --previous-report")
+	generateCmd.Flags().StringVar(&previousReportURL, "previous-url", "", "HTTP(S) URL serving the previous report, fetched instead of reading --previous-report (the diff is skipped if the fetch fails)")
 	generateCmd.Flags().BoolVar(&collapsibleNotes, "collapsible-notes", false, "Wrap notes section in collapsible <details> HTML block")
+	generateCmd.Flags().BoolVar(&legend, "legend", false, "Add a key below the table explaining the status emoji used in the report")
+	generateCmd.Flags().BoolVar(&fullLegend, "full-legend", false, "Like --legend, but list every status instead of only those in the report")
+	generateCmd.Flags().BoolVar(&plainNotes, "plain-notes", false, "List issues in the notes section by bare URL instead of a link titled with the issue title")
 	generateCmd.Flags().StringVar(&groupBy, "group-by", "", "Group rows by: assignee, label:<glob>, field:<name>")
+	generateCmd.Flags().BoolVar(&groupByAssignee, "group-by-assignee", false, "Shorthand for --group-by assignee: one table per assignee, issues with several listed under each, plus 'Unassigned'")
 	generateCmd.Flags().StringVar(&columns, "columns", "", "Comma-separated project field names to show as extra columns (e.g., 'Priority,Sprint')")
+	generateCmd.Flags().StringVar(&statusFromField, "status-from-field", "", "Take each row's status from this project field (e.g., 'Status' with options like '🟢 On Track') instead of report comments")
+	generateCmd.Flags().StringVar(&targetDatePolicy, "target-date", pipeline.TargetDateNewestReport, "Target date used when several reports in the window give one: 'newest-report', 'earliest', or 'latest'")
+	generateCmd.Flags().StringVar(&targetDateField, "target-date-field", "", "Take each row's target date from this project date field (e.g., 'Target Date') instead of report comments")
+	generateCmd.Flags().StringVar(&statusLabelPrefix, "status-label-prefix", "", "Only use labels with this prefix (e.g., 'status:') when deriving status from labels")
+	generateCmd.Flags().StringVar(&reportAuthors, "report-authors", "", "Comma-separated GitHub logins whose structured reports count (default: all authors)")
+	generateCmd.Flags().IntVar(&minUpdateWords, "min-update-words", 0, "Treat structured updates with fewer words as missing (0 disables)")
+	generateCmd.Flags().StringVar(&noUpdateMsg, "no-update-message", pipeline.DefaultNoUpdateMessage, "Update text for issues with no update in the window; {{.Days}} is the window length")
+	generateCmd.Flags().StringVar(&noStructuredMsg, "no-structured-update-message", pipeline.DefaultNoStructuredUpdateMessage, "Update text for issues whose reports had no usable update; {{.Days}} is the window length")
+	generateCmd.Flags().BoolVar(&bodyFallback, "body-fallback", false, "Show the first 200 characters of the issue body instead of 'No update provided' for issues without updates")
+	generateCmd.Flags().IntVar(&maxCommentPages, "max-comment-pages", 0, "Cap comment pages (100 comments each) fetched per issue, keeping the newest; 0 fetches all")
+	generateCmd.Flags().DurationVar(&runTimeout, "timeout", 0, "Overall deadline for the whole run (e.g., '5m'); 0 disables")
+	generateCmd.Flags().StringVar(&timezone, "timezone", "UTC", "IANA timezone for rendering target dates (e.g., 'America/Los_Angeles')")
+	generateCmd.Flags().StringVar(&model, "model", "", "AI model to use (overrides GITHUB_MODELS_MODEL)")
+	generateCmd.Flags().StringVar(&modelFallback, "model-fallback", "", "Model to try once when the primary model is still rate limited after retries")
+	generateCmd.Flags().StringVar(&modelsBaseURL, "base-url", "", "GitHub Models API base URL (overrides GITHUB_MODELS_BASE_URL)")
+	generateCmd.Flags().IntVar(&maxIssues, "max-issues", 0, "Keep only the first N issues after merging the project board and URL list (0 = no cap)")
+	generateCmd.Flags().StringVar(&githubHosts, "github-hosts", "", "Comma-separated GitHub Enterprise Server hosts whose issue URLs may appear alongside github.com ones (overrides GITHUB_HOSTS)")
+	generateCmd.Flags().StringVar(&summarizeKey, "summarize-key", pipeline.SummarizeKeyUpdate, "Report key whose text is summarized: 'update' or 'summary' (reports without the key use their update)")
+	generateCmd.Flags().BoolVar(&noDedupUpdates, "no-dedup-updates", false, "Send every update in the window to the summarizer, even identical reposts (case and whitespace are ignored when comparing)")
+	generateCmd.Flags().BoolVar(&parallelFetch, "parallel-fetch", false, "Fetch each issue's metadata and comments concurrently (two requests in flight per issue)")
+	generateCmd.Flags().BoolVar(&aiStrict, "ai-strict", false, "Fail instead of falling back to raw text when the AI returns no summary for an issue")
+	generateCmd.Flags().BoolVar(&noSummaryCache, "no-summary-cache", false, "Re-summarize every issue instead of reusing cached summaries for unchanged updates")
+	generateCmd.Flags().BoolVar(&countOnly, "count-only", false, "Print only per-status counts instead of the report table (skips AI summarization)")
+	generateCmd.Flags().StringVar(&outputFormat, "format", "table", "Output format: 'table' or 'compact' (one line per issue)")
+	generateCmd.Flags().StringVar(&tableHeaders, "headers", "", "Comma-separated replacements for the Status, Initiative/Epic, Target Date, and Update column headers")
+	generateCmd.Flags().BoolVar(&noDateColumn, "no-date-column", false, "Omit the Target Date column from the table (rows are still sorted by date)")
+	generateCmd.Flags().BoolVar(&showIssueNumber, "show-issue-number", false, "Prefix each linked title with its issue number (e.g., '[#123 User Auth](url)')")
+	generateCmd.Flags().BoolVar(&linkUpdates, "link-updates", false, "Append a link to the source comment after each update (e.g., '([source](url))')")
+	generateCmd.Flags().BoolVar(&listUpdates, "list-updates", false, "Without AI summaries, show every update in the window as a bulleted list in the cell instead of only the newest")
+	generateCmd.Flags().BoolVar(&noEscape, "no-escape", false, "Write titles, updates and extra columns into table cells without escaping pipes or backslashes (newlines are still collapsed); unescaped pipes can break the table in standard markdown")
+	generateCmd.Flags().BoolVar(&markFallbacks, "mark-fallbacks", false, "Append '_(raw)_' to update cells that show raw text because AI summarization returned nothing")
+	generateCmd.Flags().BoolVar(&showUpdateCount, "show-update-count", false, "Append '(N updates)' to each update cell with the number of reports in the window, replacing the 'Multiple updates' notes")
+	generateCmd.Flags().BoolVar(&autoTitle, "auto-title", false, "Add a title with the project title (when using --project), ISO week and date range (e.g., 'Weekly Report — 2025-W32 (Aug 4–Aug 10)')")
+	generateCmd.Flags().BoolVar(&splitByStatus, "split-by-status", false, "Write one table per status to --output-dir (e.g., on-track.md, at-risk.md)")
+	generateCmd.Flags().StringVar(&outputDir, "output-dir", "", "Directory for --split-by-status files")
+	generateCmd.Flags().BoolVar(&expandSubIssues, "expand-sub-issues", false, "Also report on the direct sub-issues of every resolved issue")
+	generateCmd.Flags().BoolVar(&mergeByTitle, "merge-by-title", false, "Collapse rows with the same title, keeping the worst status and joining updates")
+	generateCmd.Flags().BoolVar(&allowEmpty, "allow-empty", false, "Print an empty table and exit 0 instead of exiting 2 when there are no rows")
+	generateCmd.Flags().BoolVar(&failOnErrors, "fail-on-errors", false, "Exit with code 3 after printing the report if any issue could not be collected")
+	generateCmd.Flags().BoolVar(&failOnStale, "fail-on-stale", false, "Exit with code 4 after printing the report if any issue had no update in the window")
 	generateCmd.Flags().BoolVar(&summaryHeader, "summary-header", false, "Generate an executive summary header above the report table")
+	generateCmd.Flags().IntVar(&summaryMaxWords, "summary-max-words", ai.DefaultMaxSummaryWords, "Trim AI summaries longer than this many words to the last full sentence within the cap")
+	generateCmd.Flags().StringVar(&summaryLanguage, "summary-language", "", "Ask the AI to write summaries in this language (e.g., 'Japanese')")
+	generateCmd.Flags().BoolVar(&noTrim, "no-trim", false, "Keep AI summaries at whatever length the model returns")
+	generateCmd.Flags().BoolVar(&withGoals, "with-goals", false, "Follow the report with a \"## Goals\" section describing the same issues, as describe does")
+	generateCmd.Flags().BoolVar(&stream, "stream", false, "Print each row as soon as its issue is collected; rows are not sorted")
 
 	generateProjectFlags = addProjectFlags(generateCmd)
+	generateRepoFilters = addRepoFilterFlags(generateCmd)
+	generateReportKeys = addReportKeyFlags(generateCmd)
 }
 
 func runGenerate(cmd *cobra.Command, args []string) error {
-	var projectFieldValuesList []string
-	if generateProjectFlags.FieldValues != "" {
-		projectFieldValuesList = input.ParseFieldValues(generateProjectFlags.FieldValues)
+	projectFieldValuesList := generateProjectFlags.fieldValues(cmd)
+
+	if groupByAssignee {
+		if groupBy != "" && groupBy != "assignee" {
+			return fmt.Errorf("--group-by-assignee cannot be combined with --group-by %s", groupBy)
+		}
+		groupBy = "assignee"
+	}
+	if splitByStatus != (outputDir != "") {
+		return fmt.Errorf("--split-by-status and --output-dir must be used together")
+	}
+	if splitByStatus && groupBy != "" {
+		return fmt.Errorf("--split-by-status cannot be combined with --group-by")
+	}
+	if outputFormat != "table" && outputFormat != "compact" {
+		return fmt.Errorf("invalid format '%s': must be 'table' or 'compact'", outputFormat)
+	}
+	if outputFormat == "compact" && (splitByStatus || groupBy != "") {
+		return fmt.Errorf("--format compact cannot be combined with --group-by or --split-by-status")
+	}
+	if withGoals && (splitByStatus || countOnly || stream || outputFormat == "compact") {
+		return fmt.Errorf("--with-goals cannot be combined with --split-by-status, --count-only, --stream or --format compact")
+	}
+	if summarizeKey != pipeline.SummarizeKeyUpdate && summarizeKey != pipeline.SummarizeKeySummary {
+		return fmt.Errorf("invalid --summarize-key '%s': must be 'update' or 'summary'", summarizeKey)
+	}
+	switch targetDatePolicy {
+	case pipeline.TargetDateNewestReport, pipeline.TargetDateEarliest, pipeline.TargetDateLatest:
+	default:
+		return fmt.Errorf("invalid --target-date '%s': must be 'newest-report', 'earliest', or 'latest'", targetDatePolicy)
+	}
+	if previousReportPath != "" && previousReportURL != "" {
+		return fmt.Errorf("--previous-report and --previous-url cannot be used together")
+	}
+	if stream {
+		if err := checkStreamFlags(); err != nil {
+			return err
+		}
+	}
+
+	// Parse the fallback message templates up front, like --headers below
+	noUpdateTemplate, err := pipeline.ParseMessageTemplate("no-update-message", noUpdateMsg)
+	if err != nil {
+		return fmt.Errorf("invalid --no-update-message: %w", err)
+	}
+	noStructuredTemplate, err := pipeline.ParseMessageTemplate("no-structured-update-message", noStructuredMsg)
+	if err != nil {
+		return fmt.Errorf("invalid --no-structured-update-message: %w", err)
+	}
+
+	// Validate --headers up front so a typo doesn't cost a full run
+	var headers []string
+	if tableHeaders != "" {
+		var err error
+		headers, err = format.ParseTableHeaders(tableHeaders)
+		if err != nil {
+			return fmt.Errorf("invalid --headers: %w", err)
+		}
+	}
+
+	// Parse --columns flag
+	var extraColumns []string
+	if columns != "" {
+		for _, col := range strings.Split(columns, ",") {
+			col = strings.TrimSpace(col)
+			if col != "" {
+				extraColumns = append(extraColumns, col)
+			}
+		}
+	}
+	tableOpts := format.TableOptions{
+		ExtraColumns:    extraColumns,
+		Headers:         headers,
+		NoDateColumn:    noDateColumn,
+		ShowIssueNumber: showIssueNumber,
+		LinkUpdates:     linkUpdates,
+		ListUpdates:     listUpdates,
+		ShowUpdateCount: showUpdateCount,
+		MarkFallbacks:   markFallbacks,
+		NoEscape:        noEscape,
 	}
 
 	cfgInput := config.ConfigInput{
@@ -127,8 +324,14 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		NoNotes:            noNotes,
 		Verbose:            verbose,
 		Quiet:              quiet,
+		LogFormat:          logFormat,
+		TokenFile:          tokenFile,
+		Proxy:              proxyURL,
+		ConfigURL:          configURL,
+		GitHubHosts:        input.ParseFieldValues(githubHosts),
 		InputPath:          inputPath,
 		SummaryPrompt:      summaryPrompt,
+		SummaryPromptFile:  summaryPromptFile,
 		ProjectURL:         generateProjectFlags.URL,
 		ProjectField:       generateProjectFlags.Field,
 		ProjectFieldValues: projectFieldValuesList,
@@ -136,120 +339,196 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		ProjectMaxItems:    generateProjectFlags.MaxItems,
 		ProjectView:        generateProjectFlags.View,
 		ProjectViewID:      generateProjectFlags.ViewID,
+		ProjectCacheTTL:    generateProjectFlags.CacheTTL,
+		ProjectNoCache:     generateProjectFlags.NoCache,
 		NoSentiment:        noSentiment,
+		StatusLabelPrefix:  statusLabelPrefix,
+		ReportAuthors:      input.ParseFieldValues(reportAuthors),
+		MinUpdateWords:     minUpdateWords,
+		MaxCommentPages:    maxCommentPages,
+		DoneSinceDays:      doneSinceDays,
+		Timeout:            runTimeout,
+		Timezone:           timezone,
+		Model:              model,
+		ModelsBaseURL:      modelsBaseURL,
+		ModelFallback:      modelFallback,
+		AIStrict:           aiStrict,
+		SummaryMaxWords:    summaryMaxWords,
+		NoTrim:             noTrim,
+		SummaryLanguage:    summaryLanguage,
+		ReportKeys:         *generateReportKeys,
 	}
 	resolverCfg := input.ResolverConfig{
-		ProjectURL:         generateProjectFlags.URL,
-		ProjectFieldName:   generateProjectFlags.Field,
-		ProjectFieldValues: projectFieldValuesList,
-		ProjectIncludePRs:  generateProjectFlags.IncludePRs,
-		ProjectMaxItems:    generateProjectFlags.MaxItems,
-		ProjectView:        generateProjectFlags.View,
-		ProjectViewID:      generateProjectFlags.ViewID,
-		URLListPath:        inputPath,
-		UseStdin:           inputPath == "" && generateProjectFlags.URL == "",
+		ProjectURL:          generateProjectFlags.URL,
+		ProjectFieldName:    generateProjectFlags.Field,
+		ProjectFieldValues:  projectFieldValuesList,
+		ProjectIncludePRs:   generateProjectFlags.IncludePRs,
+		ProjectMaxItems:     generateProjectFlags.MaxItems,
+		ProjectView:         generateProjectFlags.View,
+		ProjectViewID:       generateProjectFlags.ViewID,
+		ProjectFieldAlias:   generateProjectFlags.FieldAlias,
+		ProjectStrict:       generateProjectFlags.Strict,
+		ProjectUpdatedSince: generateProjectFlags.UpdatedSince,
+		URLListPath:         inputPath,
+		UseStdin:            inputPath == "" && generateProjectFlags.URL == "",
+		RepoAllowlist:       input.ParseFieldValues(generateRepoFilters.Allowlist),
+		RepoDenylist:        input.ParseFieldValues(generateRepoFilters.Denylist),
+		ExpandSubIssues:     expandSubIssues,
+		MaxIssues:           maxIssues,
 	}
 
 	deps, err := setupCommand(cfgInput, resolverCfg)
 	if err != nil {
+		if allowEmpty && errors.Is(err, config.ErrNoRows) {
+			printEmptyReport(tableOpts, outputFormat == "compact" || splitByStatus || countOnly)
+			return nil
+		}
 		return err
 	}
+	defer deps.Cancel()
 	ctx, cfg, logger, fetcher, summarizer, issueRefs := deps.Ctx, deps.Cfg, deps.Logger, deps.Fetcher, deps.Summarizer, deps.IssueRefs
+	if cfg.Models.Enabled && !noSummaryCache {
+		summarizer = withSummaryCache(cfg, logger, summarizer)
+	}
 
 	// Calculate time window
-	since := time.Now().AddDate(0, 0, -cfg.SinceDays)
+	now := time.Now()
+	since := now.AddDate(0, 0, -cfg.SinceDays)
 	logger.Debug("Looking for updates since", "since", since.Format("2006-01-02"))
 
-	// ========== PHASE A: Collect all issue data (parallel) ==========
-	logger.Info("Collecting issue data...", "concurrency", cfg.Concurrency)
-	dataResults := make(chan pipeline.IssueDataResult, len(issueRefs))
-	semaphore := make(chan struct{}, cfg.Concurrency)
-
-	var completed atomic.Int32
-	var wg sync.WaitGroup
+	// ========== PHASES A-C: Collect (parallel), batch summarize and assemble via pipeline.Run ==========
+	collectOpts := pipeline.CollectOptions{
+		StatusLabelPrefix: cfg.StatusLabelPrefix,
+		Now:               now,
+		ReportAuthors:     cfg.ReportAuthors,
+		MinUpdateWords:    cfg.MinUpdateWords,
+		BodyFallback:      bodyFallback,
+		DoneSinceDays:     cfg.DoneSinceDays,
+		StatusField:       statusFromField,
+		TargetDateField:   targetDateField,
+		TargetDatePolicy:  targetDatePolicy,
+		Location:          cfg.Location,
+		ReportKeys:        cfg.ReportKeys.Compile(),
+		SummarizeKey:      summarizeKey,
+		NoDedupUpdates:    noDedupUpdates,
+		ParallelFetch:     parallelFetch,
+
+		NoUpdateMessage:           noUpdateTemplate,
+		NoStructuredUpdateMessage: noStructuredTemplate,
+	}
 
-	for _, ref := range issueRefs {
-		wg.Add(1)
-		go func(ref input.IssueRef) {
-			defer wg.Done()
-			semaphore <- struct{}{}
-			defer func() { <-semaphore }()
+	logger.Info("Collecting issue data...", "concurrency", cfg.Concurrency)
+	reporter := newProgressReporter(cfg, logger, len(issueRefs))
 
-			data, err := pipeline.CollectIssueData(ctx, fetcher, ref, since, cfg.SinceDays)
+	var title string
+	if autoTitle {
+		title = format.WeeklyReportTitle(deps.ProjectTitle, since, now)
+	}
 
+	if stream {
+		var completed atomic.Int32
+		collect := func(ctx context.Context, ref input.IssueRef) (pipeline.IssueData, error) {
+			data, err := pipeline.CollectIssueData(ctx, fetcher, ref, since, cfg.SinceDays, collectOpts)
 			current := completed.Add(1)
 			if !cfg.Quiet {
-				logger.Info("Collecting issue data",
-					"completed", int(current),
-					"total", len(issueRefs))
+				reporter.Update(int(current))
 			}
-
-			dataResults <- pipeline.IssueDataResult{Data: data, Err: err}
-		}(ref)
+			return data, err
+		}
+		streamOpts := generateRenderOptions{
+			Table:      tableOpts,
+			Title:      title,
+			Compact:    outputFormat == "compact",
+			AllowEmpty: allowEmpty,
+		}
+		return streamGenerate(ctx, cfg, logger, summarizer, issueRefs, deps.Throttle, collect, reporter, streamOpts)
 	}
 
-	go func() {
-		wg.Wait()
-		close(dataResults)
-	}()
+	runCfg := pipeline.RunConfig{
+		Fetcher:   fetcher,
+		Refs:      issueRefs,
+		Permits:   deps.Throttle,
+		Since:     since,
+		SinceDays: cfg.SinceDays,
+		Options:   collectOpts,
+		Sentiment: cfg.Models.Sentiment && !countOnly,
+		Logger:    logger,
+	}
+	if cfg.Models.Enabled && !countOnly {
+		runCfg.Summarizer = summarizer
+	}
+	if !cfg.Quiet {
+		runCfg.OnProgress = reporter.Update
+	}
+	result, err := pipeline.Run(ctx, runCfg)
+	reporter.Done()
 
-	var allData []pipeline.IssueData
-	var errorCount int
+	// The pipeline returns failures instead of printing them; report them here
+	var collectErrs collectionErrors
+	for _, failure := range result.Failures.Failures() {
+		collectErrs.record(failure, cfg, logger)
+	}
+	errorCount := collectErrs.total
+	if err != nil {
+		if timeoutErr := checkRunTimeout(ctx, cfg.Timeout); timeoutErr != nil {
+			return timeoutErr
+		}
+		return err
+	}
 
-	for result := range dataResults {
-		if result.Err != nil {
-			errorCount++
-			if !cfg.Quiet {
-				fmt.Fprintf(os.Stderr, "Error collecting data for issue: %v\n", result.Err)
-			}
-			logger.Debug("Error collecting issue data", "error", result.Err)
-			continue
+	// --ai-strict fails instead of shipping fallback text
+	allData, batchResults := result.Data, result.BatchResults
+	if runCfg.Summarizer != nil && cfg.Models.Strict {
+		if result.SummarizeErr != nil {
+			return fmt.Errorf("%w: batch summarization failed: %v", config.ErrAIIncomplete, result.SummarizeErr)
+		}
+		if err := config.CheckAIResults(pipeline.MissingBatchResults(allData, batchResults), len(allData)); err != nil {
+			return err
 		}
-		allData = append(allData, result.Data)
 	}
 
-	if errorCount > 0 {
-		logger.Info("Data collection completed with errors", "errors", errorCount, "successful", len(allData))
-	} else {
-		logger.Info("Data collection completed successfully", "issues", len(allData))
+	rows, notes := result.Rows, collectErrs.appendNote(result.Notes, logger)
+
+	if mergeByTitle {
+		before := len(rows)
+		rows = format.MergeRowsByTitle(rows)
+		logger.Debug("Merged rows by title", "before", before, "after", len(rows))
+	}
+
+	if countOnly {
+		if err := renderStatusCounts(rows, cfg, allowEmpty); err != nil {
+			return err
+		}
+		return checkReportHealth(rows, notes, errorCount)
 	}
 
-	// ========== PHASE B: Batch summarization (single API call) ==========
-	var batchResults map[string]ai.BatchResult
-	if cfg.Models.Enabled {
+	var goals []format.DescribeRow
+	if withGoals {
+		logger.Info("Describing issues for the goals section...")
 		var err error
-		batchResults, err = pipeline.BatchSummarize(ctx, summarizer, allData, logger)
+		goals, err = describeRows(ctx, cfg, logger, summarizer, pipeline.DescribeDataFromIssues(allData), false)
 		if err != nil {
-			logger.Warn("Batch summarization failed, using fallbacks", "error", err)
-			batchResults = make(map[string]ai.BatchResult)
+			return err
 		}
-	} else {
-		logger.Debug("AI summarization disabled, using fallbacks")
-		batchResults = make(map[string]ai.BatchResult)
 	}
 
-	// ========== PHASE C: Create final results ==========
-	rows, notes := pipeline.AssembleGenerateResults(allData, batchResults, cfg.Models.Sentiment, logger)
-
 	// ========== PHASE D: Compare with previous report (if provided) ==========
-	if previousReportPath != "" {
-		logger.Info("Comparing with previous report", "path", previousReportPath)
-		prevContent, err := os.ReadFile(previousReportPath) //nolint:gosec // user-supplied CLI path
-		if err != nil {
-			logger.Warn("Could not read previous report, skipping diff", "error", err)
-		} else {
-			previousRows := diff.ParseReport(string(prevContent))
-			if len(previousRows) > 0 {
-				var diffNotes []format.Note
-				rows, diffNotes = diff.Compare(previousRows, rows)
-				notes = append(notes, diffNotes...)
-				logger.Info("Diff completed", "previous_rows", len(previousRows),
-					"transitions", format.CountNotesByKind(diffNotes, format.NoteStatusChanged),
-					"new", format.CountNotesByKind(diffNotes, format.NoteNewItem),
-					"removed", format.CountNotesByKind(diffNotes, format.NoteRemovedItem))
-			} else {
-				logger.Warn("Previous report contained no parseable rows, skipping diff")
-			}
+	if previousReportPath != "" || previousReportURL != "" {
+		previousRows, err := loadPreviousReport(ctx, cfg, headers, logger)
+		switch {
+		case err != nil:
+			logger.Warn("Could not load previous report, skipping diff", "error", err)
+		case len(previousRows) > 0:
+			var diffNotes []format.Note
+			rows, diffNotes = diff.Compare(previousRows, rows)
+			notes = append(notes, diffNotes...)
+			logger.Info("Diff completed", "previous_rows", len(previousRows),
+				"transitions", format.CountNotesByKind(diffNotes, format.NoteStatusChanged),
+				"new", format.CountNotesByKind(diffNotes, format.NoteNewItem),
+				"removed", format.CountNotesByKind(diffNotes, format.NoteRemovedItem),
+				"date_changes", format.CountNotesByKind(diffNotes, format.NoteTargetDateChanged))
+		default:
+			logger.Warn("Previous report contained no parseable rows, skipping diff")
 		}
 	}
 
@@ -273,17 +552,6 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Parse --columns flag
-	var extraColumns []string
-	if columns != "" {
-		for _, col := range strings.Split(columns, ",") {
-			col = strings.TrimSpace(col)
-			if col != "" {
-				extraColumns = append(extraColumns, col)
-			}
-		}
-	}
-
 	// Parse --group-by flag
 	var groupConfig *format.GroupConfig
 	if groupBy != "" {
@@ -294,13 +562,250 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		groupConfig = &gc
 	}
 
+	renderOpts := generateRenderOptions{
+		Table:      tableOpts,
+		Groups:     groupConfig,
+		Title:      title,
+		HeaderText: headerText,
+		Compact:    outputFormat == "compact",
+		AllowEmpty: allowEmpty,
+		WithGoals:  withGoals,
+		Goals:      goals,
+	}
+	if splitByStatus {
+		renderOpts.SplitDir = outputDir
+	}
+
 	// Generate output
-	return renderGenerateOutput(rows, notes, cfg, logger, extraColumns, groupConfig, headerText)
+	if err := renderGenerateOutput(rows, notes, cfg, logger, renderOpts); err != nil {
+		return err
+	}
+	return checkReportHealth(rows, notes, errorCount)
+}
+
+// collectionErrors tallies the issues that failed during collection
+type collectionErrors struct {
+	total        int
+	inaccessible int
+}
+
+// record counts err; unreadable issues are tallied into one note instead of a line each
+func (c *collectionErrors) record(err error, cfg *config.Config, logger *slog.Logger) {
+	c.total++
+	if errors.Is(err, github.ErrInaccessible) {
+		c.inaccessible++
+		logger.Debug("Skipping inaccessible issue", "error", err)
+		return
+	}
+	if !cfg.Quiet {
+		fmt.Fprintf(os.Stderr, "Error collecting data for issue: %v\n", err)
+	}
+	logger.Debug("Error collecting issue data", "error", err)
+}
+
+// appendNote adds the skipped-inaccessible note to notes when any issue was unreadable
+func (c *collectionErrors) appendNote(notes []format.Note, logger *slog.Logger) []format.Note {
+	if c.inaccessible == 0 {
+		return notes
+	}
+	logger.Warn("Skipped issues the token can't access", "count", c.inaccessible)
+	return append(notes, format.Note{Kind: format.NoteSkippedInaccessible, Count: c.inaccessible})
+}
+
+// loadPreviousReport reads and parses the --previous-report file, or fetches
+// the report at --previous-url; a markdown table's date column is found by the
+// --headers date header when headers is set
+func loadPreviousReport(ctx context.Context, cfg *config.Config, headers []string, logger *slog.Logger) ([]diff.PreviousRow, error) {
+	dateHeader := ""
+	if len(headers) == len(format.DefaultTableHeaders) {
+		dateHeader = headers[2]
+	}
+
+	if previousReportURL != "" {
+		logger.Info("Comparing with previous report", "url", previousReportURL)
+		return diff.FetchPreviousReport(ctx, previousReportURL, cfg.Proxy, dateHeader)
+	}
+
+	logger.Info("Comparing with previous report", "path", previousReportPath)
+	prevContent, err := os.ReadFile(previousReportPath) //nolint:gosec // user-supplied CLI path
+	if err != nil {
+		return nil, err
+	}
+	return diff.ParsePreviousReportWithDateHeader(string(prevContent), dateHeader)
+}
+
+// checkStreamFlags rejects options that need every row before anything is printed
+func checkStreamFlags() error {
+	conflicts := []struct {
+		set  bool
+		name string
+	}{
+		{groupBy != "", "--group-by"},
+		{splitByStatus, "--split-by-status"},
+		{mergeByTitle, "--merge-by-title"},
+		{countOnly, "--count-only"},
+		{previousReportPath != "", "--previous-report"},
+		{previousReportURL != "", "--previous-url"},
+		{summaryHeader, "--summary-header"},
+	}
+	for _, conflict := range conflicts {
+		if conflict.set {
+			return fmt.Errorf("--stream cannot be combined with %s", conflict.name)
+		}
+	}
+	return nil
+}
+
+// streamGenerate collects and summarizes issues in parallel and prints each
+// issue's rows as soon as it completes, in completion order rather than
+// sorted, then prints the notes section once every issue is done
+func streamGenerate(ctx context.Context, cfg *config.Config, logger *slog.Logger, summarizer ai.Summarizer, issueRefs []input.IssueRef, permits pipeline.Permits, collect pipeline.CollectFunc, reporter *progress.Reporter, opts generateRenderOptions) error {
+	var mu sync.Mutex
+	batchResults := make(map[string]ai.BatchResult)
+
+	// Summarize each issue on its own inside the worker so slow model calls overlap
+	summarizeCollect := func(ctx context.Context, ref input.IssueRef) (pipeline.IssueData, error) {
+		data, err := collect(ctx, ref)
+		if err != nil || data.Excluded || !cfg.Models.Enabled {
+			return data, err
+		}
+		results, err := pipeline.BatchSummarize(ctx, summarizer, []pipeline.IssueData{data}, logger)
+		if err != nil {
+			logger.Warn("Summarization failed, using fallback", "url", data.IssueURL, "error", err)
+			return data, nil
+		}
+		mu.Lock()
+		maps.Copy(batchResults, results)
+		mu.Unlock()
+		return data, nil
+	}
+
+	var allData []pipeline.IssueData
+	var rows []format.Row
+	var notes []format.Note
+	var collectErrs collectionErrors
+	headerPrinted := false
+
+	pipeline.CollectEach(ctx, issueRefs, permits, summarizeCollect, func(result pipeline.IssueDataResult) {
+		if result.Err != nil {
+			collectErrs.record(pipeline.IssueError{URL: result.URL, Err: result.Err}, cfg, logger)
+			return
+		}
+		if result.Data.Excluded {
+			logger.Debug("Issue excluded by its report", "url", result.URL)
+			return
+		}
+		allData = append(allData, result.Data)
+
+		mu.Lock()
+		issueRows, issueNotes := pipeline.AssembleGenerateResults([]pipeline.IssueData{result.Data}, batchResults, cfg.Models.Sentiment, logger)
+		mu.Unlock()
+		notes = append(notes, issueNotes...)
+
+		for _, row := range issueRows {
+			if !headerPrinted {
+				headerPrinted = true
+				if opts.Title != "" {
+					fmt.Printf("# %s\n\n", opts.Title)
+				}
+				if !opts.Compact {
+					fmt.Print(format.RenderEmptyTable(opts.Table))
+				}
+			}
+			if opts.Compact {
+				fmt.Print(format.RenderCompact([]format.Row{row}))
+			} else {
+				fmt.Print(format.RenderTableRow(row, opts.Table))
+			}
+			rows = append(rows, row)
+		}
+	})
+	reporter.Done()
+	logger.Info("Streaming completed", "rows", len(rows), "errors", collectErrs.total)
+
+	if err := checkRunTimeout(ctx, cfg.Timeout); err != nil {
+		return err
+	}
+
+	notes = collectErrs.appendNote(notes, logger)
+	if len(rows) == 0 {
+		if opts.AllowEmpty {
+			printEmptyReport(opts.Table, opts.Compact)
+			return nil
+		}
+		if !cfg.Quiet {
+			fmt.Fprintf(os.Stderr, "No report rows generated\n")
+		}
+		return config.ErrNoRows
+	}
+
+	writeLegend(os.Stdout, rows)
+	writeNotes(os.Stdout, notes, cfg, logger, true)
+
+	if cfg.Models.Enabled && cfg.Models.Strict {
+		mu.Lock()
+		missing := pipeline.MissingBatchResults(allData, batchResults)
+		mu.Unlock()
+		if err := config.CheckAIResults(missing, len(allData)); err != nil {
+			return err
+		}
+	}
+	return checkReportHealth(rows, notes, collectErrs.total)
+}
+
+// checkReportHealth applies the post-render --fail-on-errors and --fail-on-stale
+// checks; collection failures take precedence
+func checkReportHealth(rows []format.Row, notes []format.Note, errorCount int) error {
+	if err := config.CheckCollectionErrors(errorCount, failOnErrors); err != nil {
+		return err
+	}
+	return config.CheckStaleItems(format.CountStaleItems(rows, notes), failOnStale)
+}
+
+// renderStatusCounts prints the per-status tally for --count-only
+func renderStatusCounts(rows []format.Row, cfg *config.Config, allowEmpty bool) error {
+	if len(rows) == 0 {
+		if allowEmpty {
+			return nil
+		}
+		if !cfg.Quiet {
+			fmt.Fprintf(os.Stderr, "No report rows generated\n")
+		}
+		return config.ErrNoRows
+	}
+
+	fmt.Print(format.RenderStatusCounts(rows))
+	return nil
+}
+
+// generateRenderOptions collects the layout choices for renderGenerateOutput
+type generateRenderOptions struct {
+	Table      format.TableOptions
+	Groups     *format.GroupConfig  // nil renders a single table
+	Title      string               // Rendered as a top-level heading when set
+	HeaderText string               // Executive summary printed above the table
+	SplitDir   string               // When set, tables are written per status into this directory
+	Compact    bool                 // One line per row instead of a table
+	AllowEmpty bool                 // Print an empty table instead of returning config.ErrNoRows
+	WithGoals  bool                 // Wrap the report in a status section followed by a goals section
+	Goals      []format.DescribeRow // Rows of the goals section when WithGoals is set
+}
+
+// printEmptyReport prints the table header with no data rows for --allow-empty;
+// tableless outputs (compact, split files, counts) print nothing
+func printEmptyReport(table format.TableOptions, tableless bool) {
+	if !tableless {
+		fmt.Print(format.RenderEmptyTable(table))
+	}
 }
 
 // renderGenerateOutput sorts, renders, and prints the report output
-func renderGenerateOutput(rows []format.Row, notes []format.Note, cfg *config.Config, logger *slog.Logger, extraColumns []string, groupConfig *format.GroupConfig, headerText string) error {
+func renderGenerateOutput(rows []format.Row, notes []format.Note, cfg *config.Config, logger *slog.Logger, opts generateRenderOptions) error {
 	if len(rows) == 0 {
+		if opts.AllowEmpty {
+			printEmptyReport(opts.Table, opts.Compact || opts.SplitDir != "")
+			return nil
+		}
 		if !cfg.Quiet {
 			fmt.Fprintf(os.Stderr, "No report rows generated\n")
 		}
@@ -309,37 +814,84 @@ func renderGenerateOutput(rows []format.Row, notes []format.Note, cfg *config.Co
 
 	format.SortRowsByTargetDate(rows)
 
-	if headerText != "" {
-		fmt.Println(headerText)
+	if opts.Title != "" {
+		fmt.Printf("# %s\n\n", opts.Title)
+	}
+
+	if opts.HeaderText != "" {
+		fmt.Println(opts.HeaderText)
 		fmt.Println()
 	}
 
+	// The status report is buffered so --with-goals can wrap it in its section
+	var out strings.Builder
 	logger.Info("Rendering output...", "rows", len(rows))
-	if groupConfig != nil {
-		groups := format.GroupRows(rows, *groupConfig)
+	switch {
+	case opts.Compact:
+		out.WriteString(format.RenderCompact(rows))
+	case opts.SplitDir != "":
+		written, err := format.WriteStatusFiles(opts.SplitDir, rows, opts.Table)
+		if err != nil {
+			return err
+		}
+		for _, path := range written {
+			logger.Info("Wrote status file", "path", path)
+		}
+	case opts.Groups != nil:
+		groups := format.GroupRows(rows, *opts.Groups)
 		for i, group := range groups {
 			if i > 0 {
-				fmt.Print("\n")
+				out.WriteString("\n")
 			}
-			fmt.Print(format.RenderTableWithTitle(group.Title, group.Rows, extraColumns))
+			out.WriteString(format.RenderTableWithTitle(group.Title, group.Rows, opts.Table))
 		}
-	} else {
-		table := format.RenderTable(rows, extraColumns)
-		fmt.Print(table)
+	default:
+		out.WriteString(format.RenderTableWithOptions(rows, opts.Table))
 	}
 
-	if cfg.Notes && len(notes) > 0 {
-		logger.Debug("Adding notes section", "notes", len(notes))
-		fmt.Print("\n")
-		var notesSection string
-		if collapsibleNotes {
-			notesSection = format.RenderNotesCollapsible(notes)
-		} else {
-			notesSection = format.RenderNotes(notes)
-		}
-		fmt.Print(notesSection)
+	if opts.SplitDir == "" {
+		writeLegend(&out, rows)
+	}
+	writeNotes(&out, notes, cfg, logger, opts.SplitDir == "")
+
+	if opts.WithGoals {
+		fmt.Print(format.RenderStatusAndGoals(out.String(), opts.Goals))
+	} else {
+		fmt.Print(out.String())
 	}
 
 	logger.Info("Report generated successfully", "rows", len(rows), "notes", len(notes))
 	return nil
 }
+
+// writeLegend writes the status key for --legend and --full-legend after a
+// blank line
+func writeLegend(w io.Writer, rows []format.Row) {
+	var key string
+	switch {
+	case fullLegend:
+		key = format.RenderLegend()
+	case legend:
+		key = format.RenderRowLegend(rows)
+	}
+	if key != "" {
+		fmt.Fprint(w, "\n"+key)
+	}
+}
+
+// writeNotes writes the notes section when notes are enabled, preceded by a
+// blank line when it follows a table
+func writeNotes(w io.Writer, notes []format.Note, cfg *config.Config, logger *slog.Logger, afterTable bool) {
+	if showUpdateCount {
+		// The counts in the table already say which items had several updates
+		notes = format.ExcludeNotesByKind(notes, format.NoteMultipleUpdates)
+	}
+	if !cfg.Notes || len(notes) == 0 {
+		return
+	}
+	logger.Debug("Adding notes section", "notes", len(notes))
+	if afterTable {
+		fmt.Fprint(w, "\n")
+	}
+	fmt.Fprint(w, format.RenderNotesWithOptions(notes, format.NotesOptions{Collapsible: collapsibleNotes, Plain: plainNotes}))
+}
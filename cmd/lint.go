@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Attamusc/weekly-report-cli/internal/config"
+	"github.com/Attamusc/weekly-report-cli/internal/github"
+	"github.com/Attamusc/weekly-report-cli/internal/httpclient"
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+	"github.com/Attamusc/weekly-report-cli/internal/report"
+)
+
+var (
+	lintVerbose    bool
+	lintReportKeys *report.KeyNames
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint <comment-url-or-file>",
+	Short: "Check a report comment's data markers",
+	Long: `Lint fetches an issue comment (or reads a file, or stdin with "-") and checks
+its <!-- data ... --> markers the way generate parses them. It prints whether
+the comment parses as a report, the data keys found, and any malformed markers
+such as a block missing <!-- data end -->. Exits non-zero when problems are found.
+
+Examples:
+  weekly-report-cli lint "https://github.com/org/repo/issues/42#issuecomment-1234567"
+  weekly-report-cli lint update.md
+  pbpaste | weekly-report-cli lint -`,
+	Args: cobra.ExactArgs(1),
+	RunE: runLint,
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+
+	lintCmd.Flags().BoolVar(&lintVerbose, "verbose", false, "Enable verbose progress output")
+	lintReportKeys = addReportKeyFlags(lintCmd)
+}
+
+func runLint(cmd *cobra.Command, args []string) error {
+	// Files and stdin are linted without loading config, so the key names are checked here
+	if err := lintReportKeys.Validate(); err != nil {
+		return fmt.Errorf("invalid report key names: %w", err)
+	}
+
+	body, err := readLintBody(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	result := report.LintReportWithKeys(body, lintReportKeys.Compile())
+	fmt.Fprint(cmd.OutOrStdout(), renderLintResult(result))
+
+	if len(result.Problems) > 0 {
+		return fmt.Errorf("report comment has %d problem(s)", len(result.Problems))
+	}
+	return nil
+}
+
+// readLintBody returns the comment body from a comment URL, a file, or stdin ("-")
+func readLintBody(cmd *cobra.Command, source string) (string, error) {
+	if source == "-" {
+		data, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return "", fmt.Errorf("failed to read stdin: %w", err)
+		}
+		return string(data), nil
+	}
+
+	if !strings.HasPrefix(source, "https://") {
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", source, err)
+		}
+		return string(data), nil
+	}
+
+	ref, commentID, err := input.ParseCommentURL(source)
+	if err != nil {
+		return "", err
+	}
+
+	cfg, err := config.FromEnvAndFlags(config.ConfigInput{Verbose: lintVerbose, LogFormat: logFormat, TokenFile: tokenFile, Proxy: proxyURL, ConfigURL: configURL})
+	if err != nil {
+		return "", fmt.Errorf("configuration error: %w", err)
+	}
+
+	logger := setupLogger(cfg)
+	ctx := context.WithValue(context.Background(), input.LoggerContextKey{}, logger)
+
+	client := github.New(ctx, cfg.GitHubToken, httpclient.NewTransport(cfg.Proxy))
+	comment, err := github.FetchComment(ctx, client, ref, commentID)
+	if err != nil {
+		return "", err
+	}
+	return comment.Body, nil
+}
+
+// renderLintResult formats a lint result for the terminal
+func renderLintResult(result report.LintResult) string {
+	var builder strings.Builder
+
+	parsed := "no"
+	if result.Parsed {
+		parsed = "yes"
+	}
+	builder.WriteString(fmt.Sprintf("Parsed as report: %s\n", parsed))
+
+	keys := "none"
+	if len(result.Keys) > 0 {
+		keys = strings.Join(result.Keys, ", ")
+	}
+	builder.WriteString(fmt.Sprintf("Keys found: %s\n", keys))
+
+	if len(result.Problems) == 0 {
+		builder.WriteString("Problems: none\n")
+		return builder.String()
+	}
+	builder.WriteString("Problems:\n")
+	for _, problem := range result.Problems {
+		builder.WriteString(fmt.Sprintf("  - %s\n", problem))
+	}
+	return builder.String()
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Attamusc/weekly-report-cli/internal/config"
+	"github.com/Attamusc/weekly-report-cli/internal/httpclient"
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+	"github.com/Attamusc/weekly-report-cli/internal/projects"
+)
+
+var projectsVerbose bool
+
+var projectsCmd = &cobra.Command{
+	Use:   "projects",
+	Short: "Inspect GitHub project boards",
+	Long:  `Commands for inspecting GitHub project boards used as report input.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = cmd.Help()
+	},
+}
+
+var projectsViewsCmd = &cobra.Command{
+	Use:   "views <project>",
+	Short: "List a project board's views",
+	Long: `List the views defined on a GitHub project board, showing each view's ID, name,
+layout, and filter string. Use the name with --project-view or the ID with
+--project-view-id.
+
+Examples:
+  weekly-report-cli projects views "org:my-org/5"
+  weekly-report-cli projects views "https://github.com/users/me/projects/2"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProjectsViews,
+}
+
+func init() {
+	rootCmd.AddCommand(projectsCmd)
+	projectsCmd.AddCommand(projectsViewsCmd)
+
+	projectsViewsCmd.Flags().BoolVar(&projectsVerbose, "verbose", false, "Enable verbose progress output")
+}
+
+func runProjectsViews(cmd *cobra.Command, args []string) error {
+	cfg, err := config.FromEnvAndFlags(config.ConfigInput{Verbose: projectsVerbose, LogFormat: logFormat, TokenFile: tokenFile, Proxy: proxyURL, ConfigURL: configURL})
+	if err != nil {
+		return fmt.Errorf("configuration error: %w", err)
+	}
+
+	ref, err := projects.ParseProjectURL(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid project URL: %w", err)
+	}
+
+	logger := setupLogger(cfg)
+	ctx := context.WithValue(context.Background(), input.LoggerContextKey{}, logger)
+
+	client := projects.NewClient(cfg.GitHubToken)
+	client.SetTransport(httpclient.NewTransport(cfg.Proxy))
+	views, err := client.FetchProjectViews(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	if len(views) == 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "No views found for project %s\n", ref.String())
+		return nil
+	}
+
+	fmt.Fprint(cmd.OutOrStdout(), renderProjectViews(views))
+	return nil
+}
+
+// renderProjectViews formats views as a markdown table
+func renderProjectViews(views []projects.ProjectView) string {
+	var builder strings.Builder
+	builder.WriteString("| ID | Name | Layout | Filter |\n")
+	builder.WriteString("|----|------|--------|--------|\n")
+	for _, view := range views {
+		filter := view.Filter
+		if filter == "" {
+			filter = "-"
+		}
+		builder.WriteString(fmt.Sprintf("| %s | %s | %s | %s |\n",
+			view.ID,
+			strings.ReplaceAll(view.Name, "|", "\\|"),
+			view.Layout,
+			strings.ReplaceAll(filter, "|", "\\|")))
+	}
+	return builder.String()
+}
@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"errors"
 	"fmt"
 	"os"
 
@@ -26,14 +25,24 @@ with optional AI summarization.`,
 
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
-		if errors.Is(err, config.ErrNoRows) {
-			os.Exit(2)
+		code := config.ExitCode(err)
+		if code != config.ExitNoRows {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
 		}
-		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
-		os.Exit(1)
+		os.Exit(code)
 	}
 }
 
+var (
+	logFormat string // Progress log format for every command
+	tokenFile string // File holding the GitHub token (e.g. a mounted secret)
+	proxyURL  string // Proxy for all API clients, overriding HTTP(S)_PROXY
+	configURL string // Remote config with organization-wide defaults
+)
+
 func init() {
-	// Global flags will be added here when generate command is implemented
+	rootCmd.PersistentFlags().StringVar(&tokenFile, "token-file", "", "Read the GitHub token from a file when GITHUB_TOKEN is unset (overrides GITHUB_TOKEN_FILE)")
+	rootCmd.PersistentFlags().StringVar(&proxyURL, "proxy", "", "Proxy URL for all GitHub and Models API requests (overrides HTTP_PROXY/HTTPS_PROXY)")
+	rootCmd.PersistentFlags().StringVar(&configURL, "config-url", "", "URL of a JSON config with organization defaults for model, summary_prompt and describe_prompt (overrides WEEKLY_REPORT_CONFIG_URL)")
+	rootCmd.PersistentFlags().StringVar(&logFormat, "log-format", "text", "Progress log format on stderr: 'text' or 'json' (keeps timestamps)")
 }
@@ -3,10 +3,14 @@ package ai
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"unicode/utf8"
+
+	"github.com/Attamusc/weekly-report-cli/internal/config"
 )
 
 func TestGHModelsClient_SummarizeBatch(t *testing.T) {
@@ -155,6 +159,31 @@ func TestGHModelsClient_SummarizeBatch(t *testing.T) {
 	}
 }
 
+func TestGHModelsClient_SummarizeBatchTrimsToCap(t *testing.T) {
+	items := []BatchItem{{IssueURL: "https://github.com/org/repo/issues/1", IssueTitle: "Feature A", UpdateTexts: []string{"Long update"}}}
+	long := strings.Repeat("word ", 50) + "end."
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, _ := json.Marshal(map[string]map[string]any{
+			items[0].IssueURL: {"summary": long, "sentiment": nil},
+		})
+		resp := chatCompletionResponse{Choices: []choice{{Message: message{Role: "assistant", Content: string(content)}}}}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "gpt-4o-mini", "test-token", "", 0)
+	client.MaxSummaryWords = 10
+
+	results, err := client.SummarizeBatch(context.Background(), items)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := strings.TrimSpace(strings.Repeat("word ", 10)) + "…"
+	if got := results[items[0].IssueURL].Summary; got != want {
+		t.Errorf("got summary %q, want %q", got, want)
+	}
+}
+
 func TestGHModelsClient_buildBatchPrompt(t *testing.T) {
 	client := NewGHModelsClient("http://test", "test-model", "test-token", "", 0)
 
@@ -234,6 +263,43 @@ func TestBuildBatchPrompt_IncludesReportedStatus(t *testing.T) {
 	}
 }
 
+func TestBuildBatchPrompt_IncludesHint(t *testing.T) {
+	client := NewGHModelsClient("http://test", "test-model", "test-token", "", 0)
+
+	items := []BatchItem{
+		{
+			IssueURL:       "https://github.com/org/repo/issues/1",
+			IssueTitle:     "Feature A",
+			UpdateTexts:    []string{"Update 1"},
+			ReportedStatus: "On Track",
+			Hint:           "Focus on the migration timeline",
+		},
+		{
+			IssueURL:       "https://github.com/org/repo/issues/2",
+			IssueTitle:     "Bug B",
+			UpdateTexts:    []string{"Update 2"},
+			ReportedStatus: "On Track",
+		},
+	}
+
+	prompt, err := client.buildBatchPrompt(items)
+	if err != nil {
+		t.Fatalf("buildBatchPrompt failed: %v", err)
+	}
+
+	var batchReq batchRequest
+	if err := json.Unmarshal([]byte(prompt), &batchReq); err != nil {
+		t.Fatalf("Prompt is not valid JSON: %v", err)
+	}
+
+	if batchReq.Items[0].Hint != "Focus on the migration timeline" {
+		t.Errorf("Expected hint on first item, got %q", batchReq.Items[0].Hint)
+	}
+	if strings.Count(prompt, `"hint"`) != 1 {
+		t.Errorf("Expected hint field only on the item that has one, got: %s", prompt)
+	}
+}
+
 func TestGHModelsClient_parseBatchResponse(t *testing.T) {
 	client := NewGHModelsClient("http://test", "test-model", "test-token", "", 0)
 
@@ -405,6 +471,84 @@ Markdown summary for bug B.`
 	}
 }
 
+func TestParseBatchResponse_MarkdownTableFallback(t *testing.T) {
+	client := NewGHModelsClient("http://test", "test-model", "test-token", "", 0)
+
+	items := []BatchItem{
+		{IssueURL: "https://github.com/org/repo/issues/1", IssueTitle: "Feature A"},
+		{IssueURL: "https://github.com/org/repo/issues/2", IssueTitle: "Bug B"},
+	}
+
+	response := `Here are the summaries:
+
+| URL | Summary |
+|-----|---------|
+| https://github.com/org/repo/issues/1 | Table summary for feature A. |
+| [#2](https://github.com/org/repo/issues/2) | Table summary for bug B. |`
+
+	result, err := client.parseBatchResponse(response, items)
+	if err != nil {
+		t.Fatalf("parseBatchResponse failed: %v", err)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(result))
+	}
+
+	want := map[string]string{
+		"https://github.com/org/repo/issues/1": "Table summary for feature A.",
+		"https://github.com/org/repo/issues/2": "Table summary for bug B.",
+	}
+	for url, summary := range want {
+		r := result[url]
+		if r.Summary != summary {
+			t.Errorf("Summary for %s = %q, want %q", url, r.Summary, summary)
+		}
+		if r.Sentiment != nil {
+			t.Errorf("Expected nil sentiment for table fallback, got %+v", r.Sentiment)
+		}
+	}
+}
+
+func TestParseBatchResponse_MarkdownTableExactURLs(t *testing.T) {
+	client := NewGHModelsClient("http://test", "test-model", "test-token", "", 0)
+
+	// issues/10 contains issues/1 as a substring and sorts after it
+	items := []BatchItem{
+		{IssueURL: "https://github.com/org/repo/issues/1", IssueTitle: "One"},
+		{IssueURL: "https://github.com/org/repo/issues/10", IssueTitle: "Ten"},
+	}
+
+	response := `| URL | Summary |
+|-----|---------|
+| [#10](https://github.com/org/repo/issues/10) | Summary for ten. |
+| <https://github.com/org/repo/issues/1/> | Summary for one. |`
+
+	result, err := client.parseBatchResponse(response, items)
+	if err != nil {
+		t.Fatalf("parseBatchResponse failed: %v", err)
+	}
+
+	want := map[string]string{
+		"https://github.com/org/repo/issues/1":  "Summary for one.",
+		"https://github.com/org/repo/issues/10": "Summary for ten.",
+	}
+	for url, summary := range want {
+		if got := result[url].Summary; got != summary {
+			t.Errorf("Summary for %s = %q, want %q", url, got, summary)
+		}
+	}
+}
+
+func TestParseBatchResponse_Unparseable(t *testing.T) {
+	client := NewGHModelsClient("http://test", "test-model", "test-token", "", 0)
+	items := []BatchItem{{IssueURL: "https://github.com/org/repo/issues/1"}}
+
+	if _, err := client.parseBatchResponse("I could not summarize these.", items); err == nil {
+		t.Error("Expected error for unparseable response")
+	}
+}
+
 func TestParseBatchResponse_MixedSentiment(t *testing.T) {
 	client := NewGHModelsClient("http://test", "test-model", "test-token", "", 0)
 
@@ -515,6 +659,37 @@ func TestNoopSummarizer_SummarizeBatch(t *testing.T) {
 	}
 }
 
+func TestNoopSummarizer_Describe(t *testing.T) {
+	summarizer := NewNoopSummarizer()
+
+	result, err := summarizer.Describe(context.Background(), "Feature A", "https://github.com/org/repo/issues/1", "  Build the thing.\n")
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	if result != "Build the thing." {
+		t.Errorf("Expected trimmed body, got %q", result)
+	}
+
+	long := strings.Repeat("a", 600)
+	result, err = summarizer.Describe(context.Background(), "Feature B", "https://github.com/org/repo/issues/2", long)
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	if result != long[:500]+"..." {
+		t.Errorf("Expected body truncated to 500 characters, got %d characters", len(result))
+	}
+
+	// A multibyte character straddling byte 500 must not be split
+	multibyte := strings.Repeat("a", 499) + "日本語"
+	result, err = summarizer.Describe(context.Background(), "Feature C", "https://github.com/org/repo/issues/3", multibyte)
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	if !utf8.ValidString(result) || result != strings.Repeat("a", 499)+"日..." {
+		t.Errorf("Expected rune-safe truncation to 500 characters, got %q", result[len(result)-10:])
+	}
+}
+
 func TestNoopSummarizer_GenerateHeader(t *testing.T) {
 	s := NewNoopSummarizer()
 
@@ -577,3 +752,188 @@ func TestNoopSummarizer_GenerateHeader(t *testing.T) {
 		}
 	})
 }
+
+func TestGHModelsClient_SummarizeBatch_UsesModelFlag(t *testing.T) {
+	var gotModel string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatCompletionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		gotModel = req.Model
+
+		_ = json.NewEncoder(w).Encode(chatCompletionResponse{
+			Choices: []choice{{Message: message{Role: "assistant", Content: `{"https://github.com/o/r/issues/1": {"summary": "Done."}}`}}},
+		})
+	}))
+	defer server.Close()
+
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	t.Setenv("GITHUB_MODELS_MODEL", "env-model")
+	cfg, err := config.FromEnvAndFlags(config.ConfigInput{Model: "flag-model", ModelsBaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("unexpected config error: %v", err)
+	}
+
+	client := NewGHModelsClient(cfg.Models.BaseURL, cfg.Models.Model, cfg.GitHubToken, "", 0)
+	items := []BatchItem{{IssueURL: "https://github.com/o/r/issues/1", UpdateTexts: []string{"Shipped."}}}
+	if _, err := client.SummarizeBatch(context.Background(), items); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if gotModel != "flag-model" {
+		t.Errorf("request model = %q, want flag-model", gotModel)
+	}
+}
+
+// chunkingServer answers each batch request with a summary for every item in it,
+// failing the requests whose 1-based index is in failOn.
+func chunkingServer(t *testing.T, requests *int, failOn map[int]bool) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if failOn[*requests] {
+			http.Error(w, "context length exceeded", http.StatusBadRequest)
+			return
+		}
+
+		var req chatCompletionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		var batch batchRequest
+		if err := json.Unmarshal([]byte(req.Messages[len(req.Messages)-1].Content), &batch); err != nil {
+			t.Fatalf("Failed to decode batch prompt: %v", err)
+		}
+
+		response := make(map[string]sentimentResponseItem, len(batch.Items))
+		for _, item := range batch.Items {
+			response[item.ID] = sentimentResponseItem{Summary: "Summary for " + item.Issue}
+		}
+		content, _ := json.Marshal(response)
+		_ = json.NewEncoder(w).Encode(chatCompletionResponse{
+			Choices: []choice{{Message: message{Role: "assistant", Content: string(content)}}},
+		})
+	}))
+}
+
+func chunkingItems(n int) []BatchItem {
+	items := make([]BatchItem, n)
+	for i := range items {
+		items[i] = BatchItem{
+			IssueURL:    fmt.Sprintf("https://github.com/org/repo/issues/%d", i+1),
+			IssueTitle:  fmt.Sprintf("Issue %d", i+1),
+			UpdateTexts: []string{"Progress made"},
+		}
+	}
+	return items
+}
+
+func TestGHModelsClient_SummarizeBatch_Chunking(t *testing.T) {
+	const chunkSize = 3
+	requests := 0
+	server := chunkingServer(t, &requests, nil)
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "test-model", "test-token", "", 0)
+	client.BatchSize = chunkSize
+
+	// 2 full chunks plus 1 leftover item → 3 requests
+	items := chunkingItems(2*chunkSize + 1)
+	result, err := client.SummarizeBatch(context.Background(), items)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if requests != 3 {
+		t.Errorf("Expected 3 chunk requests, got %d", requests)
+	}
+	if len(result) != len(items) {
+		t.Errorf("Expected %d results, got %d", len(items), len(result))
+	}
+}
+
+func TestGHModelsClient_BatchPromptOrderIsStable(t *testing.T) {
+	var prompts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatCompletionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("Failed to decode request: %v", err)
+		}
+		prompts = append(prompts, req.Messages[1].Content)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "{}"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "test-model", "test-token", "", 0)
+
+	a := BatchItem{IssueURL: "https://github.com/org/repo/issues/1", IssueTitle: "A", UpdateTexts: []string{"one"}}
+	b := BatchItem{IssueURL: "https://github.com/org/repo/issues/2", IssueTitle: "B", UpdateTexts: []string{"two"}}
+	c := BatchItem{IssueURL: "https://github.com/other/repo/issues/3", IssueTitle: "C", UpdateTexts: []string{"three"}}
+
+	_, _ = client.SummarizeBatch(context.Background(), []BatchItem{c, a, b})
+	_, _ = client.SummarizeBatch(context.Background(), []BatchItem{b, c, a})
+
+	da := DescribeBatchItem{IssueURL: a.IssueURL, IssueTitle: "A", IssueBody: "alpha"}
+	db := DescribeBatchItem{IssueURL: b.IssueURL, IssueTitle: "B", IssueBody: "beta"}
+	_, _ = client.DescribeBatch(context.Background(), []DescribeBatchItem{db, da})
+	_, _ = client.DescribeBatch(context.Background(), []DescribeBatchItem{da, db})
+
+	if len(prompts) != 4 {
+		t.Fatalf("Expected 4 requests, got %d", len(prompts))
+	}
+	if prompts[0] != prompts[1] {
+		t.Errorf("Expected identical summarize prompts for reordered items\nfirst:  %s\nsecond: %s", prompts[0], prompts[1])
+	}
+	if prompts[2] != prompts[3] {
+		t.Errorf("Expected identical describe prompts for reordered items\nfirst:  %s\nsecond: %s", prompts[2], prompts[3])
+	}
+
+	var req batchRequest
+	if err := json.Unmarshal([]byte(prompts[0]), &req); err != nil {
+		t.Fatalf("Failed to decode batch prompt: %v", err)
+	}
+	for i, want := range []string{a.IssueURL, b.IssueURL, c.IssueURL} {
+		if req.Items[i].ID != want {
+			t.Errorf("Item %d: expected %s, got %s", i, want, req.Items[i].ID)
+		}
+	}
+}
+
+func TestGHModelsClient_SummarizeBatch_ChunkFailureKeepsOthers(t *testing.T) {
+	requests := 0
+	server := chunkingServer(t, &requests, map[int]bool{2: true})
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "test-model", "test-token", "", 0)
+	client.BatchSize = 2
+
+	items := chunkingItems(5)
+	result, err := client.SummarizeBatch(context.Background(), items)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(result) != 3 {
+		t.Fatalf("Expected 3 results from surviving chunks, got %d", len(result))
+	}
+	for _, missing := range []string{items[2].IssueURL, items[3].IssueURL} {
+		if _, ok := result[missing]; ok {
+			t.Errorf("Expected no result for %s from the failed chunk", missing)
+		}
+	}
+}
+
+func TestGHModelsClient_SummarizeBatch_AllChunksFail(t *testing.T) {
+	requests := 0
+	server := chunkingServer(t, &requests, map[int]bool{1: true, 2: true})
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "test-model", "test-token", "", 0)
+	client.BatchSize = 2
+
+	if _, err := client.SummarizeBatch(context.Background(), chunkingItems(3)); err == nil {
+		t.Error("Expected error when every chunk fails")
+	}
+}
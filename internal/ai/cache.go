@@ -0,0 +1,183 @@
+package ai
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// SummaryCache stores batch summaries on disk, keyed by a hash of everything
+// that shapes the summary, so unchanged issues skip the API on later runs
+type SummaryCache struct {
+	dir string
+}
+
+// NewSummaryCache creates a summary cache rooted at dir
+func NewSummaryCache(dir string) *SummaryCache {
+	return &SummaryCache{dir: dir}
+}
+
+// DefaultSummaryCacheDir returns the per-user cache directory for summaries
+func DefaultSummaryCacheDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate user cache directory: %w", err)
+	}
+	return filepath.Join(base, "weekly-report-cli", "summaries"), nil
+}
+
+// summaryCacheEntry is the on-disk representation of a cached summary
+type summaryCacheEntry struct {
+	Summary   string           `json:"summary"`
+	Sentiment *SentimentResult `json:"sentiment,omitempty"`
+}
+
+// Load returns the cached result for key, if any
+func (c *SummaryCache) Load(key string) (BatchResult, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return BatchResult{}, false
+	}
+
+	var entry summaryCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Summary == "" {
+		return BatchResult{}, false
+	}
+
+	return BatchResult{Summary: entry.Summary, Sentiment: entry.Sentiment}, true
+}
+
+// Store writes result for key to the cache
+func (c *SummaryCache) Store(key string, result BatchResult) error {
+	data, err := json.Marshal(summaryCacheEntry{Summary: result.Summary, Sentiment: result.Sentiment})
+	if err != nil {
+		return fmt.Errorf("failed to encode summary cache entry: %w", err)
+	}
+
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create summary cache directory: %w", err)
+	}
+
+	// Write to a temp file first so concurrent runs never read a partial entry
+	tmp, err := os.CreateTemp(c.dir, "summary-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write summary cache entry: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write summary cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write summary cache entry: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), c.path(key)); err != nil {
+		return fmt.Errorf("failed to write summary cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// path returns the cache file for key
+func (c *SummaryCache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// SummaryCacheKey hashes the issue URL, its update texts, and the model,
+// prompt, and summary word cap in use; any change to these produces a
+// different key. Keys are per item, so they don't depend on where the item
+// sits in a batch.
+func SummaryCacheKey(item BatchItem, model, prompt string, maxWords int) string {
+	h := sha256.New()
+	// Length-prefix each field so adjacent values can't run together
+	write := func(s string) {
+		_, _ = fmt.Fprintf(h, "%d:%s|", len(s), s)
+	}
+	write(item.IssueURL)
+	for _, text := range item.UpdateTexts {
+		write(text)
+	}
+	write(item.ReportedStatus)
+	write(item.Hint)
+	write(model)
+	write(prompt)
+	write(strconv.Itoa(maxWords))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// CachingSummarizer wraps a Summarizer and serves SummarizeBatch results from
+// a SummaryCache, sending only uncached items to the wrapped summarizer
+type CachingSummarizer struct {
+	Summarizer
+	cache    *SummaryCache
+	model    string
+	prompt   string
+	maxWords int
+}
+
+// NewCachingSummarizer wraps inner with cache; model, prompt, and the summary
+// word cap (0 = untrimmed) are part of every cache key
+func NewCachingSummarizer(inner Summarizer, cache *SummaryCache, model, prompt string, maxWords int) *CachingSummarizer {
+	return &CachingSummarizer{
+		Summarizer: inner,
+		cache:      cache,
+		model:      model,
+		prompt:     prompt,
+		maxWords:   maxWords,
+	}
+}
+
+// SummarizeBatch returns cached results for unchanged items and summarizes the rest
+func (s *CachingSummarizer) SummarizeBatch(ctx context.Context, items []BatchItem) (map[string]BatchResult, error) {
+	logger := getContextLogger(ctx)
+
+	results := make(map[string]BatchResult, len(items))
+	keys := make(map[string]string, len(items))
+	var misses []BatchItem
+
+	for _, item := range items {
+		key := SummaryCacheKey(item, s.model, s.prompt, s.maxWords)
+		keys[item.IssueURL] = key
+		if cached, ok := s.cache.Load(key); ok {
+			results[item.IssueURL] = cached
+			continue
+		}
+		misses = append(misses, item)
+	}
+
+	logger.Debug("Summary cache lookup", "hits", len(results), "misses", len(misses))
+
+	if len(misses) == 0 {
+		return results, nil
+	}
+
+	fresh, err := s.Summarizer.SummarizeBatch(ctx, misses)
+	if err != nil {
+		if len(results) > 0 {
+			logger.Warn("Batch summarization failed, using cached summaries only", "error", err)
+			return results, nil
+		}
+		return nil, err
+	}
+
+	for url, result := range fresh {
+		results[url] = result
+		key, ok := keys[url]
+		if !ok || result.Summary == "" {
+			continue
+		}
+		if err := s.cache.Store(key, result); err != nil {
+			logger.Warn("Failed to write summary cache", "error", err)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,92 @@
+package ai
+
+import (
+	"context"
+	"testing"
+)
+
+// countingSummarizer records the items sent to SummarizeBatch.
+type countingSummarizer struct {
+	NoopSummarizer
+	batches [][]BatchItem
+}
+
+func (c *countingSummarizer) SummarizeBatch(ctx context.Context, items []BatchItem) (map[string]BatchResult, error) {
+	c.batches = append(c.batches, items)
+	return c.NoopSummarizer.SummarizeBatch(ctx, items)
+}
+
+func TestCachingSummarizer_HitOnSameContent(t *testing.T) {
+	inner := &countingSummarizer{}
+	s := NewCachingSummarizer(inner, NewSummaryCache(t.TempDir()), "test-model", "", 0)
+
+	items := []BatchItem{{IssueURL: "https://github.com/o/r/issues/1", UpdateTexts: []string{"Shipped v1"}}}
+
+	if _, err := s.SummarizeBatch(context.Background(), items); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result, err := s.SummarizeBatch(context.Background(), items)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inner.batches) != 1 {
+		t.Errorf("expected 1 call to the wrapped summarizer, got %d", len(inner.batches))
+	}
+	if got := result["https://github.com/o/r/issues/1"].Summary; got != "Shipped v1" {
+		t.Errorf("got cached summary %q, want %q", got, "Shipped v1")
+	}
+}
+
+func TestCachingSummarizer_MissOnChangedUpdate(t *testing.T) {
+	inner := &countingSummarizer{}
+	s := NewCachingSummarizer(inner, NewSummaryCache(t.TempDir()), "test-model", "", 0)
+
+	first := []BatchItem{
+		{IssueURL: "https://github.com/o/r/issues/1", UpdateTexts: []string{"Shipped v1"}},
+		{IssueURL: "https://github.com/o/r/issues/2", UpdateTexts: []string{"Designing"}},
+	}
+	if _, err := s.SummarizeBatch(context.Background(), first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second := []BatchItem{
+		first[0],
+		{IssueURL: "https://github.com/o/r/issues/2", UpdateTexts: []string{"Design approved"}},
+	}
+	result, err := s.SummarizeBatch(context.Background(), second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(inner.batches) != 2 {
+		t.Fatalf("expected 2 calls to the wrapped summarizer, got %d", len(inner.batches))
+	}
+	if len(inner.batches[1]) != 1 || inner.batches[1][0].IssueURL != "https://github.com/o/r/issues/2" {
+		t.Errorf("expected only the changed issue to be re-summarized, got %+v", inner.batches[1])
+	}
+	if got := result["https://github.com/o/r/issues/2"].Summary; got != "Design approved" {
+		t.Errorf("got summary %q, want %q", got, "Design approved")
+	}
+	if len(result) != 2 {
+		t.Errorf("expected 2 results, got %d", len(result))
+	}
+}
+
+func TestSummaryCacheKey_DependsOnModelPromptAndWordCap(t *testing.T) {
+	item := BatchItem{IssueURL: "https://github.com/o/r/issues/1", UpdateTexts: []string{"Shipped"}}
+
+	base := SummaryCacheKey(item, "model-a", "", 35)
+	if base != SummaryCacheKey(item, "model-a", "", 35) {
+		t.Error("expected identical inputs to produce the same key")
+	}
+	if base == SummaryCacheKey(item, "model-b", "", 35) {
+		t.Error("expected model to change the key")
+	}
+	if base == SummaryCacheKey(item, "model-a", "custom prompt", 35) {
+		t.Error("expected prompt to change the key")
+	}
+	if base == SummaryCacheKey(item, "model-a", "", 20) || base == SummaryCacheKey(item, "model-a", "", 0) {
+		t.Error("expected the word cap to change the key")
+	}
+}
@@ -4,12 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/Attamusc/weekly-report-cli/internal/input"
@@ -23,6 +29,19 @@ type GHModelsClient struct {
 	Model        string
 	Token        string
 	SystemPrompt string
+	BatchSize    int // Maximum items per batch request (0 = maxBatchSize)
+
+	// FallbackModel gets one attempt when Model is still rate limited after
+	// every retry (empty = no fallback)
+	FallbackModel string
+
+	// Language, when set, asks for every response in that language
+	// ("Respond in <Language>.") on top of whichever system prompt is used
+	Language string
+
+	// MaxSummaryWords caps the length of every summary; longer ones are
+	// trimmed by TrimSummary (0 = no trimming)
+	MaxSummaryWords int
 }
 
 // NewGHModelsClient creates a new GitHub Models API client
@@ -62,7 +81,7 @@ type choice struct {
 
 const (
 	defaultSystemPrompt = `Refine the content in the engineering status updates to be one
-	paragraph of roughly 3-5 sentences, present tense, third-person, markdown-ready, 
+	paragraph of %s, present tense, third-person, markdown-ready, 
 	no prefatory text. Attempt to not lose context when summarizing.
 
 	When the source material contains links (GitHub issue/PR references, URLs, etc.),
@@ -80,9 +99,10 @@ You will receive a JSON object with an array of items, each containing:
 - issue: The issue title
 - updates: One or more status updates (newest first)
 - reported_status: The author's claimed status
+- hint: (optional) author-provided guidance on how to summarize this item; follow it when present
 
 For each item, produce:
-1. A summary: 3-5 sentences, present tense, third-person, markdown-ready, no prefatory text.
+1. A summary: %s, present tense, third-person, markdown-ready, no prefatory text.
    Do not lose context when summarizing.
    When the source material contains links (GitHub issue/PR references, URLs, etc.),
    weave them naturally into the summary as inline markdown links with descriptive
@@ -159,17 +179,84 @@ Respond with ONLY the paragraph text, no formatting, no prefatory text.`
 
 	temperature    = 1 // gpt-5o-mini only supports temperature of 1
 	maxRetries     = 3
-	baseDelay      = 1 * time.Second
-	maxBatchSize   = 25   // Maximum items per batch to avoid token limits
+	maxBatchSize   = 25   // Default maximum items per batch to avoid token limits
 	maxBatchTokens = 8000 // Rough estimate of safe token limit for batch
 )
 
+// DefaultMaxSummaryWords is the default word cap applied to AI summaries
+const DefaultMaxSummaryWords = 35
+
+// wordRegex matches the words of a summary, keeping their byte offsets
+var wordRegex = regexp.MustCompile(`\S+`)
+
+// TrimSummary shortens summary to at most maxWords words. It keeps everything
+// up to the last sentence that ends within the cap; with no such sentence it
+// cuts at the cap and appends an ellipsis. Summaries within the cap, and any
+// summary when maxWords is 0, are returned unchanged.
+func TrimSummary(summary string, maxWords int) string {
+	words := wordRegex.FindAllStringIndex(summary, -1)
+	if maxWords <= 0 || len(words) <= maxWords {
+		return summary
+	}
+
+	for i := maxWords - 1; i >= 0; i-- {
+		word := strings.TrimRight(summary[words[i][0]:words[i][1]], `"')]*_`)
+		if strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?") {
+			return summary[:words[i][1]]
+		}
+	}
+	return strings.TrimRight(summary[:words[maxWords-1][1]], ",;:-") + "…"
+}
+
+// trimSummary applies c.MaxSummaryWords to summary, logging when it cuts
+func (c *GHModelsClient) trimSummary(ctx context.Context, issueURL, summary string) string {
+	trimmed := TrimSummary(summary, c.MaxSummaryWords)
+	if trimmed != summary {
+		getContextLogger(ctx).Debug("Trimmed over-long AI summary", "issue", issueURL,
+			"words", len(strings.Fields(summary)), "max_words", c.MaxSummaryWords)
+	}
+	return trimmed
+}
+
+// baseDelay is the starting backoff between retries; a var so tests can shorten it
+var baseDelay = 1 * time.Second
+
+// errRateLimitExhausted marks a model that was still rate limited after every retry
+var errRateLimitExhausted = errors.New("rate limited after all retries")
+
+// batchSize returns the configured chunk size or maxBatchSize if unset
+func (c *GHModelsClient) batchSize() int {
+	if c.BatchSize > 0 {
+		return c.BatchSize
+	}
+	return maxBatchSize
+}
+
+// AppendLanguage adds the "Respond in <language>." instruction to prompt;
+// an empty language leaves prompt unchanged
+func AppendLanguage(prompt, language string) string {
+	language = strings.TrimSpace(language)
+	if language == "" {
+		return prompt
+	}
+	return fmt.Sprintf("%s\n\nRespond in %s.", strings.TrimRight(prompt, " \t\n"), language)
+}
+
 // getSystemPrompt returns the configured system prompt or the default if empty
 func (c *GHModelsClient) getSystemPrompt() string {
 	if c.SystemPrompt != "" {
 		return c.SystemPrompt
 	}
-	return defaultSystemPrompt
+	return fmt.Sprintf(defaultSystemPrompt, c.summaryLength())
+}
+
+// summaryLength describes the summary length the default prompts ask for, kept
+// within MaxSummaryWords so trimming rarely has to cut
+func (c *GHModelsClient) summaryLength() string {
+	if c.MaxSummaryWords > 0 {
+		return fmt.Sprintf("one or two sentences totaling at most %d words", c.MaxSummaryWords)
+	}
+	return "roughly 3-5 sentences"
 }
 
 // Summarize generates a summary for a single update using GitHub Models API
@@ -182,7 +269,11 @@ func (c *GHModelsClient) Summarize(ctx context.Context, issueTitle, issueURL, up
 
 	logger.Debug("AI summarizing single update", "model", c.Model, "issue", issueURL)
 	userPrompt := fmt.Sprintf("Issue: %s (%s)\nUpdate:\n%s", issueTitle, issueURL, updateText)
-	return c.callAPI(ctx, userPrompt, "")
+	summary, err := c.callAPI(ctx, userPrompt, "")
+	if err != nil {
+		return "", err
+	}
+	return c.trimSummary(ctx, issueURL, summary), nil
 }
 
 // SummarizeMany generates a summary for multiple updates using GitHub Models API
@@ -200,11 +291,56 @@ func (c *GHModelsClient) SummarizeMany(ctx context.Context, issueTitle, issueURL
 		userPrompt += fmt.Sprintf("\n%d) %s", i+1, update)
 	}
 
-	return c.callAPI(ctx, userPrompt, "")
+	summary, err := c.callAPI(ctx, userPrompt, "")
+	if err != nil {
+		return "", err
+	}
+	return c.trimSummary(ctx, issueURL, summary), nil
+}
+
+// pingPrompt keeps the Ping request and its response as small as possible
+const pingPrompt = "Reply with the single word OK."
+
+// Ping sends one tiny prompt to Model, without retries or fallback, to check
+// that the endpoint is reachable and the token has Models access
+func (c *GHModelsClient) Ping(ctx context.Context) error {
+	_, err := c.callModel(ctx, c.Model, 1, "ping", pingPrompt)
+	if err == nil {
+		return nil
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("GitHub Models authentication failed.\nYour GITHUB_TOKEN may be invalid.\nVisit https://github.com/settings/tokens to create or update your token")
+		case http.StatusForbidden:
+			return fmt.Errorf("GitHub Models access denied for model %s.\nFine-grained tokens need the 'Models' read permission; check that Models is enabled for your account or organization.\nSet DISABLE_SUMMARY=1 to run without AI summaries", c.Model)
+		case http.StatusNotFound:
+			return fmt.Errorf("GitHub Models has no model %s.\nCheck --model or GITHUB_MODELS_MODEL", c.Model)
+		}
+	}
+	return err
 }
 
-// callAPI makes the actual HTTP request to GitHub Models API with retry logic
+// callAPI makes the actual HTTP request to GitHub Models API with retry logic,
+// falling back to FallbackModel once if the primary model stays rate limited
 func (c *GHModelsClient) callAPI(ctx context.Context, userPrompt string, systemPromptOverride string) (string, error) {
+	summary, err := c.callModel(ctx, c.Model, maxRetries, userPrompt, systemPromptOverride)
+	if err == nil || c.FallbackModel == "" || !errors.Is(err, errRateLimitExhausted) {
+		return summary, err
+	}
+
+	getContextLogger(ctx).Debug("AI primary model rate limited, trying fallback", "model", c.Model, "fallback", c.FallbackModel)
+	summary, fallbackErr := c.callModel(ctx, c.FallbackModel, 1, userPrompt, systemPromptOverride)
+	if fallbackErr != nil {
+		return "", fmt.Errorf("%w (fallback model %s: %v)", err, c.FallbackModel, fallbackErr)
+	}
+	return summary, nil
+}
+
+// callModel requests a completion from model, making up to attempts tries
+func (c *GHModelsClient) callModel(ctx context.Context, model string, attempts int, userPrompt string, systemPromptOverride string) (string, error) {
 	// Get logger from context if available
 	logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger)
 	if !ok {
@@ -212,24 +348,25 @@ func (c *GHModelsClient) callAPI(ctx context.Context, userPrompt string, systemP
 	}
 
 	request := chatCompletionRequest{
-		Model:       c.Model,
+		Model:       model,
 		Temperature: temperature,
 		Messages: []message{
 			{Role: "system", Content: func() string {
 				if systemPromptOverride != "" {
-					return systemPromptOverride
+					return AppendLanguage(systemPromptOverride, c.Language)
 				}
-				return c.getSystemPrompt()
+				return AppendLanguage(c.getSystemPrompt(), c.Language)
 			}()},
 
 			{Role: "user", Content: userPrompt},
 		},
 	}
 
-	logger.Debug("Starting AI API request", "model", c.Model, "temperature", temperature, "maxRetries", maxRetries)
+	logger.Debug("Starting AI API request", "model", model, "temperature", temperature, "maxRetries", attempts)
 
 	var lastErr error
-	for attempt := 0; attempt < maxRetries; attempt++ {
+	rateLimited := false
+	for attempt := 0; attempt < attempts; attempt++ {
 		if attempt > 0 {
 			// Apply jittered exponential backoff
 			backoff := retry.CalculateBackoff(attempt-1, int(baseDelay.Milliseconds()))
@@ -241,13 +378,17 @@ func (c *GHModelsClient) callAPI(ctx context.Context, userPrompt string, systemP
 			}
 		}
 
-		logger.Debug("AI API request attempt", "attempt", attempt+1, "maxRetries", maxRetries)
+		logger.Debug("AI API request attempt", "attempt", attempt+1, "maxRetries", attempts)
 		response, err := c.makeHTTPRequest(ctx, request)
 		if err != nil {
 			lastErr = err
 
 			// Check if it's a rate limit error (429)
-			if httpErr, ok := err.(*HTTPError); ok && httpErr.StatusCode == 429 {
+			httpErr, isHTTPErr := err.(*HTTPError)
+			// Any 429 in the run makes it a rate-limit failure, even if later
+			// attempts fail differently, so the fallback model still gets a try
+			if isHTTPErr && httpErr.StatusCode == 429 {
+				rateLimited = true
 				logger.Debug("AI API rate limited", "attempt", attempt+1, "statusCode", httpErr.StatusCode)
 				// Extract retry-after header if present
 				if retryAfter := httpErr.Headers.Get("Retry-After"); retryAfter != "" {
@@ -263,6 +404,12 @@ func (c *GHModelsClient) callAPI(ctx context.Context, userPrompt string, systemP
 				continue // Retry on rate limit
 			}
 
+			// Server errors and dropped connections are usually transient
+			if ctx.Err() == nil && isTransientError(err) {
+				logger.Debug("AI API transient failure", "attempt", attempt+1, "error", err)
+				continue
+			}
+
 			logger.Debug("AI API request failed", "attempt", attempt+1, "error", err)
 			// For other errors, return immediately
 			return "", fmt.Errorf("GitHub Models API request failed: %w", err)
@@ -275,12 +422,35 @@ func (c *GHModelsClient) callAPI(ctx context.Context, userPrompt string, systemP
 		}
 
 		summary := response.Choices[0].Message.Content
-		logger.Debug("AI API request succeeded", "attempt", attempt+1, "summaryLength", len(summary))
+		logger.Debug("AI API request succeeded", "model", model, "attempt", attempt+1, "summaryLength", len(summary))
 		return summary, nil
 	}
 
-	logger.Debug("AI API failed after all retries", "maxRetries", maxRetries, "lastError", lastErr)
-	return "", fmt.Errorf("GitHub Models API failed after %d retries: %w", maxRetries, lastErr)
+	logger.Debug("AI API failed after all retries", "model", model, "maxRetries", attempts, "lastError", lastErr)
+	if !rateLimited {
+		return "", fmt.Errorf("GitHub Models API request failed after %d attempts: %w", attempts, lastErr)
+	}
+	return "", fmt.Errorf("GitHub Models API failed after %d retries: %w: %w", attempts, errRateLimitExhausted, lastErr)
+}
+
+// isTransientError reports whether a failed request is worth retrying: a 5xx
+// response, a timeout, or a connection that was refused, reset, or cut off.
+// Other 4xx responses and malformed requests or responses fail immediately;
+// an EOF only counts when the transport hit it, not when decoding a body.
+func isTransientError(err error) bool {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && (errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF))
 }
 
 // makeHTTPRequest performs the actual HTTP request
@@ -344,6 +514,7 @@ type batchRequestItem struct {
 	Issue          string   `json:"issue"`
 	Updates        []string `json:"updates"`
 	ReportedStatus string   `json:"reported_status"`
+	Hint           string   `json:"hint,omitempty"`
 }
 
 // batchRequest represents the structure sent to the API for batch summarization
@@ -351,7 +522,7 @@ type batchRequest struct {
 	Items []batchRequestItem `json:"items"`
 }
 
-// buildBatchPrompt creates a JSON prompt for batch summarization
+// buildBatchPrompt creates a JSON prompt for batch summarization, listing items in the given order
 func (c *GHModelsClient) buildBatchPrompt(items []BatchItem) (string, error) {
 	batchReq := batchRequest{
 		Items: make([]batchRequestItem, len(items)),
@@ -363,6 +534,7 @@ func (c *GHModelsClient) buildBatchPrompt(items []BatchItem) (string, error) {
 			Issue:          item.IssueTitle,
 			Updates:        item.UpdateTexts,
 			ReportedStatus: item.ReportedStatus,
+			Hint:           item.Hint,
 		}
 	}
 
@@ -387,7 +559,7 @@ type sentimentMatch struct {
 }
 
 // parseBatchResponse attempts to parse the API response as JSON
-// Tries formats in order: nested (with sentiment), flat (legacy), markdown fallback
+// Tries formats in order: nested (with sentiment), flat (legacy), markdown sections, markdown table
 func (c *GHModelsClient) parseBatchResponse(response string, items []BatchItem) (map[string]BatchResult, error) {
 	// Try new nested format first: {"url": {"summary": "...", "sentiment": {...}}}
 	var nested map[string]sentimentResponseItem
@@ -413,7 +585,12 @@ func (c *GHModelsClient) parseBatchResponse(response string, items []BatchItem)
 	}
 
 	// Fall back to markdown parsing
-	return c.parseMarkdownBatchResponse(response, items)
+	if result, err := c.parseMarkdownBatchResponse(response, items); err == nil {
+		return result, nil
+	}
+
+	// Last resort: a two-column markdown table of URL → summary
+	return parseMarkdownTableBatchResponse(response, items)
 }
 
 // convertNestedResponse converts the nested AI response to BatchResult map
@@ -481,6 +658,67 @@ func (c *GHModelsClient) parseMarkdownBatchResponse(response string, items []Bat
 	return result, nil
 }
 
+// parseMarkdownTableBatchResponse parses a markdown table batch response
+// Expected format:
+// | URL | Summary |
+// |-----|---------|
+// | <URL> | <summary text> |
+// Header, separator, and rows whose first cell matches no item are skipped.
+func parseMarkdownTableBatchResponse(response string, items []BatchItem) (map[string]BatchResult, error) {
+	result := make(map[string]BatchResult)
+	byURL := make(map[string]string, len(items))
+	for _, item := range items {
+		byURL[canonicalCellURL(item.IssueURL)] = item.IssueURL
+	}
+
+	for _, line := range strings.Split(response, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "|") {
+			continue
+		}
+
+		cells := strings.Split(strings.Trim(line, "|"), "|")
+		if len(cells) < 2 {
+			continue
+		}
+
+		issueURL, ok := byURL[canonicalCellURL(strings.TrimSpace(cells[0]))]
+		if !ok {
+			continue
+		}
+		// Rejoin any remaining cells in case the summary itself contained a pipe
+		summary := strings.TrimSpace(strings.Join(cells[1:], "|"))
+		if summary == "" {
+			continue
+		}
+
+		result[issueURL] = BatchResult{Summary: summary, Sentiment: nil}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("failed to parse batch response in JSON, markdown, or table formats")
+	}
+
+	return result, nil
+}
+
+// cellLinkRegex matches a markdown link, capturing its target
+var cellLinkRegex = regexp.MustCompile(`\[[^\]]*\]\(([^)\s]+)\)`)
+
+// canonicalCellURL extracts the URL from a table cell written as a bare URL,
+// "<url>", or "[text](url)", dropping any query string, fragment, and trailing
+// slash so it can be compared exactly against an item's issue URL
+func canonicalCellURL(cell string) string {
+	if match := cellLinkRegex.FindStringSubmatch(cell); match != nil {
+		cell = match[1]
+	}
+	cell = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(cell), "<"), ">")
+	if i := strings.IndexAny(cell, "?#"); i >= 0 {
+		cell = cell[:i]
+	}
+	return strings.TrimSuffix(cell, "/")
+}
+
 // batchConfig holds the parameters for a generic batch API call.
 type batchConfig struct {
 	systemPrompt string // System prompt to use during the API call
@@ -527,10 +765,10 @@ func runBatch[I any, R any](
 		return make(map[string]R), nil
 	}
 
-	// If we have more than maxBatchSize items, chunk them
-	if len(items) > maxBatchSize {
-		logger.Debug("Splitting "+cfg.actionName+" batch into chunks", "totalItems", len(items), "chunkSize", maxBatchSize)
-		return chunkedBatch(ctx, items, logger, cfg.actionName, selfFn)
+	// If we have more items than fit in one request, chunk them
+	if size := c.batchSize(); len(items) > size {
+		logger.Debug("Splitting "+cfg.actionName+" batch into chunks", "totalItems", len(items), "chunkSize", size)
+		return chunkedBatch(ctx, items, size, logger, cfg.actionName, selfFn)
 	}
 
 	logger.Debug("AI "+cfg.actionName+" batch", "model", c.Model, "items", len(items))
@@ -557,37 +795,70 @@ func runBatch[I any, R any](
 	return results, nil
 }
 
+// sortedByIssueURL returns a copy of items sorted by the URL that key returns.
+// Batch prompts and chunk boundaries then depend only on which items are sent,
+// not on the order callers collected them in.
+func sortedByIssueURL[I any](items []I, key func(I) string) []I {
+	sorted := make([]I, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return key(sorted[i]) < key(sorted[j])
+	})
+	return sorted
+}
+
 // SummarizeBatch generates summaries for multiple issues in a single request
-// Implements chunking to avoid token limits
+// Implements chunking to avoid token limits. Items are sent in IssueURL order,
+// so the same items always produce the same prompts whatever order they arrive in.
 func (c *GHModelsClient) SummarizeBatch(ctx context.Context, items []BatchItem) (map[string]BatchResult, error) {
-	cfg := batchConfig{systemPrompt: batchSystemPrompt, actionName: "summarize"}
+	items = sortedByIssueURL(items, func(item BatchItem) string { return item.IssueURL })
+	cfg := batchConfig{systemPrompt: fmt.Sprintf(batchSystemPrompt, c.summaryLength()), actionName: "summarize"}
 	return runBatch(ctx, c, items, cfg,
 		c.buildBatchPrompt,
 		func(resp string) (map[string]BatchResult, error) {
-			return c.parseBatchResponse(resp, items)
+			results, err := c.parseBatchResponse(resp, items)
+			if err != nil {
+				return nil, err
+			}
+			for url, result := range results {
+				result.Summary = c.trimSummary(ctx, url, result.Summary)
+				results[url] = result
+			}
+			return results, nil
 		},
 		c.SummarizeBatch,
 	)
 }
 
-// chunkedBatch splits items into chunks and processes them sequentially.
+// chunkedBatch splits items into chunks of size and processes them sequentially.
 // It works with any item and result types by accepting a batch function.
-func chunkedBatch[I any, R any](ctx context.Context, items []I, logger *slog.Logger, actionName string, batchFn func(context.Context, []I) (map[string]R, error)) (map[string]R, error) {
+// A failed chunk is logged and skipped so the other chunks' results survive;
+// an error is returned only when every chunk fails.
+func chunkedBatch[I any, R any](ctx context.Context, items []I, size int, logger *slog.Logger, actionName string, batchFn func(context.Context, []I) (map[string]R, error)) (map[string]R, error) {
 	result := make(map[string]R)
+	var firstErr error
+	failed := 0
+	chunks := 0
 
-	for i := 0; i < len(items); i += maxBatchSize {
-		end := i + maxBatchSize
+	for i := 0; i < len(items); i += size {
+		end := i + size
 		if end > len(items) {
 			end = len(items)
 		}
 
 		chunk := items[i:end]
-		chunkNum := i/maxBatchSize + 1
+		chunkNum := i/size + 1
+		chunks++
 		logger.Debug("Processing "+actionName+" chunk", "chunk", chunkNum, "items", len(chunk))
 
 		chunkResults, err := batchFn(ctx, chunk)
 		if err != nil {
-			return nil, fmt.Errorf("%s chunk %d failed: %w", actionName, chunkNum, err)
+			failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s chunk %d failed: %w", actionName, chunkNum, err)
+			}
+			logger.Warn("AI "+actionName+" chunk failed, using fallbacks for its items", "chunk", chunkNum, "items", len(chunk), "error", err)
+			continue
 		}
 
 		// Merge results
@@ -596,6 +867,10 @@ func chunkedBatch[I any, R any](ctx context.Context, items []I, logger *slog.Log
 		}
 	}
 
+	if failed == chunks {
+		return nil, firstErr
+	}
+
 	return result, nil
 }
 
@@ -611,7 +886,7 @@ type describeRequest struct {
 	Items []describeRequestItem `json:"items"`
 }
 
-// buildDescribePrompt creates a JSON prompt for batch description
+// buildDescribePrompt creates a JSON prompt for batch description, listing items in the given order
 func (c *GHModelsClient) buildDescribePrompt(items []DescribeBatchItem) (string, error) {
 	req := describeRequest{
 		Items: make([]describeRequestItem, len(items)),
@@ -634,8 +909,10 @@ func (c *GHModelsClient) buildDescribePrompt(items []DescribeBatchItem) (string,
 }
 
 // DescribeBatch generates project/goal summaries for issue descriptions
-// Implements chunking to avoid token limits
+// Implements chunking to avoid token limits. Items are sent in IssueURL order,
+// as in SummarizeBatch.
 func (c *GHModelsClient) DescribeBatch(ctx context.Context, items []DescribeBatchItem) (map[string]string, error) {
+	items = sortedByIssueURL(items, func(item DescribeBatchItem) string { return item.IssueURL })
 	cfg := batchConfig{systemPrompt: describeSystemPrompt, actionName: "describe"}
 	return runBatch(ctx, c, items, cfg,
 		c.buildDescribePrompt,
@@ -646,6 +923,27 @@ func (c *GHModelsClient) DescribeBatch(ctx context.Context, items []DescribeBatc
 	)
 }
 
+// Describe generates a project/goal summary for a single issue. It sends a
+// one-item describe batch so the prompt and output match batched runs.
+func (c *GHModelsClient) Describe(ctx context.Context, issueTitle, issueURL, issueBody string) (string, error) {
+	getContextLogger(ctx).Debug("AI describing single issue", "model", c.Model, "issue", issueURL)
+
+	descriptions, err := c.DescribeBatch(ctx, []DescribeBatchItem{{
+		IssueURL:   issueURL,
+		IssueTitle: issueTitle,
+		IssueBody:  issueBody,
+	}})
+	if err != nil {
+		return "", err
+	}
+
+	description := strings.TrimSpace(descriptions[issueURL])
+	if description == "" {
+		return "", fmt.Errorf("GitHub Models API returned no description for %s", issueURL)
+	}
+	return description, nil
+}
+
 // parseDescribeResponse attempts to parse the API response as JSON
 // Returns a map of issueURL -> description
 func (c *GHModelsClient) parseDescribeResponse(response string, items []DescribeBatchItem) (map[string]string, error) {
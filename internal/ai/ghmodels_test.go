@@ -3,15 +3,23 @@ package ai
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
 )
 
 func TestGHModelsClient_Summarize(t *testing.T) {
+	// 5xx responses are retried; keep the backoff short
+	origDelay := baseDelay
+	baseDelay = time.Millisecond
+	t.Cleanup(func() { baseDelay = origDelay })
+
 	tests := []struct {
 		name           string
 		issueTitle     string
@@ -168,6 +176,140 @@ func TestGHModelsClient_Summarize(t *testing.T) {
 	}
 }
 
+func TestGHModelsClient_Describe(t *testing.T) {
+	// 5xx responses are retried; keep the backoff short
+	origDelay := baseDelay
+	baseDelay = time.Millisecond
+	t.Cleanup(func() { baseDelay = origDelay })
+
+	tests := []struct {
+		name           string
+		issueTitle     string
+		issueURL       string
+		issueBody      string
+		responseBody   string
+		statusCode     int
+		expectedError  string
+		expectedResult string
+	}{
+		{
+			name:       "successful single issue description",
+			issueTitle: "Implement user authentication",
+			issueURL:   "https://github.com/owner/repo/issues/123",
+			issueBody:  "## Goal\nAdd OAuth2 login and session management for the web app.",
+			responseBody: `{
+				"choices": [
+					{
+						"message": {
+							"role": "assistant",
+							"content": "{\"https://github.com/owner/repo/issues/123\": \"Adds OAuth2 login and session management.\"}"
+						}
+					}
+				]
+			}`,
+			statusCode:     200,
+			expectedResult: "Adds OAuth2 login and session management.",
+		},
+		{
+			name:       "response omits the issue",
+			issueTitle: "Fix bug in payment processing",
+			issueURL:   "https://github.com/owner/repo/issues/456",
+			issueBody:  "Payments fail for some cards.",
+			responseBody: `{
+				"choices": [
+					{
+						"message": {
+							"role": "assistant",
+							"content": "{\"https://github.com/owner/repo/issues/999\": \"Something else.\"}"
+						}
+					}
+				]
+			}`,
+			statusCode:    200,
+			expectedError: "returned no description",
+		},
+		{
+			name:       "API returns empty choices",
+			issueTitle: "Optimize database queries",
+			issueURL:   "https://github.com/owner/repo/issues/789",
+			issueBody:  "Add indexes to the hot tables.",
+			responseBody: `{
+				"choices": []
+			}`,
+			statusCode:    200,
+			expectedError: "GitHub Models API returned empty response",
+		},
+		{
+			name:       "HTTP 500 error",
+			issueTitle: "Update dependencies",
+			issueURL:   "https://github.com/owner/repo/issues/321",
+			issueBody:  "Bump every package to its latest version.",
+			statusCode: 500,
+			responseBody: `{
+				"error": {
+					"message": "Internal server error"
+				}
+			}`,
+			expectedError: "GitHub Models API request failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var request chatCompletionRequest
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Errorf("Failed to decode request body: %v", err)
+				}
+
+				if len(request.Messages) != 2 {
+					t.Fatalf("Expected 2 messages, got %d", len(request.Messages))
+				}
+				if request.Messages[0].Content != describeSystemPrompt {
+					t.Errorf("Expected the describe system prompt")
+				}
+
+				// The single issue is sent as a one-item describe batch
+				userContent := request.Messages[1].Content
+				if !strings.Contains(userContent, tt.issueTitle) {
+					t.Errorf("User prompt should contain issue title")
+				}
+				if !strings.Contains(userContent, tt.issueURL) {
+					t.Errorf("User prompt should contain issue URL")
+				}
+				var batch describeRequest
+				if err := json.Unmarshal([]byte(userContent), &batch); err != nil || len(batch.Items) != 1 || batch.Items[0].Body != tt.issueBody {
+					t.Errorf("Expected a one-item describe request with the issue body, got %q", userContent)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			client := NewGHModelsClient(server.URL, "gpt-4o-mini", "test-token", "", 0)
+
+			result, err := client.Describe(context.Background(), tt.issueTitle, tt.issueURL, tt.issueBody)
+
+			if tt.expectedError != "" {
+				if err == nil {
+					t.Errorf("Expected error containing '%s', got nil", tt.expectedError)
+				} else if !strings.Contains(err.Error(), tt.expectedError) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.expectedError, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				if result != tt.expectedResult {
+					t.Errorf("Expected result '%s', got '%s'", tt.expectedResult, result)
+				}
+			}
+		})
+	}
+}
+
 func TestGHModelsClient_SummarizeMany(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Verify request
@@ -275,6 +417,229 @@ func TestGHModelsClient_RetryOnRateLimit(t *testing.T) {
 	}
 }
 
+func TestGHModelsClient_FallbackModelOnRateLimit(t *testing.T) {
+	origDelay := baseDelay
+	baseDelay = time.Millisecond
+	t.Cleanup(func() { baseDelay = origDelay })
+
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatCompletionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		calls[req.Model]++
+
+		if req.Model == "primary-model" {
+			w.WriteHeader(429)
+			w.Write([]byte(`{"error": {"message": "Rate limited"}}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "From the fallback."}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "primary-model", "test-token", "", 0)
+	client.FallbackModel = "fallback-model"
+
+	result, err := client.Summarize(context.Background(), "Test", "https://github.com/test/repo/issues/1", "Update text")
+	if err != nil {
+		t.Fatalf("Expected fallback to succeed, got %v", err)
+	}
+	if result != "From the fallback." {
+		t.Errorf("Expected fallback result, got '%s'", result)
+	}
+	if calls["primary-model"] != maxRetries {
+		t.Errorf("Expected %d primary attempts, got %d", maxRetries, calls["primary-model"])
+	}
+	if calls["fallback-model"] != 1 {
+		t.Errorf("Expected 1 fallback attempt, got %d", calls["fallback-model"])
+	}
+}
+
+func TestGHModelsClient_NoFallbackForNonRateLimitErrors(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		calls[req.Model]++
+		w.WriteHeader(400)
+		w.Write([]byte(`{"error": {"message": "Bad request"}}`))
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "primary-model", "test-token", "", 0)
+	client.FallbackModel = "fallback-model"
+
+	if _, err := client.Summarize(context.Background(), "Test", "https://github.com/test/repo/issues/1", "Update text"); err == nil {
+		t.Fatal("Expected error for 400 response")
+	}
+	if calls["fallback-model"] != 0 {
+		t.Errorf("Expected no fallback attempt for a non-rate-limit error, got %d", calls["fallback-model"])
+	}
+}
+
+func TestGHModelsClient_RetryOnServerError(t *testing.T) {
+	origDelay := baseDelay
+	baseDelay = time.Millisecond
+	t.Cleanup(func() { baseDelay = origDelay })
+
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		switch callCount {
+		case 1:
+			w.WriteHeader(503)
+			w.Write([]byte(`{"error": {"message": "Service unavailable"}}`))
+		case 2:
+			// Drop the connection without a response
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Fatalf("failed to hijack connection: %v", err)
+			}
+			conn.Close()
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "Recovered."}}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "gpt-4o-mini", "test-token", "", 0)
+
+	result, err := client.Summarize(context.Background(), "Test", "https://github.com/test/repo/issues/1", "Update text")
+	if err != nil {
+		t.Fatalf("Expected success after transient failures, got %v", err)
+	}
+	if result != "Recovered." {
+		t.Errorf("Expected the recovered result, got '%s'", result)
+	}
+	if callCount != 3 {
+		t.Errorf("Expected 3 API calls (503, dropped connection, success), got %d", callCount)
+	}
+}
+
+func TestGHModelsClient_NoRetryOnClientError(t *testing.T) {
+	origDelay := baseDelay
+	baseDelay = time.Millisecond
+	t.Cleanup(func() { baseDelay = origDelay })
+
+	callCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		callCount++
+		w.WriteHeader(400)
+		w.Write([]byte(`{"error": {"message": "Bad request"}}`))
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "gpt-4o-mini", "test-token", "", 0)
+
+	_, err := client.Summarize(context.Background(), "Test", "https://github.com/test/repo/issues/1", "Update text")
+	if err == nil || !strings.Contains(err.Error(), "HTTP 400") {
+		t.Fatalf("Expected the 400 error, got %v", err)
+	}
+	if callCount != 1 {
+		t.Errorf("Expected a single API call for a 400, got %d", callCount)
+	}
+}
+
+func TestGHModelsClient_ServerErrorExhaustionSkipsFallback(t *testing.T) {
+	origDelay := baseDelay
+	baseDelay = time.Millisecond
+	t.Cleanup(func() { baseDelay = origDelay })
+
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		calls[req.Model]++
+		w.WriteHeader(502)
+		w.Write([]byte(`{"error": {"message": "Bad gateway"}}`))
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "primary-model", "test-token", "", 0)
+	client.FallbackModel = "fallback-model"
+
+	_, err := client.Summarize(context.Background(), "Test", "https://github.com/test/repo/issues/1", "Update text")
+	if err == nil || errors.Is(err, errRateLimitExhausted) {
+		t.Fatalf("Expected a non-rate-limit error, got %v", err)
+	}
+	if calls["primary-model"] != maxRetries {
+		t.Errorf("Expected %d primary attempts, got %d", maxRetries, calls["primary-model"])
+	}
+	if calls["fallback-model"] != 0 {
+		t.Errorf("Expected no fallback attempt for server errors, got %d", calls["fallback-model"])
+	}
+}
+
+func TestGHModelsClient_FallbackAfterRateLimitThenServerError(t *testing.T) {
+	origDelay := baseDelay
+	baseDelay = time.Millisecond
+	t.Cleanup(func() { baseDelay = origDelay })
+
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		calls[req.Model]++
+
+		if req.Model == "primary-model" {
+			if calls[req.Model] == 1 {
+				w.WriteHeader(429)
+				w.Write([]byte(`{"error": {"message": "Rate limited"}}`))
+				return
+			}
+			w.WriteHeader(503)
+			w.Write([]byte(`{"error": {"message": "Service unavailable"}}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"choices": [{"message": {"role": "assistant", "content": "From the fallback."}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "primary-model", "test-token", "", 0)
+	client.FallbackModel = "fallback-model"
+
+	result, err := client.Summarize(context.Background(), "Test", "https://github.com/test/repo/issues/1", "Update text")
+	if err != nil {
+		t.Fatalf("Expected fallback to succeed after a 429 then 503s, got %v", err)
+	}
+	if result != "From the fallback." {
+		t.Errorf("Expected fallback result, got '%s'", result)
+	}
+	if calls["fallback-model"] != 1 {
+		t.Errorf("Expected 1 fallback attempt, got %d", calls["fallback-model"])
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "server error", err: &HTTPError{StatusCode: 503}, want: true},
+		{name: "client error", err: &HTTPError{StatusCode: 400}},
+		{name: "transport EOF", err: &url.Error{Op: "Post", URL: "https://models.example", Err: io.EOF}, want: true},
+		{name: "transport unexpected EOF", err: &url.Error{Op: "Post", URL: "https://models.example", Err: io.ErrUnexpectedEOF}, want: true},
+		{name: "decode EOF", err: fmt.Errorf("failed to decode response: %w", io.EOF)},
+		{name: "decode unexpected EOF", err: fmt.Errorf("failed to decode response: %w", io.ErrUnexpectedEOF)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransientError(tt.err); got != tt.want {
+				t.Errorf("isTransientError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGHModelsClient_ContextCancellation(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Simulate slow response
@@ -409,6 +774,25 @@ func TestGHModelsClient_DefaultSystemPrompt(t *testing.T) {
 	}
 }
 
+func TestGHModelsClient_PromptsFollowWordCap(t *testing.T) {
+	client := NewGHModelsClient("", "gpt-4o-mini", "test-token", "", 0)
+	client.MaxSummaryWords = 35
+
+	want := "one or two sentences totaling at most 35 words"
+	if prompt := client.getSystemPrompt(); !strings.Contains(prompt, want) || strings.Contains(prompt, "3-5 sentences") {
+		t.Errorf("expected the default prompt to ask for %q, got %q", want, prompt)
+	}
+	if prompt := fmt.Sprintf(batchSystemPrompt, client.summaryLength()); !strings.Contains(prompt, "1. A summary: "+want+",") {
+		t.Errorf("expected the batch prompt to ask for %q, got %q", want, prompt)
+	}
+
+	// A custom prompt is sent as written
+	client.SystemPrompt = "Summarize in 3-5 sentences."
+	if prompt := client.getSystemPrompt(); prompt != "Summarize in 3-5 sentences." {
+		t.Errorf("expected the custom prompt unchanged, got %q", prompt)
+	}
+}
+
 func TestValidateWordCount(t *testing.T) {
 	// Test helper to validate that responses are ≤35 words
 	responses := []string{
@@ -435,6 +819,153 @@ func TestValidateWordCount(t *testing.T) {
 	}
 }
 
+func TestTrimSummary(t *testing.T) {
+	testCases := []struct {
+		name     string
+		summary  string
+		maxWords int
+		want     string
+	}{
+		{
+			name:     "within cap is unchanged",
+			summary:  "Shipped the API. Docs are next.",
+			maxWords: 6,
+			want:     "Shipped the API. Docs are next.",
+		},
+		{
+			name:     "cuts at the last sentence within the cap",
+			summary:  "Shipped the API to staging. Load tests passed! Next week we roll out to every region.",
+			maxWords: 10,
+			want:     "Shipped the API to staging. Load tests passed!",
+		},
+		{
+			name:     "sentence ending in bold markdown",
+			summary:  "Migration is **done.** Cleanup of the old tables continues through next week.",
+			maxWords: 6,
+			want:     "Migration is **done.**",
+		},
+		{
+			name:     "hard trim with ellipsis when no sentence fits",
+			summary:  "Rolled out the new indexer to staging, validated latency, and started the production migration.",
+			maxWords: 6,
+			want:     "Rolled out the new indexer to…",
+		},
+		{
+			name:     "hard trim drops trailing comma",
+			summary:  "Rolled out the indexer, validated latency, and started the migration.",
+			maxWords: 4,
+			want:     "Rolled out the indexer…",
+		},
+		{
+			name:     "zero disables trimming",
+			summary:  "One two three four five.",
+			maxWords: 0,
+			want:     "One two three four five.",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := TrimSummary(tc.summary, tc.maxWords)
+			if got != tc.want {
+				t.Errorf("TrimSummary() = %q, want %q", got, tc.want)
+			}
+			if tc.maxWords > 0 && len(strings.Fields(got)) > tc.maxWords {
+				t.Errorf("trimmed summary has %d words, cap is %d", len(strings.Fields(got)), tc.maxWords)
+			}
+		})
+	}
+}
+
+func TestGHModelsClient_SummarizeTrimsToCap(t *testing.T) {
+	long := "This is a very long response that exceeds the thirty-five word limit. " +
+		"It keeps going to ensure concise and readable status updates for engineering teams working on complex " +
+		"software development projects with multiple stakeholders and requirements across several quarters."
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := chatCompletionResponse{Choices: []choice{{Message: message{Role: "assistant", Content: long}}}}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "gpt-4o-mini", "test-token", "", 0)
+	client.MaxSummaryWords = DefaultMaxSummaryWords
+
+	summary, err := client.Summarize(context.Background(), "Test", "https://github.com/test/repo/issues/1", "Update text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if words := len(strings.Fields(summary)); words > DefaultMaxSummaryWords {
+		t.Errorf("summary has %d words, want at most %d: %s", words, DefaultMaxSummaryWords, summary)
+	}
+	if summary != "This is a very long response that exceeds the thirty-five word limit." {
+		t.Errorf("expected the summary cut at its first sentence, got %q", summary)
+	}
+
+	client.MaxSummaryWords = 0
+	untrimmed, err := client.Summarize(context.Background(), "Test", "https://github.com/test/repo/issues/1", "Update text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if untrimmed != long {
+		t.Errorf("expected the full summary with trimming off, got %q", untrimmed)
+	}
+}
+
+func TestAppendLanguage(t *testing.T) {
+	if got := AppendLanguage("Summarize.\n", "Japanese"); got != "Summarize.\n\nRespond in Japanese." {
+		t.Errorf("got %q", got)
+	}
+	if got := AppendLanguage("Summarize.", " "); got != "Summarize." {
+		t.Errorf("expected prompt unchanged without a language, got %q", got)
+	}
+}
+
+func TestGHModelsClient_SummaryLanguage(t *testing.T) {
+	update := "検索インデックスをステージングに展開しました。来週は本番環境へ移行します。"
+	var requests []chatCompletionRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req chatCompletionRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+		content := "順調に進んでいます。"
+		if strings.Contains(req.Messages[1].Content, `"items"`) {
+			content = `{"https://github.com/org/repo/issues/1": {"summary": "順調に進んでいます。", "sentiment": null}}`
+		}
+		resp := chatCompletionResponse{Choices: []choice{{Message: message{Role: "assistant", Content: content}}}}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := NewGHModelsClient(server.URL, "gpt-4o-mini", "test-token", "", 0)
+	client.Language = "Japanese"
+	client.MaxSummaryWords = DefaultMaxSummaryWords
+
+	summary, err := client.Summarize(context.Background(), "検索刷新", "https://github.com/org/repo/issues/1", update)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary != "順調に進んでいます。" {
+		t.Errorf("expected the multibyte summary unchanged, got %q", summary)
+	}
+
+	items := []BatchItem{{IssueURL: "https://github.com/org/repo/issues/1", IssueTitle: "検索刷新", UpdateTexts: []string{update}}}
+	if _, err := client.SummarizeBatch(context.Background(), items); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	for i, req := range requests {
+		if !strings.HasSuffix(req.Messages[0].Content, "Respond in Japanese.") {
+			t.Errorf("request %d: expected the language instruction in the system prompt, got %q", i, req.Messages[0].Content)
+		}
+		if !strings.Contains(req.Messages[1].Content, update) {
+			t.Errorf("request %d: expected the full multibyte update in the user prompt, got %q", i, req.Messages[1].Content)
+		}
+	}
+}
+
 func TestGHModelsClient_GenerateHeader(t *testing.T) {
 	transition := "At Risk→On Track"
 	items := []HeaderItem{
@@ -489,3 +1020,50 @@ func TestGHModelsClient_GenerateHeader_Empty(t *testing.T) {
 		t.Errorf("expected empty string, got %q", result)
 	}
 }
+
+func TestGHModelsClient_Ping(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    string
+	}{
+		{name: "success", statusCode: http.StatusOK, body: `{"choices": [{"message": {"role": "assistant", "content": "OK"}}]}`},
+		{name: "no models access", statusCode: http.StatusForbidden, body: `{"error": {"code": "no_access"}}`, wantErr: "'Models' read permission"},
+		{name: "unknown model", statusCode: http.StatusNotFound, body: `{"error": {"code": "unknown_model"}}`, wantErr: "has no model gpt-test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				var req chatCompletionRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Fatalf("failed to decode request: %v", err)
+				}
+				if req.Messages[0].Content != pingPrompt {
+					t.Errorf("got system prompt %q, want the ping prompt", req.Messages[0].Content)
+				}
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewGHModelsClient(server.URL, "gpt-test", "test-token", "", 5*time.Second)
+			err := client.Ping(context.Background())
+			if requests != 1 {
+				t.Errorf("expected a single request, got %d", requests)
+			}
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ type BatchItem struct {
 	IssueTitle     string   // Issue title for context
 	UpdateTexts    []string // One or more updates (newest first)
 	ReportedStatus string   // The reporter's claimed status (e.g., "On Track", "Unknown")
+	Hint           string   // Optional reporter-provided summarization guidance
 }
 
 // DescribeBatchItem represents a single item for project/goal description
@@ -60,6 +61,9 @@ type Summarizer interface {
 	// Returns a map of issueURL -> description summary
 	DescribeBatch(ctx context.Context, items []DescribeBatchItem) (map[string]string, error)
 
+	// Describe generates a project/goal summary for a single issue description
+	Describe(ctx context.Context, issueTitle, issueURL, issueBody string) (string, error)
+
 	// GenerateHeader produces an executive summary paragraph from assembled report data.
 	GenerateHeader(ctx context.Context, items []HeaderItem) (string, error)
 }
@@ -130,12 +134,22 @@ func (n *NoopSummarizer) GenerateHeader(_ context.Context, items []HeaderItem) (
 func (n *NoopSummarizer) DescribeBatch(_ context.Context, items []DescribeBatchItem) (map[string]string, error) {
 	result := make(map[string]string, len(items))
 	for _, item := range items {
-		body := strings.TrimSpace(item.IssueBody)
-		// Truncate to 500 characters for table display when AI is disabled
-		if len(body) > 500 {
-			body = body[:500] + "..."
-		}
-		result[item.IssueURL] = body
+		result[item.IssueURL] = truncateDescribeBody(item.IssueBody)
 	}
 	return result, nil
 }
+
+// Describe returns the raw issue body (truncated for table display)
+func (n *NoopSummarizer) Describe(_ context.Context, _, _, issueBody string) (string, error) {
+	return truncateDescribeBody(issueBody), nil
+}
+
+// truncateDescribeBody trims body and truncates it to 500 characters for
+// table display when AI is disabled
+func truncateDescribeBody(body string) string {
+	body = strings.TrimSpace(body)
+	if runes := []rune(body); len(runes) > 500 {
+		body = string(runes[:500]) + "..."
+	}
+	return body
+}
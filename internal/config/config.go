@@ -2,24 +2,41 @@ package config
 
 import (
 	"errors"
+	"fmt"
+	"net/url"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/Attamusc/weekly-report-cli/internal/httpclient"
+	"github.com/Attamusc/weekly-report-cli/internal/logging"
+	"github.com/Attamusc/weekly-report-cli/internal/projects"
+	"github.com/Attamusc/weekly-report-cli/internal/report"
 	"github.com/joho/godotenv"
 )
 
 // ErrNoRows indicates no report rows were produced.
 var ErrNoRows = errors.New("no rows produced")
 
+// ErrRunTimedOut indicates the overall run deadline (--timeout) was exceeded.
+var ErrRunTimedOut = errors.New("run timed out")
+
+// ErrAIIncomplete indicates --ai-strict was set and the AI returned no result for some issues.
+var ErrAIIncomplete = errors.New("AI results incomplete")
+
 // Config holds all configuration for the application
 type Config struct {
 	GitHubToken string
+	HostTokens  map[string]string // Token per GitHub Enterprise Server host issues may live on (lowercased host)
 	SinceDays   int
 	Concurrency int
 	Notes       bool
 	Verbose     bool
 	Quiet       bool
+	LogFormat   string   // "text" (default) or "json"
+	Proxy       *url.URL // Explicit proxy for all API clients (nil = HTTP_PROXY/HTTPS_PROXY/NO_PROXY)
 	Models      struct {
 		BaseURL      string
 		Model        string
@@ -27,6 +44,11 @@ type Config struct {
 		SystemPrompt string
 		Sentiment    bool          // true by default when AI enabled, false with --no-sentiment
 		Timeout      time.Duration // HTTP timeout for AI API requests
+		BatchSize    int           // Maximum issues per batch request (0 = client default)
+		Strict       bool          // Fail instead of falling back when AI results are missing
+		Fallback     string        // Model tried once when Model stays rate limited (empty = none)
+		MaxWords     int           // Longer summaries are trimmed (0 = no trimming)
+		Language     string        // Language the model is asked to respond in (empty = unspecified)
 	}
 	Project struct {
 		URL         string
@@ -36,7 +58,16 @@ type Config struct {
 		MaxItems    int
 		ViewName    string
 		ViewID      string
+		CacheTTL    time.Duration // How long fetched items are reused from disk (0 = no cache)
 	}
+	StatusLabelPrefix string          // Restricts label-based status fallback to labels with this prefix
+	ReportAuthors     []string        // Only structured reports by these logins count (empty = all authors)
+	MinUpdateWords    int             // Updates with fewer words count as no update (0 = no minimum)
+	MaxCommentPages   int             // Cap on comment pages fetched per issue (0 = no cap)
+	DoneSinceDays     int             // Look-back window for closed issues (0 = SinceDays)
+	Timeout           time.Duration   // Overall run deadline (0 = no deadline)
+	Location          *time.Location  // Timezone for rendering target dates (default UTC)
+	ReportKeys        report.KeyNames // Data key names reports are parsed with (empty names = defaults)
 }
 
 // ConfigInput holds the CLI flags and input parameters for creating a Config.
@@ -46,8 +77,15 @@ type ConfigInput struct {
 	NoNotes            bool
 	Verbose            bool
 	Quiet              bool
+	LogFormat          string
+	TokenFile          string   // Overrides GITHUB_TOKEN_FILE when set
+	Proxy              string   // Proxy URL overriding HTTP_PROXY/HTTPS_PROXY
+	ConfigURL          string   // Remote config with organization defaults (overrides WEEKLY_REPORT_CONFIG_URL)
+	GitHubHosts        []string // GitHub Enterprise Server hosts besides github.com (overrides GITHUB_HOSTS)
 	InputPath          string
 	SummaryPrompt      string
+	SummaryPromptFile  string // Read into the system prompt, overriding SummaryPrompt
+	DescribePrompts    bool   // Default to the remote config's describe_prompt instead of summary_prompt
 	ProjectURL         string
 	ProjectField       string
 	ProjectFieldValues []string
@@ -55,34 +93,99 @@ type ConfigInput struct {
 	ProjectMaxItems    int
 	ProjectView        string
 	ProjectViewID      string
+	ProjectCacheTTL    time.Duration
+	ProjectNoCache     bool
 	NoSentiment        bool
+	StatusLabelPrefix  string
+	ReportAuthors      []string
+	MinUpdateWords     int
+	MaxCommentPages    int
+	DoneSinceDays      int
+	Timeout            time.Duration
+	Timezone           string // IANA timezone name for target dates (empty = UTC)
+	Model              string // Overrides GITHUB_MODELS_MODEL when set
+	ModelsBaseURL      string // Overrides GITHUB_MODELS_BASE_URL when set
+	ModelFallback      string
+	AIStrict           bool
+	SummaryMaxWords    int  // Word cap for AI summaries (0 = no cap)
+	NoTrim             bool // Disables the SummaryMaxWords cap
+	SummaryLanguage    string
+	ReportKeys         report.KeyNames // Custom report data key names (empty names = defaults)
 }
 
 // FromEnvAndFlags creates a Config from environment variables and CLI flags
 func FromEnvAndFlags(in ConfigInput) (*Config, error) {
 	// Load environment variables from .env file if it exists
 	_ = godotenv.Load() // Silently ignore if .env file doesn't exist
+	// GITHUB_TOKEN takes precedence; otherwise fall back to `gh auth token`
+	tokenFile := in.TokenFile
+	if tokenFile == "" {
+		tokenFile = os.Getenv("GITHUB_TOKEN_FILE")
+	}
+	token, err := resolveGitHubToken(os.Getenv("GITHUB_TOKEN"), tokenFile)
+	if err != nil {
+		return nil, err
+	}
+
 	config := &Config{
-		GitHubToken: os.Getenv("GITHUB_TOKEN"),
+		GitHubToken: token,
 		SinceDays:   in.SinceDays,
 		Concurrency: in.Concurrency,
 		Notes:       !in.NoNotes,             // --no-notes inverts the boolean
 		Verbose:     in.Verbose && !in.Quiet, // verbose is disabled if quiet is set
 		Quiet:       in.Quiet,
+		LogFormat:   in.LogFormat,
+	}
+
+	if err := logging.ValidateFormat(in.LogFormat); err != nil {
+		return nil, err
+	}
+
+	// Issue URLs may also point at GitHub Enterprise Server hosts, each with its own token
+	hosts := in.GitHubHosts
+	if len(hosts) == 0 {
+		hosts = splitList(os.Getenv("GITHUB_HOSTS"))
+	}
+	config.HostTokens, err = resolveHostTokens(hosts, os.Getenv("GITHUB_ENTERPRISE_TOKEN"))
+	if err != nil {
+		return nil, err
+	}
+
+	proxyURL, err := httpclient.ParseProxyURL(in.Proxy)
+	if err != nil {
+		return nil, err
 	}
+	config.Proxy = proxyURL
 
-	// Validate required GitHub token
-	if config.GitHubToken == "" {
-		return nil, errors.New("GITHUB_TOKEN environment variable is required")
+	// Organization defaults from a remote config sit below flags and env
+	var remote RemoteConfig
+	configURL := in.ConfigURL
+	if configURL == "" {
+		configURL = os.Getenv("WEEKLY_REPORT_CONFIG_URL")
+	}
+	if configURL != "" {
+		remote, err = loadRemoteConfig(configURL, proxyURL)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	// Set up AI models configuration
-	config.Models.BaseURL = os.Getenv("GITHUB_MODELS_BASE_URL")
+	// Set up AI models configuration (flag > env > remote config > default)
+	config.Models.BaseURL = in.ModelsBaseURL
+	if config.Models.BaseURL == "" {
+		config.Models.BaseURL = os.Getenv("GITHUB_MODELS_BASE_URL")
+	}
 	if config.Models.BaseURL == "" {
 		config.Models.BaseURL = "https://models.github.ai"
 	}
 
-	config.Models.Model = os.Getenv("GITHUB_MODELS_MODEL")
+	config.Models.Model = in.Model
+	if config.Models.Model == "" {
+		config.Models.Model = os.Getenv("GITHUB_MODELS_MODEL")
+	}
+	if config.Models.Model == "" {
+		config.Models.Model = remote.Model
+	}
 	if config.Models.Model == "" {
 		config.Models.Model = "gpt-5-mini"
 	}
@@ -90,8 +193,33 @@ func FromEnvAndFlags(in ConfigInput) (*Config, error) {
 	// Check if AI summarization is disabled
 	config.Models.Enabled = os.Getenv("DISABLE_SUMMARY") == ""
 
-	// Set custom system prompt if provided
+	// Set custom system prompt if provided; a prompt file wins over the inline flag,
+	// which wins over the remote config
 	config.Models.SystemPrompt = in.SummaryPrompt
+	if config.Models.SystemPrompt == "" {
+		config.Models.SystemPrompt = remote.SummaryPrompt
+		if in.DescribePrompts {
+			config.Models.SystemPrompt = remote.DescribePrompt
+		}
+	}
+	if in.SummaryPromptFile != "" {
+		prompt, err := readPromptFile(in.SummaryPromptFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Models.SystemPrompt = prompt
+	}
+
+	config.Models.Strict = in.AIStrict
+	config.Models.Fallback = in.ModelFallback
+
+	if in.SummaryMaxWords < 0 {
+		return nil, errors.New("--summary-max-words must not be negative")
+	}
+	if !in.NoTrim {
+		config.Models.MaxWords = in.SummaryMaxWords
+	}
+	config.Models.Language = strings.TrimSpace(in.SummaryLanguage)
 
 	// Sentiment analysis is on by default when AI is enabled
 	config.Models.Sentiment = config.Models.Enabled && !in.NoSentiment
@@ -108,14 +236,105 @@ func FromEnvAndFlags(in ConfigInput) (*Config, error) {
 		}
 	}
 
+	// AI batch chunk size: configurable via AI_BATCH_SIZE env var, default left to the client
+	if batchSizeStr := os.Getenv("AI_BATCH_SIZE"); batchSizeStr != "" {
+		batchSize, err := strconv.Atoi(batchSizeStr)
+		if err != nil || batchSize < 1 {
+			return nil, errors.New("AI_BATCH_SIZE must be a positive integer")
+		}
+		config.Models.BatchSize = batchSize
+	}
+
 	// Set up project configuration
 	config.Project.URL = in.ProjectURL
 	config.Project.FieldName = in.ProjectField
+	if err := projects.ValidateFilterValues(in.ProjectFieldValues); err != nil {
+		return nil, fmt.Errorf("--project-field-values: %w", err)
+	}
 	config.Project.FieldValues = in.ProjectFieldValues
 	config.Project.IncludePRs = in.ProjectIncludePRs
 	config.Project.MaxItems = in.ProjectMaxItems
 	config.Project.ViewName = in.ProjectView
 	config.Project.ViewID = in.ProjectViewID
 
+	if in.ProjectCacheTTL < 0 {
+		return nil, errors.New("--cache-ttl must not be negative")
+	}
+	if !in.ProjectNoCache {
+		config.Project.CacheTTL = in.ProjectCacheTTL
+	}
+
+	config.StatusLabelPrefix = in.StatusLabelPrefix
+	config.ReportAuthors = in.ReportAuthors
+
+	if in.MinUpdateWords < 0 {
+		return nil, errors.New("--min-update-words must not be negative")
+	}
+	config.MinUpdateWords = in.MinUpdateWords
+
+	if in.MaxCommentPages < 0 {
+		return nil, errors.New("--max-comment-pages must not be negative")
+	}
+	config.MaxCommentPages = in.MaxCommentPages
+
+	if in.DoneSinceDays < 0 {
+		return nil, errors.New("--done-since-days must not be negative")
+	}
+	config.DoneSinceDays = in.DoneSinceDays
+
+	if in.Timeout < 0 {
+		return nil, errors.New("--timeout must not be negative")
+	}
+	config.Timeout = in.Timeout
+
+	config.Location = time.UTC
+	if in.Timezone != "" {
+		loc, err := time.LoadLocation(in.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --timezone %q: %w", in.Timezone, err)
+		}
+		config.Location = loc
+	}
+
+	if err := in.ReportKeys.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid report key names: %w", err)
+	}
+	config.ReportKeys = in.ReportKeys
+
 	return config, nil
 }
+
+// readPromptFile loads a system prompt from path, trimming surrounding whitespace
+func readPromptFile(path string) (string, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // user-supplied CLI path
+	if err != nil {
+		return "", fmt.Errorf("failed to read prompt file: %w", err)
+	}
+
+	prompt := strings.TrimSpace(string(data))
+	if prompt == "" {
+		return "", fmt.Errorf("prompt file %s is empty", path)
+	}
+	return prompt, nil
+}
+
+// EnterpriseHosts returns the GitHub Enterprise Server hosts with a token, sorted
+func (c *Config) EnterpriseHosts() []string {
+	hosts := make([]string, 0, len(c.HostTokens))
+	for host := range c.HostTokens {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
+// splitList splits a comma-separated value, dropping empty entries
+func splitList(raw string) []string {
+	var values []string
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
@@ -1,16 +1,121 @@
 package config
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/ai"
+	"github.com/Attamusc/weekly-report-cli/internal/report"
 )
 
+// stubGHAuthToken replaces the gh CLI token lookup for the duration of a test
+func stubGHAuthToken(t *testing.T, token string, err error) {
+	t.Helper()
+	orig := ghAuthToken
+	ghAuthToken = func() (string, error) { return token, err }
+	t.Cleanup(func() { ghAuthToken = orig })
+}
+
 func TestFromEnvAndFlags_RequiresGitHubToken(t *testing.T) {
 	t.Setenv("GITHUB_TOKEN", "")
+	stubGHAuthToken(t, "", errors.New("gh: not found"))
 	_, err := FromEnvAndFlags(ConfigInput{})
 	if err == nil {
 		t.Fatal("expected error for missing GITHUB_TOKEN")
 	}
+	if !errors.Is(err, ErrNoGitHubToken) {
+		t.Errorf("expected ErrNoGitHubToken, got %v", err)
+	}
+}
+
+func TestFromEnvAndFlags_FallsBackToGHAuthToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	stubGHAuthToken(t, "gh-token", nil)
+	cfg, err := FromEnvAndFlags(ConfigInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.GitHubToken != "gh-token" {
+		t.Errorf("expected token from gh CLI, got %q", cfg.GitHubToken)
+	}
+}
+
+func TestFromEnvAndFlags_EnvTokenWinsOverGHAuthToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "env-token")
+	stubGHAuthToken(t, "gh-token", nil)
+	cfg, err := FromEnvAndFlags(ConfigInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.GitHubToken != "env-token" {
+		t.Errorf("expected GITHUB_TOKEN to take precedence, got %q", cfg.GitHubToken)
+	}
+}
+
+// writeTokenFile writes contents to a temp file and returns its path
+func writeTokenFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+	return path
+}
+
+func TestFromEnvAndFlags_TokenFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		envToken  string
+		envFile   string
+		flagFile  string
+		expected  string
+		expectErr bool
+	}{
+		{name: "flag file trimmed", flagFile: writeTokenFile(t, "  file-token\n"), expected: "file-token"},
+		{name: "env file", envFile: writeTokenFile(t, "env-file-token\r\n"), expected: "env-file-token"},
+		{name: "flag overrides env file", envFile: writeTokenFile(t, "env-file-token"), flagFile: writeTokenFile(t, "flag-file-token"), expected: "flag-file-token"},
+		{name: "GITHUB_TOKEN wins over file", envToken: "env-token", flagFile: writeTokenFile(t, "file-token"), expected: "env-token"},
+		{name: "missing file", flagFile: filepath.Join(t.TempDir(), "missing"), expectErr: true},
+		{name: "empty file", flagFile: writeTokenFile(t, " \n"), expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GITHUB_TOKEN", tt.envToken)
+			t.Setenv("GITHUB_TOKEN_FILE", tt.envFile)
+			stubGHAuthToken(t, "gh-token", nil)
+
+			cfg, err := FromEnvAndFlags(ConfigInput{TokenFile: tt.flagFile})
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected error, got token %q", cfg.GitHubToken)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if cfg.GitHubToken != tt.expected {
+				t.Errorf("got token %q, want %q", cfg.GitHubToken, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromEnvAndFlags_EmptyGHAuthToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	stubGHAuthToken(t, "", nil)
+	if _, err := FromEnvAndFlags(ConfigInput{}); !errors.Is(err, ErrNoGitHubToken) {
+		t.Errorf("expected ErrNoGitHubToken for empty gh token, got %v", err)
+	}
 }
 
 func TestFromEnvAndFlags_DefaultValues(t *testing.T) {
@@ -55,6 +160,132 @@ func TestFromEnvAndFlags_EnvVarOverrides(t *testing.T) {
 	}
 }
 
+func TestFromEnvAndFlags_ModelFlagOverridesEnv(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	t.Setenv("GITHUB_MODELS_MODEL", "gpt-4o")
+	t.Setenv("GITHUB_MODELS_BASE_URL", "https://custom.example.com")
+	cfg, err := FromEnvAndFlags(ConfigInput{
+		Model:         "gpt-4.1",
+		ModelsBaseURL: "https://flag.example.com",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.Model != "gpt-4.1" {
+		t.Errorf("got Model=%q, want gpt-4.1", cfg.Models.Model)
+	}
+	if cfg.Models.BaseURL != "https://flag.example.com" {
+		t.Errorf("got BaseURL=%q, want flag URL", cfg.Models.BaseURL)
+	}
+}
+
+func TestFromEnvAndFlags_ModelFallback(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{ModelFallback: "gpt-4o-mini"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.Fallback != "gpt-4o-mini" {
+		t.Errorf("got Models.Fallback=%q, want gpt-4o-mini", cfg.Models.Fallback)
+	}
+}
+
+func TestFromEnvAndFlags_LogFormat(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{LogFormat: "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.LogFormat != "json" {
+		t.Errorf("got LogFormat=%q, want json", cfg.LogFormat)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{LogFormat: "yaml"}); err == nil {
+		t.Error("expected error for unsupported log format")
+	}
+}
+
+func TestFromEnvAndFlags_Proxy(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Proxy != nil {
+		t.Errorf("expected no explicit proxy by default, got %v", cfg.Proxy)
+	}
+
+	cfg, err = FromEnvAndFlags(ConfigInput{Proxy: "http://proxy.corp:3128"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Proxy == nil || cfg.Proxy.Host != "proxy.corp:3128" {
+		t.Errorf("got Proxy=%v, want http://proxy.corp:3128", cfg.Proxy)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{Proxy: "proxy.corp:3128"}); err == nil {
+		t.Error("expected error for proxy URL without a scheme")
+	}
+}
+
+func TestFromEnvAndFlags_SummaryPromptFile(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	prompt := "You summarize weekly updates.\n\nKeep it to one sentence and name any blockers."
+	path := filepath.Join(t.TempDir(), "prompt.txt")
+	if err := os.WriteFile(path, []byte(prompt+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+
+	cfg, err := FromEnvAndFlags(ConfigInput{SummaryPrompt: "inline prompt", SummaryPromptFile: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.SystemPrompt != prompt {
+		t.Fatalf("got SystemPrompt=%q, want file contents %q", cfg.Models.SystemPrompt, prompt)
+	}
+
+	// The loaded prompt must reach the API as the system message
+	var systemMessage string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			Messages []struct {
+				Role    string `json:"role"`
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&request); err == nil && len(request.Messages) > 0 {
+			systemMessage = request.Messages[0].Content
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"choices": [{"message": {"content": "ok"}}]}`))
+	}))
+	defer server.Close()
+
+	client := ai.NewGHModelsClient(server.URL, cfg.Models.Model, cfg.GitHubToken, cfg.Models.SystemPrompt, 0)
+	if _, err := client.Summarize(context.Background(), "Issue", "https://github.com/o/r/issues/1", "Update"); err != nil {
+		t.Fatalf("unexpected summarize error: %v", err)
+	}
+	if systemMessage != prompt {
+		t.Errorf("got system message %q, want %q", systemMessage, prompt)
+	}
+}
+
+func TestFromEnvAndFlags_SummaryPromptFileErrors(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+
+	if _, err := FromEnvAndFlags(ConfigInput{SummaryPromptFile: filepath.Join(t.TempDir(), "missing.txt")}); err == nil {
+		t.Error("expected error for missing prompt file")
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty.txt")
+	if err := os.WriteFile(empty, []byte("  \n"), 0o600); err != nil {
+		t.Fatalf("failed to write prompt file: %v", err)
+	}
+	if _, err := FromEnvAndFlags(ConfigInput{SummaryPromptFile: empty}); err == nil {
+		t.Error("expected error for empty prompt file")
+	}
+}
+
 func TestFromEnvAndFlags_NoNotesInversion(t *testing.T) {
 	t.Setenv("GITHUB_TOKEN", "test-token")
 	cfg, _ := FromEnvAndFlags(ConfigInput{NoNotes: true})
@@ -119,6 +350,23 @@ func TestFromEnvAndFlags_AITimeout_Invalid(t *testing.T) {
 	}
 }
 
+func TestFromEnvAndFlags_AIBatchSize(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	t.Setenv("AI_BATCH_SIZE", "10")
+	cfg, err := FromEnvAndFlags(ConfigInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.BatchSize != 10 {
+		t.Errorf("got BatchSize=%d, want 10", cfg.Models.BatchSize)
+	}
+
+	t.Setenv("AI_BATCH_SIZE", "0")
+	if _, err := FromEnvAndFlags(ConfigInput{}); err == nil {
+		t.Error("expected error for non-positive AI_BATCH_SIZE")
+	}
+}
+
 func TestFromEnvAndFlags_ProjectConfig(t *testing.T) {
 	t.Setenv("GITHUB_TOKEN", "test-token")
 	cfg, err := FromEnvAndFlags(ConfigInput{
@@ -169,3 +417,223 @@ func TestErrNoRows_SentinelError(t *testing.T) {
 		t.Error("ErrNoRows should match itself via errors.Is")
 	}
 }
+
+func TestFromEnvAndFlags_StatusLabelPrefix(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{StatusLabelPrefix: "status:"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.StatusLabelPrefix != "status:" {
+		t.Errorf("got StatusLabelPrefix=%q, want status:", cfg.StatusLabelPrefix)
+	}
+}
+
+func TestFromEnvAndFlags_ReportAuthors(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{ReportAuthors: []string{"epic-owner", "status-bot[bot]"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.ReportAuthors) != 2 || cfg.ReportAuthors[0] != "epic-owner" {
+		t.Errorf("got ReportAuthors=%v, want [epic-owner status-bot[bot]]", cfg.ReportAuthors)
+	}
+}
+
+func TestFromEnvAndFlags_MinUpdateWords(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{MinUpdateWords: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MinUpdateWords != 3 {
+		t.Errorf("got MinUpdateWords=%d, want 3", cfg.MinUpdateWords)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{MinUpdateWords: -1}); err == nil {
+		t.Error("expected error for negative --min-update-words")
+	}
+}
+
+func TestFromEnvAndFlags_SummaryMaxWords(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{SummaryMaxWords: 35})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.MaxWords != 35 {
+		t.Errorf("got MaxWords=%d, want 35", cfg.Models.MaxWords)
+	}
+
+	cfg, err = FromEnvAndFlags(ConfigInput{SummaryMaxWords: 35, NoTrim: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.MaxWords != 0 {
+		t.Errorf("got MaxWords=%d with --no-trim, want 0", cfg.Models.MaxWords)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{SummaryMaxWords: -1}); err == nil {
+		t.Error("expected error for negative --summary-max-words")
+	}
+}
+
+func TestFromEnvAndFlags_ReportKeys(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	keys := report.KeyNames{Marker: "weeklyStatus", Trending: "health"}
+	cfg, err := FromEnvAndFlags(ConfigInput{ReportKeys: keys})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.ReportKeys != keys {
+		t.Errorf("got ReportKeys=%+v, want %+v", cfg.ReportKeys, keys)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{ReportKeys: report.KeyNames{Trending: "update"}}); err == nil {
+		t.Error("expected error for a trending key that collides with the update key")
+	}
+}
+
+func TestFromEnvAndFlags_Timezone(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Location != time.UTC {
+		t.Errorf("expected UTC by default, got %v", cfg.Location)
+	}
+
+	cfg, err = FromEnvAndFlags(ConfigInput{Timezone: "America/Los_Angeles"})
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	if cfg.Location.String() != "America/Los_Angeles" {
+		t.Errorf("got Location=%v, want America/Los_Angeles", cfg.Location)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{Timezone: "Mars/Olympus_Mons"}); err == nil || !strings.Contains(err.Error(), "--timezone") {
+		t.Errorf("expected --timezone error for unknown zone, got %v", err)
+	}
+}
+
+func TestFromEnvAndFlags_DoneSinceDays(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{SinceDays: 7, DoneSinceDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.DoneSinceDays != 30 {
+		t.Errorf("got DoneSinceDays=%d, want 30", cfg.DoneSinceDays)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{DoneSinceDays: -1}); err == nil {
+		t.Error("expected error for negative --done-since-days")
+	}
+}
+
+func TestFromEnvAndFlags_MaxCommentPages(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{MaxCommentPages: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MaxCommentPages != 5 {
+		t.Errorf("got MaxCommentPages=%d, want 5", cfg.MaxCommentPages)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{MaxCommentPages: -1}); err == nil {
+		t.Error("expected error for negative --max-comment-pages")
+	}
+}
+
+func TestFromEnvAndFlags_ProjectFieldValueRegex(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{ProjectFieldValues: []string{"re:^Blocked", "Done"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.Project.FieldValues) != 2 {
+		t.Errorf("got FieldValues=%v, want both values", cfg.Project.FieldValues)
+	}
+
+	_, err = FromEnvAndFlags(ConfigInput{ProjectFieldValues: []string{"re:^Blocked (v2"}})
+	if err == nil || !strings.Contains(err.Error(), "--project-field-values") {
+		t.Errorf("expected --project-field-values error for malformed regex, got %v", err)
+	}
+}
+
+func TestFromEnvAndFlags_Timeout(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{Timeout: 5 * time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Timeout != 5*time.Minute {
+		t.Errorf("got Timeout=%v, want 5m", cfg.Timeout)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{Timeout: -time.Second}); err == nil {
+		t.Error("expected error for negative timeout")
+	}
+}
+
+func TestFromEnvAndFlags_ProjectCache(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	cfg, err := FromEnvAndFlags(ConfigInput{ProjectCacheTTL: 10 * time.Minute})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Project.CacheTTL != 10*time.Minute {
+		t.Errorf("got CacheTTL=%v, want 10m", cfg.Project.CacheTTL)
+	}
+
+	cfg, err = FromEnvAndFlags(ConfigInput{ProjectCacheTTL: 10 * time.Minute, ProjectNoCache: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Project.CacheTTL != 0 {
+		t.Errorf("got CacheTTL=%v, want 0 with --no-cache", cfg.Project.CacheTTL)
+	}
+
+	if _, err := FromEnvAndFlags(ConfigInput{ProjectCacheTTL: -time.Minute}); err == nil {
+		t.Error("expected error for negative cache TTL")
+	}
+}
+
+func TestFromEnvAndFlags_GitHubHosts(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	t.Setenv("GITHUB_ENTERPRISE_TOKEN", "")
+	t.Setenv("GITHUB_HOSTS", "ignored.example.com")
+	orig := ghHostAuthToken
+	ghHostAuthToken = func(host string) (string, error) {
+		if host == "github.example.com" {
+			return "ghes-token", nil
+		}
+		return "", errors.New("not logged in")
+	}
+	t.Cleanup(func() { ghHostAuthToken = orig })
+
+	cfg, err := FromEnvAndFlags(ConfigInput{GitHubHosts: []string{"GitHub.Example.com", "github.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(cfg.HostTokens) != 1 || cfg.HostTokens["github.example.com"] != "ghes-token" {
+		t.Errorf("got host tokens %v, want the gh token for github.example.com only", cfg.HostTokens)
+	}
+
+	t.Setenv("GITHUB_ENTERPRISE_TOKEN", "env-ghes-token")
+	cfg, err = FromEnvAndFlags(ConfigInput{GitHubHosts: []string{"github.example.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.HostTokens["github.example.com"] != "env-ghes-token" {
+		t.Errorf("expected GITHUB_ENTERPRISE_TOKEN to take precedence, got %q", cfg.HostTokens["github.example.com"])
+	}
+
+	t.Setenv("GITHUB_ENTERPRISE_TOKEN", "")
+	_, err = FromEnvAndFlags(ConfigInput{})
+	if err == nil || !strings.Contains(err.Error(), "no token for GitHub host ignored.example.com") {
+		t.Errorf("expected GITHUB_HOSTS to be used without the flag and fail without a token, got %v", err)
+	}
+}
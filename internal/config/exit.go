@@ -0,0 +1,65 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrCollectionFailed indicates --fail-on-errors was set and some issues could not be collected.
+var ErrCollectionFailed = errors.New("some issues could not be collected")
+
+// ErrStaleItems indicates --fail-on-stale was set and some issues had no update in the window.
+var ErrStaleItems = errors.New("some issues have no update in the window")
+
+// Process exit codes
+const (
+	ExitOK               = 0 // Success
+	ExitFatal            = 1 // Any other error (API failures, invalid configuration, etc.)
+	ExitNoRows           = 2 // No rows produced (valid but empty result)
+	ExitCollectionFailed = 3 // --fail-on-errors and at least one issue failed
+	ExitStaleItems       = 4 // --fail-on-stale and at least one issue had no update
+)
+
+// ExitCode maps a command error to the process exit code
+func ExitCode(err error) int {
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.Is(err, ErrNoRows):
+		return ExitNoRows
+	case errors.Is(err, ErrCollectionFailed):
+		return ExitCollectionFailed
+	case errors.Is(err, ErrStaleItems):
+		return ExitStaleItems
+	default:
+		return ExitFatal
+	}
+}
+
+// CheckCollectionErrors returns ErrCollectionFailed when failOnErrors is set
+// and errorCount issues failed to collect; otherwise it returns nil
+func CheckCollectionErrors(errorCount int, failOnErrors bool) error {
+	if !failOnErrors || errorCount == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %d failed", ErrCollectionFailed, errorCount)
+}
+
+// CheckStaleItems returns ErrStaleItems when failOnStale is set and staleCount
+// issues had no update in the window; otherwise it returns nil
+func CheckStaleItems(staleCount int, failOnStale bool) error {
+	if !failOnStale || staleCount == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %d stale", ErrStaleItems, staleCount)
+}
+
+// CheckAIResults returns ErrAIIncomplete listing the issues in missing that got
+// no AI result out of total; otherwise it returns nil
+func CheckAIResults(missing []string, total int) error {
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: no AI result for %d of %d issues: %s", ErrAIIncomplete, len(missing), total, strings.Join(missing, ", "))
+}
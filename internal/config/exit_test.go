@@ -0,0 +1,93 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "success", err: nil, expected: ExitOK},
+		{name: "no rows", err: ErrNoRows, expected: ExitNoRows},
+		{name: "wrapped no rows", err: fmt.Errorf("generate: %w", ErrNoRows), expected: ExitNoRows},
+		{name: "collection failed", err: fmt.Errorf("%w: 2 failed", ErrCollectionFailed), expected: ExitCollectionFailed},
+		{name: "stale items", err: fmt.Errorf("%w: 1 stale", ErrStaleItems), expected: ExitStaleItems},
+		{name: "timeout is fatal", err: ErrRunTimedOut, expected: ExitFatal},
+		{name: "other error", err: errors.New("boom"), expected: ExitFatal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.expected {
+				t.Errorf("ExitCode(%v) = %d, want %d", tt.err, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckCollectionErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		errorCount   int
+		failOnErrors bool
+		expected     int
+	}{
+		{name: "lenient with errors", errorCount: 3, failOnErrors: false, expected: ExitOK},
+		{name: "strict without errors", errorCount: 0, failOnErrors: true, expected: ExitOK},
+		{name: "strict with errors", errorCount: 1, failOnErrors: true, expected: ExitCollectionFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckCollectionErrors(tt.errorCount, tt.failOnErrors)
+			if got := ExitCode(err); got != tt.expected {
+				t.Errorf("got exit code %d (err=%v), want %d", got, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckStaleItems(t *testing.T) {
+	tests := []struct {
+		name        string
+		staleCount  int
+		failOnStale bool
+		expected    int
+	}{
+		{name: "lenient with stale items", staleCount: 2, failOnStale: false, expected: ExitOK},
+		{name: "strict without stale items", staleCount: 0, failOnStale: true, expected: ExitOK},
+		{name: "strict with stale items", staleCount: 1, failOnStale: true, expected: ExitStaleItems},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckStaleItems(tt.staleCount, tt.failOnStale)
+			if got := ExitCode(err); got != tt.expected {
+				t.Errorf("got exit code %d (err=%v), want %d", got, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckAIResults(t *testing.T) {
+	if err := CheckAIResults(nil, 3); err != nil {
+		t.Errorf("expected no error without missing results, got %v", err)
+	}
+
+	err := CheckAIResults([]string{"https://github.com/o/r/issues/2"}, 3)
+	if !errors.Is(err, ErrAIIncomplete) {
+		t.Fatalf("expected ErrAIIncomplete, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "no AI result for 1 of 3 issues: https://github.com/o/r/issues/2") {
+		t.Errorf("expected the missing issue in the error, got %q", err.Error())
+	}
+	if got := ExitCode(err); got != ExitFatal {
+		t.Errorf("got exit code %d, want %d", got, ExitFatal)
+	}
+}
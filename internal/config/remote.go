@@ -0,0 +1,121 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/httpclient"
+)
+
+// remoteConfigTimeout bounds the startup fetch of a --config-url document
+const remoteConfigTimeout = 10 * time.Second
+
+// maxRemoteConfigSize caps how much of a remote config document is read
+const maxRemoteConfigSize = 1 << 20
+
+// RemoteConfig holds the organization-wide defaults served at --config-url.
+// The document must be JSON; YAML is rejected with an error saying so.
+// Local flags and environment variables override every field.
+type RemoteConfig struct {
+	Model          string `json:"model"`
+	SummaryPrompt  string `json:"summary_prompt"`  // System prompt for generate
+	DescribePrompt string `json:"describe_prompt"` // System prompt for describe
+}
+
+// remoteConfigCacheDir returns where fetched configs are kept as a fallback
+// for unreachable URLs. It is a variable so tests can point it at a temp dir.
+var remoteConfigCacheDir = func() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate user cache directory: %w", err)
+	}
+	return filepath.Join(base, "weekly-report-cli", "remote-config"), nil
+}
+
+// yamlKeyRegex matches a leading "key: value" line, the usual start of a YAML document
+var yamlKeyRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*:(\s|$)`)
+
+// looksLikeYAML reports whether data reads as a YAML document rather than JSON
+func looksLikeYAML(data []byte) bool {
+	text := strings.TrimSpace(string(data))
+	return strings.HasPrefix(text, "---") || yamlKeyRegex.MatchString(text)
+}
+
+// loadRemoteConfig fetches the config at rawURL. When the URL can't be
+// fetched, the copy stored by the last successful fetch is used; without one
+// the fetch error is returned.
+func loadRemoteConfig(rawURL string, proxy *url.URL) (RemoteConfig, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return RemoteConfig{}, fmt.Errorf("invalid --config-url %q: must be an http(s) URL", rawURL)
+	}
+
+	cachePath := ""
+	if dir, err := remoteConfigCacheDir(); err == nil {
+		sum := sha256.Sum256([]byte(rawURL))
+		cachePath = filepath.Join(dir, hex.EncodeToString(sum[:])+".json")
+	}
+
+	data, fetchErr := fetchRemoteConfig(rawURL, proxy)
+	if fetchErr == nil {
+		if cachePath != "" {
+			// Best effort: a missing fallback only matters if the URL goes down later
+			if err := os.MkdirAll(filepath.Dir(cachePath), 0o755); err == nil {
+				_ = os.WriteFile(cachePath, data, 0o600)
+			}
+		}
+	} else {
+		var cached []byte
+		if cachePath != "" {
+			cached, err = os.ReadFile(cachePath) //nolint:gosec // path derived from the cache dir
+		}
+		if cached == nil || err != nil {
+			return RemoteConfig{}, fmt.Errorf("failed to fetch config from %s (no cached copy to fall back on): %w", rawURL, fetchErr)
+		}
+		data = cached
+	}
+
+	var remote RemoteConfig
+	if err := json.Unmarshal(data, &remote); err != nil {
+		if looksLikeYAML(data) {
+			return RemoteConfig{}, fmt.Errorf("invalid config at %s: it looks like YAML, but only JSON is supported", rawURL)
+		}
+		return RemoteConfig{}, fmt.Errorf("invalid config at %s: %w", rawURL, err)
+	}
+	return remote, nil
+}
+
+// fetchRemoteConfig downloads the config document at rawURL
+func fetchRemoteConfig(rawURL string, proxy *url.URL) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteConfigTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Transport: httpclient.NewTransport(proxy)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxRemoteConfigSize))
+}
@@ -0,0 +1,135 @@
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// isolateRemoteConfig gives the test an empty fallback cache
+func isolateRemoteConfig(t *testing.T) {
+	t.Helper()
+	dir := t.TempDir()
+	origDir := remoteConfigCacheDir
+	remoteConfigCacheDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { remoteConfigCacheDir = origDir })
+}
+
+// newRemoteConfigServer serves body as the config document
+func newRemoteConfigServer(t *testing.T, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFromEnvAndFlags_RemoteConfigDefaults(t *testing.T) {
+	isolateRemoteConfig(t)
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	t.Setenv("GITHUB_MODELS_MODEL", "")
+	server := newRemoteConfigServer(t, `{"model": "org-model", "summary_prompt": "Summarize for the org.", "describe_prompt": "Describe for the org."}`)
+
+	cfg, err := FromEnvAndFlags(ConfigInput{ConfigURL: server.URL + "/weekly-report.json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.Model != "org-model" {
+		t.Errorf("got model %q, want org-model", cfg.Models.Model)
+	}
+	if cfg.Models.SystemPrompt != "Summarize for the org." {
+		t.Errorf("got prompt %q, want the remote prompt", cfg.Models.SystemPrompt)
+	}
+
+	cfg, err = FromEnvAndFlags(ConfigInput{ConfigURL: server.URL + "/weekly-report.json", DescribePrompts: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.SystemPrompt != "Describe for the org." {
+		t.Errorf("got prompt %q, want the remote describe prompt", cfg.Models.SystemPrompt)
+	}
+}
+
+func TestFromEnvAndFlags_RemoteConfigOverriddenLocally(t *testing.T) {
+	isolateRemoteConfig(t)
+	t.Setenv("GITHUB_TOKEN", "test-token")
+	t.Setenv("GITHUB_MODELS_MODEL", "env-model")
+	server := newRemoteConfigServer(t, `{"model": "org-model", "summary_prompt": "Summarize for the org."}`)
+	t.Setenv("WEEKLY_REPORT_CONFIG_URL", server.URL)
+
+	cfg, err := FromEnvAndFlags(ConfigInput{SummaryPrompt: "Local prompt."})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Models.Model != "env-model" {
+		t.Errorf("got model %q, want the env model to win", cfg.Models.Model)
+	}
+	if cfg.Models.SystemPrompt != "Local prompt." {
+		t.Errorf("got prompt %q, want the flag prompt to win", cfg.Models.SystemPrompt)
+	}
+}
+
+func TestLoadRemoteConfig_UnreachableURL(t *testing.T) {
+	isolateRemoteConfig(t)
+	server := newRemoteConfigServer(t, `{"model": "org-model"}`)
+	configURL := server.URL
+	server.Close()
+
+	_, err := loadRemoteConfig(configURL, nil)
+	if err == nil {
+		t.Fatal("expected an error for an unreachable URL")
+	}
+	if !strings.Contains(err.Error(), configURL) || !strings.Contains(err.Error(), "no cached copy") {
+		t.Errorf("got %q, want it to name the URL and the missing fallback", err)
+	}
+}
+
+func TestLoadRemoteConfig_FallsBackToLastFetch(t *testing.T) {
+	isolateRemoteConfig(t)
+	server := newRemoteConfigServer(t, `{"model": "org-model"}`)
+	configURL := server.URL
+
+	if _, err := loadRemoteConfig(configURL, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Start a new run with the URL down
+	server.Close()
+
+	remote, err := loadRemoteConfig(configURL, nil)
+	if err != nil {
+		t.Fatalf("expected the cached copy to be used, got %v", err)
+	}
+	if remote.Model != "org-model" {
+		t.Errorf("got model %q, want org-model", remote.Model)
+	}
+}
+
+func TestLoadRemoteConfig_Invalid(t *testing.T) {
+	isolateRemoteConfig(t)
+	server := newRemoteConfigServer(t, `{"model": `)
+	yamlServer := newRemoteConfigServer(t, "model: org-model\nsummary_prompt: Summarize.\n")
+
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "not a URL", url: "weekly-report.json", want: "must be an http(s) URL"},
+		{name: "unsupported scheme", url: "file:///etc/weekly-report.json", want: "must be an http(s) URL"},
+		{name: "not JSON", url: server.URL, want: "invalid config"},
+		{name: "YAML", url: yamlServer.URL, want: "looks like YAML, but only JSON is supported"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadRemoteConfig(tt.url, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ErrNoGitHubToken indicates neither GITHUB_TOKEN, a token file, nor the GitHub CLI provided a token.
+var ErrNoGitHubToken = errors.New("GITHUB_TOKEN environment variable is required (or set --token-file/GITHUB_TOKEN_FILE, or authenticate the GitHub CLI with 'gh auth login')")
+
+// ghTokenTimeout bounds how long we wait on the gh binary
+const ghTokenTimeout = 5 * time.Second
+
+// ghAuthToken asks the GitHub CLI for its stored token. It is a variable so
+// tests can stub it without a gh binary on PATH.
+var ghAuthToken = func() (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ghTokenTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, "gh", "auth", "token").Output()
+	if err != nil {
+		return "", fmt.Errorf("gh auth token failed: %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// ghHostAuthToken asks the GitHub CLI for its stored token for a GitHub
+// Enterprise Server host. It is a variable so tests can stub it.
+var ghHostAuthToken = func(host string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), ghTokenTimeout)
+	defer cancel()
+
+	out, err := exec.CommandContext(ctx, "gh", "auth", "token", "--hostname", host).Output()
+	if err != nil {
+		return "", fmt.Errorf("gh auth token --hostname %s failed: %w", host, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// resolveHostTokens returns a token for each GitHub Enterprise Server host:
+// envToken (GITHUB_ENTERPRISE_TOKEN) when set, otherwise the GitHub CLI's
+// token for that host
+func resolveHostTokens(hosts []string, envToken string) (map[string]string, error) {
+	tokens := make(map[string]string, len(hosts))
+	for _, host := range hosts {
+		host = strings.ToLower(host)
+		if host == "github.com" {
+			continue
+		}
+		token := envToken
+		if token == "" {
+			var err error
+			token, err = ghHostAuthToken(host)
+			if err != nil || token == "" {
+				return nil, fmt.Errorf("no token for GitHub host %s: set GITHUB_ENTERPRISE_TOKEN or run 'gh auth login --hostname %s'", host, host)
+			}
+		}
+		tokens[host] = token
+	}
+	return tokens, nil
+}
+
+// resolveGitHubToken returns envToken when set, then the contents of tokenFile
+// (e.g. a mounted secret), falling back to the GitHub CLI
+func resolveGitHubToken(envToken, tokenFile string) (string, error) {
+	if envToken != "" {
+		return envToken, nil
+	}
+
+	if tokenFile != "" {
+		return readTokenFile(tokenFile)
+	}
+
+	token, err := ghAuthToken()
+	if err != nil || token == "" {
+		return "", ErrNoGitHubToken
+	}
+	return token, nil
+}
+
+// readTokenFile reads a token from path, trimming surrounding whitespace and newlines
+func readTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // user-supplied token path
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return token, nil
+}
@@ -16,11 +16,22 @@ var dateLayouts = []string{
 
 // ParseTargetDate attempts to parse a target date string into a time.Time pointer
 // Returns nil if the date string is empty, invalid, or cannot be parsed
-// Tries multiple common date formats: YYYY-MM-DD, RFC3339, and variants
+// Tries multiple common date formats: YYYY-MM-DD, RFC3339, and variants.
+// Values without a UTC offset are read in UTC.
 func ParseTargetDate(raw string) *time.Time {
+	return ParseTargetDateIn(raw, time.UTC)
+}
+
+// ParseTargetDateIn parses raw like ParseTargetDate, reading values without a
+// UTC offset in loc (nil = UTC). The result is in loc, so it renders and counts
+// days in that timezone.
+func ParseTargetDateIn(raw string, loc *time.Location) *time.Time {
 	if raw == "" {
 		return nil
 	}
+	if loc == nil {
+		loc = time.UTC
+	}
 
 	// Normalize whitespace and remove common prefixes/suffixes
 	raw = strings.TrimSpace(raw)
@@ -30,10 +41,10 @@ func ParseTargetDate(raw string) *time.Time {
 
 	// Try each layout format
 	for _, layout := range dateLayouts {
-		if parsed, err := time.Parse(layout, raw); err == nil {
-			// Convert to UTC for consistent handling
-			utc := parsed.UTC()
-			return &utc
+		if parsed, err := time.ParseInLocation(layout, raw, loc); err == nil {
+			// Convert to loc for consistent handling
+			inLoc := parsed.In(loc)
+			return &inLoc
 		}
 	}
 
@@ -41,16 +52,25 @@ func ParseTargetDate(raw string) *time.Time {
 	return nil
 }
 
+// DaysSince returns the number of whole calendar days from t to now, compared
+// by date in t's timezone so the time of day doesn't matter. The result is
+// negative when t falls after now.
+func DaysSince(t, now time.Time) int {
+	now = now.In(t.Location())
+	from := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from).Hours() / 24)
+}
+
 // RenderTargetDate formats a time pointer as a date string
 // Returns "TBD" if the time pointer is nil
-// Returns YYYY-MM-DD format for valid dates (always in UTC)
+// Returns YYYY-MM-DD format for valid dates, in the time's own timezone
 func RenderTargetDate(t *time.Time) string {
 	if t == nil {
 		return "TBD"
 	}
 
-	// Format as YYYY-MM-DD in UTC
-	return t.UTC().Format("2006-01-02")
+	return t.Format("2006-01-02")
 }
 
 // IsValidDate checks if a date string can be successfully parsed
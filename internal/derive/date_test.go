@@ -306,3 +306,61 @@ func TestParseTargetDateTimezoneConsistency(t *testing.T) {
 		}
 	}
 }
+
+func TestParseTargetDateIn_Location(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	// 03:00 UTC on the 6th is still the evening of the 5th in Los Angeles
+	boundary := "2025-08-06T03:00:00Z"
+
+	if got := RenderTargetDate(ParseTargetDateIn(boundary, nil)); got != "2025-08-06" {
+		t.Errorf("UTC: got %q, want 2025-08-06", got)
+	}
+
+	inLA := ParseTargetDateIn(boundary, la)
+	if got := RenderTargetDate(inLA); got != "2025-08-05" {
+		t.Errorf("America/Los_Angeles: got %q, want 2025-08-05", got)
+	}
+	if !inLA.Equal(*ParseTargetDate(boundary)) {
+		t.Error("expected the location to leave the underlying instant alone")
+	}
+
+	// Dates without an offset are read as that calendar day in the location
+	dateOnly := ParseTargetDateIn("2025-08-06", la)
+	if got := RenderTargetDate(dateOnly); got != "2025-08-06" {
+		t.Errorf("date-only value in America/Los_Angeles: got %q, want 2025-08-06", got)
+	}
+
+	// Days are counted by calendar date in the parsed date's timezone
+	now := time.Date(2025, 8, 6, 4, 0, 0, 0, time.UTC) // Evening of the 5th in Los Angeles
+	if got := DaysSince(*dateOnly, now); got != -1 {
+		t.Errorf("DaysSince in America/Los_Angeles: got %d, want -1", got)
+	}
+}
+
+func TestDaysSince(t *testing.T) {
+	now := time.Date(2025, 8, 13, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		t        time.Time
+		expected int
+	}{
+		{"same day earlier", time.Date(2025, 8, 13, 0, 0, 0, 0, time.UTC), 0},
+		{"same day later", time.Date(2025, 8, 13, 23, 0, 0, 0, time.UTC), 0},
+		{"yesterday", time.Date(2025, 8, 12, 23, 59, 0, 0, time.UTC), 1},
+		{"twelve days", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), 12},
+		{"across month", time.Date(2025, 7, 21, 0, 0, 0, 0, time.UTC), 23},
+		{"future", time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC), -2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DaysSince(tt.t, now); got != tt.expected {
+				t.Errorf("DaysSince(%v) = %d, expected %d", tt.t, got, tt.expected)
+			}
+		})
+	}
+}
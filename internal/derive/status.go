@@ -23,6 +23,9 @@ var (
 	Unknown     = Status{Emoji: ":black_circle:", Caption: "Unknown"}
 )
 
+// Statuses lists every predefined status
+var Statuses = []Status{OnTrack, AtRisk, OffTrack, NotStarted, NeedsUpdate, Shaping, Done, Unknown}
+
 // Status mapping patterns (case-insensitive)
 var statusMappings = []struct {
 	patterns []string
@@ -128,8 +131,21 @@ func matchLabelPattern(label string) (Status, bool) {
 // MapLabelsToStatus attempts to derive a status from issue labels using
 // word-boundary matching. Returns the first matching status and true, or
 // (Unknown, false) if no label matches a known status pattern.
-func MapLabelsToStatus(labels []string) (Status, bool) {
+//
+// When prefix is non-empty (e.g. "status:"), only labels starting with the
+// prefix (case-insensitive) are considered, and the prefix is stripped before
+// matching, so "status: blocked" maps to Off Track while a bare "blocked"
+// label is ignored.
+func MapLabelsToStatus(labels []string, prefix string) (Status, bool) {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
 	for _, label := range labels {
+		if prefix != "" {
+			lower := strings.ToLower(strings.TrimSpace(label))
+			if !strings.HasPrefix(lower, prefix) {
+				continue
+			}
+			label = lower[len(prefix):]
+		}
 		if status, ok := matchLabelPattern(label); ok {
 			return status, true
 		}
@@ -190,3 +206,41 @@ func ParseStatusKey(key string) (Status, bool) {
 		return Unknown, false
 	}
 }
+
+// sentimentStatuses maps the suggested_status strings returned by sentiment
+// analysis to the statuses they can override a row with. Unknown is left out:
+// a suggestion that carries no status shouldn't replace a reported one.
+var sentimentStatuses = map[string]Status{
+	"on_track":  OnTrack,
+	"at_risk":   AtRisk,
+	"off_track": OffTrack,
+	"blocked":   OffTrack,
+	"done":      Done,
+}
+
+// MapSentimentStatus converts a sentiment suggested_status string (e.g.
+// "at_risk") to a Status, ignoring case, surrounding whitespace, and whether
+// words are joined by underscores, hyphens, or spaces. Returns (Unknown, false)
+// for strings without a mapping, so callers skip the override.
+func MapSentimentStatus(s string) (Status, bool) {
+	key := strings.ToLower(strings.TrimSpace(s))
+	key = strings.NewReplacer("-", "_", " ", "_").Replace(key)
+	if status, ok := sentimentStatuses[key]; ok {
+		return status, true
+	}
+	return Unknown, false
+}
+
+// ParseCaption returns the predefined status whose caption is caption (e.g.
+// "Needs Update"), matching case-insensitively. Emojis are shared between
+// statuses, so the caption is what identifies one. Returns (Unknown, false)
+// if no caption matches.
+func ParseCaption(caption string) (Status, bool) {
+	caption = strings.TrimSpace(caption)
+	for _, status := range Statuses {
+		if strings.EqualFold(status.Caption, caption) {
+			return status, true
+		}
+	}
+	return Unknown, false
+}
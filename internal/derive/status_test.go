@@ -1,6 +1,7 @@
 package derive
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -386,6 +387,32 @@ func TestStatusKey_Roundtrip(t *testing.T) {
 	}
 }
 
+func TestParseCaption_Roundtrip(t *testing.T) {
+	// Every predefined status must be recoverable from its rendered caption,
+	// which requires captions to be unique
+	seen := make(map[string]bool)
+	for _, status := range Statuses {
+		if seen[status.Caption] {
+			t.Errorf("caption %q is shared by more than one status", status.Caption)
+		}
+		seen[status.Caption] = true
+
+		// String() renders "<emoji> <caption>"; the caption follows the emoji
+		rendered := strings.TrimPrefix(status.String(), status.Emoji+" ")
+		parsed, ok := ParseCaption(rendered)
+		if !ok || parsed != status {
+			t.Errorf("Round-trip failed: %+v -> %q -> %+v (ok=%t)", status, rendered, parsed, ok)
+		}
+	}
+
+	if parsed, ok := ParseCaption("  needs update "); !ok || parsed != NeedsUpdate {
+		t.Errorf("expected case- and whitespace-insensitive match, got %+v (ok=%t)", parsed, ok)
+	}
+	if parsed, ok := ParseCaption("Stale"); ok || parsed != Unknown {
+		t.Errorf("expected (Unknown, false) for an unknown caption, got %+v (ok=%t)", parsed, ok)
+	}
+}
+
 func TestCircleEmojiRegex(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -560,7 +587,7 @@ func TestMapLabelsToStatus(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			status, ok := MapLabelsToStatus(tt.labels)
+			status, ok := MapLabelsToStatus(tt.labels, "")
 			if ok != tt.wantOK {
 				t.Errorf("MapLabelsToStatus(%v) ok = %t, want %t", tt.labels, ok, tt.wantOK)
 			}
@@ -570,3 +597,96 @@ func TestMapLabelsToStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMapLabelsToStatus_Prefix(t *testing.T) {
+	tests := []struct {
+		name       string
+		labels     []string
+		prefix     string
+		wantStatus Status
+		wantOK     bool
+	}{
+		{
+			name:       "prefixed label matches",
+			labels:     []string{"epic", "status: blocked"},
+			prefix:     "status:",
+			wantStatus: OffTrack,
+			wantOK:     true,
+		},
+		{
+			name:       "prefix is case-insensitive",
+			labels:     []string{"Status: At Risk"},
+			prefix:     "status:",
+			wantStatus: AtRisk,
+			wantOK:     true,
+		},
+		{
+			name:       "prefix without separator space",
+			labels:     []string{"status/on track"},
+			prefix:     "status/",
+			wantStatus: OnTrack,
+			wantOK:     true,
+		},
+		{
+			name:       "unprefixed label is ignored",
+			labels:     []string{"blocked"},
+			prefix:     "status:",
+			wantStatus: Unknown,
+			wantOK:     false,
+		},
+		{
+			name:       "prefixed label with unknown value",
+			labels:     []string{"status: triage"},
+			prefix:     "status:",
+			wantStatus: Unknown,
+			wantOK:     false,
+		},
+		{
+			name:       "first matching prefixed label wins",
+			labels:     []string{"done", "status: on track", "status: blocked"},
+			prefix:     "status:",
+			wantStatus: OnTrack,
+			wantOK:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, ok := MapLabelsToStatus(tt.labels, tt.prefix)
+			if ok != tt.wantOK {
+				t.Errorf("MapLabelsToStatus(%v, %q) ok = %t, want %t", tt.labels, tt.prefix, ok, tt.wantOK)
+			}
+			if status != tt.wantStatus {
+				t.Errorf("MapLabelsToStatus(%v, %q) = %+v, want %+v", tt.labels, tt.prefix, status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestMapSentimentStatus(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Status
+		ok       bool
+	}{
+		{input: "on_track", expected: OnTrack, ok: true},
+		{input: "at_risk", expected: AtRisk, ok: true},
+		{input: "off_track", expected: OffTrack, ok: true},
+		{input: "done", expected: Done, ok: true},
+		{input: "blocked", expected: OffTrack, ok: true},
+		{input: " At-Risk ", expected: AtRisk, ok: true},
+		{input: "off track", expected: OffTrack, ok: true},
+		{input: "unknown", expected: Unknown, ok: false},
+		{input: "vibes", expected: Unknown, ok: false},
+		{input: "", expected: Unknown, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			status, ok := MapSentimentStatus(tt.input)
+			if status != tt.expected || ok != tt.ok {
+				t.Errorf("MapSentimentStatus(%q) = (%+v, %v), expected (%+v, %v)", tt.input, status, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
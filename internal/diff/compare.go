@@ -3,11 +3,14 @@ package diff
 import (
 	"fmt"
 
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
 	"github.com/Attamusc/weekly-report-cli/internal/format"
 )
 
 // Compare takes previous report rows and current format.Row slice, and returns
-// annotated rows with status transitions plus additional notes for new/removed items.
+// annotated rows with status transitions plus additional notes for new/removed
+// items and changed target dates. Target dates are only compared when the
+// previous report recorded one.
 func Compare(previous []PreviousRow, current []format.Row) ([]format.Row, []format.Note) {
 	if len(previous) == 0 {
 		return current, nil
@@ -28,8 +31,9 @@ func Compare(previous []PreviousRow, current []format.Row) ([]format.Row, []form
 		if !existed {
 			current[i].NewItem = true
 			notes = append(notes, format.Note{
-				Kind:     format.NoteNewItem,
-				IssueURL: current[i].EpicURL,
+				Kind:       format.NoteNewItem,
+				IssueURL:   current[i].EpicURL,
+				IssueTitle: current[i].EpicTitle,
 			})
 			continue
 		}
@@ -40,10 +44,21 @@ func Compare(previous []PreviousRow, current []format.Row) ([]format.Row, []form
 			notes = append(notes, format.Note{
 				Kind:            format.NoteStatusChanged,
 				IssueURL:        current[i].EpicURL,
+				IssueTitle:      current[i].EpicTitle,
 				ReportedStatus:  prev.StatusCaption,
 				SuggestedStatus: current[i].StatusCaption,
 			})
 		}
+
+		if prev.TargetDate != "" && prev.TargetDate != derive.RenderTargetDate(current[i].TargetDate) {
+			notes = append(notes, format.Note{
+				Kind:               format.NoteTargetDateChanged,
+				IssueURL:           current[i].EpicURL,
+				IssueTitle:         current[i].EpicTitle,
+				TargetDate:         current[i].TargetDate,
+				PreviousTargetDate: prev.TargetDate,
+			})
+		}
 	}
 
 	for _, prev := range previous {
@@ -51,6 +66,7 @@ func Compare(previous []PreviousRow, current []format.Row) ([]format.Row, []form
 			notes = append(notes, format.Note{
 				Kind:           format.NoteRemovedItem,
 				IssueURL:       prev.IssueURL,
+				IssueTitle:     prev.Title,
 				ReportedStatus: prev.StatusCaption,
 			})
 		}
@@ -2,6 +2,7 @@ package diff
 
 import (
 	"testing"
+	"time"
 
 	"github.com/Attamusc/weekly-report-cli/internal/format"
 )
@@ -96,6 +97,7 @@ func TestCompare_RemovedItem(t *testing.T) {
 		makePrev("https://example.com/1", ":green_circle:", "On Track"),
 		makePrev("https://example.com/2", ":red_circle:", "Off Track"),
 	}
+	prev[1].Title = "Retired Epic"
 	current := []format.Row{makeRow("https://example.com/1", ":green_circle:", "On Track")}
 	_, notes := Compare(prev, current)
 	found := false
@@ -105,6 +107,9 @@ func TestCompare_RemovedItem(t *testing.T) {
 			if n.ReportedStatus != "Off Track" {
 				t.Errorf("expected ReportedStatus=Off Track, got %s", n.ReportedStatus)
 			}
+			if n.IssueTitle != "Retired Epic" {
+				t.Errorf("expected IssueTitle=Retired Epic, got %q", n.IssueTitle)
+			}
 		}
 	}
 	if !found {
@@ -169,3 +174,65 @@ func TestCompare_EmptyCurrent(t *testing.T) {
 		t.Errorf("expected 2 NoteRemovedItem, got %d", removed)
 	}
 }
+
+func TestCompare_TargetDateChanged(t *testing.T) {
+	prev := []PreviousRow{
+		{IssueURL: "https://example.com/1", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "2024-01-15"},
+		{IssueURL: "https://example.com/2", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "TBD"},
+		makePrev("https://example.com/3", ":green_circle:", "On Track"),
+	}
+	moved := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	current := []format.Row{
+		makeRow("https://example.com/1", ":green_circle:", "On Track"),
+		makeRow("https://example.com/2", ":green_circle:", "On Track"),
+		makeRow("https://example.com/3", ":green_circle:", "On Track"),
+	}
+	current[0].TargetDate = &moved
+	current[2].TargetDate = &moved
+
+	_, notes := Compare(prev, current)
+	if len(notes) != 1 {
+		t.Fatalf("expected 1 note, got %d: %+v", len(notes), notes)
+	}
+	note := notes[0]
+	if note.Kind != format.NoteTargetDateChanged || note.IssueURL != "https://example.com/1" {
+		t.Errorf("unexpected note: %+v", note)
+	}
+	if note.PreviousTargetDate != "2024-01-15" || note.TargetDate == nil || !note.TargetDate.Equal(moved) {
+		t.Errorf("unexpected dates on note: %+v", note)
+	}
+}
+
+func TestCompare_JSONPrevious(t *testing.T) {
+	prev, err := ParseJSONReport(`[
+  {"url": "https://example.com/1", "status": "At Risk"},
+  {"url": "https://example.com/2", "status": "On Track"}
+]`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	current := []format.Row{
+		makeRow("https://example.com/1", ":green_circle:", "On Track"),
+		makeRow("https://example.com/3", ":green_circle:", "On Track"),
+	}
+
+	rows, notes := Compare(prev, current)
+
+	if rows[0].StatusTransition == nil || *rows[0].StatusTransition != ":yellow_circle:→:green_circle:" {
+		t.Errorf("expected At Risk→On Track transition on row 1, got %v", rows[0].StatusTransition)
+	}
+	if !rows[1].NewItem {
+		t.Error("expected row 3 to be flagged as new")
+	}
+
+	counts := make(map[format.NoteKind]int)
+	for _, n := range notes {
+		counts[n.Kind]++
+		if n.Kind == format.NoteStatusChanged && n.ReportedStatus != "At Risk" {
+			t.Errorf("expected previous status At Risk, got %q", n.ReportedStatus)
+		}
+	}
+	if counts[format.NoteStatusChanged] != 1 || counts[format.NoteNewItem] != 1 || counts[format.NoteRemovedItem] != 1 {
+		t.Errorf("expected one changed, new, and removed note, got %v", counts)
+	}
+}
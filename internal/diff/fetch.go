@@ -0,0 +1,53 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/httpclient"
+)
+
+// previousReportTimeout bounds the fetch of a --previous-url report
+const previousReportTimeout = 30 * time.Second
+
+// maxPreviousReportSize caps how much of a fetched previous report is read
+const maxPreviousReportSize = 10 << 20
+
+// FetchPreviousReport downloads the previous report served at rawURL and
+// parses it like ParsePreviousReportWithDateHeader, so the URL may serve either
+// the JSON export or a markdown table. Requests go through proxy when set.
+func FetchPreviousReport(ctx context.Context, rawURL string, proxy *url.URL, dateHeader string) ([]PreviousRow, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid --previous-url %q: must be an http(s) URL", rawURL)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, previousReportTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	client := &http.Client{Transport: httpclient.NewTransport(proxy)}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch previous report from %s: %w", rawURL, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch previous report from %s: unexpected status %s", rawURL, resp.Status)
+	}
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxPreviousReportSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read previous report from %s: %w", rawURL, err)
+	}
+	return ParsePreviousReportWithDateHeader(string(content), dateHeader)
+}
@@ -0,0 +1,63 @@
+package diff
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Attamusc/weekly-report-cli/internal/format"
+)
+
+func TestFetchPreviousReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reports/last-week.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"url": "https://example.com/1", "status": "At Risk", "target_date": "2024-01-15"}]`))
+	}))
+	defer server.Close()
+
+	previous, err := FetchPreviousReport(context.Background(), server.URL+"/reports/last-week.json", nil, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	current := []format.Row{
+		makeRow("https://example.com/1", ":green_circle:", "On Track"),
+		makeRow("https://example.com/2", ":green_circle:", "On Track"),
+	}
+	_, notes := Compare(previous, current)
+	if got := format.CountNotesByKind(notes, format.NoteStatusChanged); got != 1 {
+		t.Errorf("got %d status changes, want 1 (notes %+v)", got, notes)
+	}
+	if got := format.CountNotesByKind(notes, format.NoteNewItem); got != 1 {
+		t.Errorf("got %d new items, want 1 (notes %+v)", got, notes)
+	}
+}
+
+func TestFetchPreviousReport_Errors(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "not a URL", url: "report.json", want: "must be an http(s) URL"},
+		{name: "unexpected status", url: server.URL + "/missing.json", want: "unexpected status 404"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FetchPreviousReport(context.Background(), tt.url, nil, "")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
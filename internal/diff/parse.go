@@ -1,31 +1,109 @@
 package diff
 
 import (
+	"encoding/json"
+	"fmt"
 	"regexp"
 	"strings"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+	"github.com/Attamusc/weekly-report-cli/internal/format"
 )
 
 // PreviousRow represents a row parsed from a previous report's markdown table.
 type PreviousRow struct {
 	IssueURL      string // Extracted from markdown link [title](url)
+	Title         string // Link text of the markdown link, or the JSON "title" (empty when absent)
 	StatusEmoji   string // e.g., ":green_circle:"
 	StatusCaption string // e.g., "On Track"
 	TargetDate    string // Raw string: "2024-01-15" or "TBD"
 }
 
 var (
-	mdLinkRe    = regexp.MustCompile(`\[.*?\]\((https?://[^)]+)\)`)
+	mdLinkRe    = regexp.MustCompile(`\[(.*?)\]\((https?://[^)]+)\)`)
 	emojiRe     = regexp.MustCompile(`^(:[a-z_]+:)\s*(.*)$`)
 	separatorRe = regexp.MustCompile(`^\|[-| :]+\|$`)
 )
 
+// jsonPreviousRow is one element of a JSON previous report
+type jsonPreviousRow struct {
+	URL        string `json:"url"`
+	Title      string `json:"title"`
+	Status     string `json:"status"`      // Caption such as "At Risk"
+	TargetDate string `json:"target_date"` // "2024-01-15" or "TBD"
+}
+
+// ParsePreviousReport parses a previous report in either supported form: a
+// JSON array of rows when the content starts with '[', otherwise a markdown table.
+func ParsePreviousReport(content string) ([]PreviousRow, error) {
+	return ParsePreviousReportWithDateHeader(content, "")
+}
+
+// ParsePreviousReportWithDateHeader parses a previous report like
+// ParsePreviousReport, finding a markdown table's target date column by
+// dateHeader (empty = the default "Target Date" header)
+func ParsePreviousReportWithDateHeader(content, dateHeader string) ([]PreviousRow, error) {
+	if strings.HasPrefix(strings.TrimSpace(content), "[") {
+		return ParseJSONReport(content)
+	}
+	return ParseReportWithDateHeader(content, dateHeader), nil
+}
+
+// ParseJSONReport parses a JSON array of {"url", "title", "status", "target_date"}
+// objects into PreviousRow structs. Rows without a URL are skipped; statuses
+// are mapped to canonical captions. Returns nil if no valid rows are found.
+func ParseJSONReport(content string) ([]PreviousRow, error) {
+	var entries []jsonPreviousRow
+	if err := json.Unmarshal([]byte(content), &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON previous report: %w", err)
+	}
+
+	var rows []PreviousRow
+	for _, entry := range entries {
+		if entry.URL == "" {
+			continue
+		}
+		// Exports carry captions ("Needs Update"); trending phrases are the fallback
+		status, ok := derive.ParseCaption(strings.TrimSpace(entry.Status))
+		if !ok {
+			status = derive.MapTrending(entry.Status)
+		}
+		rows = append(rows, PreviousRow{
+			IssueURL:      entry.URL,
+			Title:         strings.TrimSpace(entry.Title),
+			StatusEmoji:   status.Emoji,
+			StatusCaption: status.Caption,
+			TargetDate:    strings.TrimSpace(entry.TargetDate),
+		})
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return rows, nil
+}
+
 // ParseReport parses a markdown table from a previous report into PreviousRow structs.
 // Malformed rows are silently skipped. Returns nil if no valid rows are found.
 func ParseReport(content string) []PreviousRow {
+	return ParseReportWithDateHeader(content, "")
+}
+
+// ParseReportWithDateHeader parses a markdown table like ParseReport. The
+// target date is read from the column whose header matches dateHeader
+// (case-insensitively; empty = the default "Target Date" header), since
+// --columns and --no-date-column move or drop it. Rows of a table without such
+// a header get an empty TargetDate.
+func ParseReportWithDateHeader(content, dateHeader string) []PreviousRow {
+	if dateHeader == "" {
+		dateHeader = format.DefaultTableHeaders[2]
+	}
+
 	lines := strings.Split(content, "\n")
 	var rows []PreviousRow
+	dateCol := -1
 
-	for _, line := range lines {
+	for i, line := range lines {
 		line = strings.TrimSpace(line)
 		if line == "" {
 			continue
@@ -38,21 +116,31 @@ func ParseReport(content string) []PreviousRow {
 			continue
 		}
 
-		// Temporarily replace escaped pipes to avoid splitting on them
-		escaped := strings.ReplaceAll(line, `\|`, "\x00")
-		parts := strings.Split(escaped[1:len(escaped)-1], "|")
-		// Restore escaped pipes in each part
-		for i, p := range parts {
-			parts[i] = strings.ReplaceAll(p, "\x00", "|")
+		parts := splitTableLine(line)
+
+		// The line above a separator is a header; it sets the date column
+		if i+1 < len(lines) && isHeaderSeparator(strings.TrimSpace(lines[i+1])) {
+			dateCol = -1
+			for j, cell := range parts {
+				if strings.EqualFold(strings.TrimSpace(cell), dateHeader) {
+					dateCol = j
+					break
+				}
+			}
+			continue
 		}
 
-		if len(parts) < 4 {
+		// Status, epic, and update columns are always present
+		if len(parts) < 3 {
 			continue
 		}
 
 		statusCell := strings.TrimSpace(parts[0])
 		issueCell := strings.TrimSpace(parts[1])
-		targetCell := strings.TrimSpace(parts[2])
+		targetCell := ""
+		if dateCol >= 0 && dateCol < len(parts) {
+			targetCell = strings.TrimSpace(parts[dateCol])
+		}
 
 		// Must have a markdown link
 		urlMatch := mdLinkRe.FindStringSubmatch(issueCell)
@@ -72,7 +160,8 @@ func ParseReport(content string) []PreviousRow {
 		}
 
 		rows = append(rows, PreviousRow{
-			IssueURL:      urlMatch[1],
+			IssueURL:      urlMatch[2],
+			Title:         strings.TrimSpace(strings.ReplaceAll(urlMatch[1], `\\`, `\`)),
 			StatusEmoji:   emojiMatch[1],
 			StatusCaption: strings.TrimSpace(emojiMatch[2]),
 			TargetDate:    targetCell,
@@ -84,3 +173,22 @@ func ParseReport(content string) []PreviousRow {
 	}
 	return rows
 }
+
+// isHeaderSeparator reports whether line is the dashed line under a table
+// header (an all-blank row also matches separatorRe)
+func isHeaderSeparator(line string) bool {
+	return separatorRe.MatchString(line) && strings.Contains(line, "-")
+}
+
+// splitTableLine splits a markdown table line into its cells, keeping escaped
+// pipes inside them
+func splitTableLine(line string) []string {
+	// Temporarily replace escaped pipes to avoid splitting on them
+	escaped := strings.ReplaceAll(line, `\|`, "\x00")
+	parts := strings.Split(escaped[1:len(escaped)-1], "|")
+	// Restore escaped pipes in each part
+	for i, p := range parts {
+		parts[i] = strings.ReplaceAll(p, "\x00", "|")
+	}
+	return parts
+}
@@ -1,7 +1,13 @@
 package diff
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+	"github.com/Attamusc/weekly-report-cli/internal/format"
 )
 
 func TestParseReport(t *testing.T) {
@@ -17,8 +23,17 @@ func TestParseReport(t *testing.T) {
 | :green_circle: On Track | [Issue One](https://github.com/org/repo/issues/1) | 2024-01-15 | Summary text |
 | :yellow_circle: At Risk | [Issue Two](https://github.com/org/repo/issues/2) | TBD | Some update |`,
 			want: []PreviousRow{
-				{IssueURL: "https://github.com/org/repo/issues/1", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "2024-01-15"},
-				{IssueURL: "https://github.com/org/repo/issues/2", StatusEmoji: ":yellow_circle:", StatusCaption: "At Risk", TargetDate: "TBD"},
+				{IssueURL: "https://github.com/org/repo/issues/1", Title: "Issue One", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "2024-01-15"},
+				{IssueURL: "https://github.com/org/repo/issues/2", Title: "Issue Two", StatusEmoji: ":yellow_circle:", StatusCaption: "At Risk", TargetDate: "TBD"},
+			},
+		},
+		{
+			name: "table without date column",
+			input: `| Status | Initiative/Epic | Update |
+|--------|-----------------|--------|
+| :green_circle: On Track | [Issue One](https://github.com/org/repo/issues/1) | Summary text |`,
+			want: []PreviousRow{
+				{IssueURL: "https://github.com/org/repo/issues/1", Title: "Issue One", StatusEmoji: ":green_circle:", StatusCaption: "On Track"},
 			},
 		},
 		{
@@ -38,7 +53,7 @@ func TestParseReport(t *testing.T) {
 |--------|-----------------|-------------|--------|
 | :green_circle: On Track | [Issue \| With Pipe](https://github.com/org/repo/issues/3) | 2024-02-01 | ok |`,
 			want: []PreviousRow{
-				{IssueURL: "https://github.com/org/repo/issues/3", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "2024-02-01"},
+				{IssueURL: "https://github.com/org/repo/issues/3", Title: "Issue | With Pipe", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "2024-02-01"},
 			},
 		},
 		{
@@ -50,8 +65,8 @@ func TestParseReport(t *testing.T) {
 | | | | |
 | :red_circle: Blocked | [Other Issue](https://github.com/org/repo/issues/5) | TBD | note |`,
 			want: []PreviousRow{
-				{IssueURL: "https://github.com/org/repo/issues/1", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "2024-01-15"},
-				{IssueURL: "https://github.com/org/repo/issues/5", StatusEmoji: ":red_circle:", StatusCaption: "Blocked", TargetDate: "TBD"},
+				{IssueURL: "https://github.com/org/repo/issues/1", Title: "Valid Issue", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "2024-01-15"},
+				{IssueURL: "https://github.com/org/repo/issues/5", Title: "Other Issue", StatusEmoji: ":red_circle:", StatusCaption: "Blocked", TargetDate: "TBD"},
 			},
 		},
 		{
@@ -67,7 +82,7 @@ func TestParseReport(t *testing.T) {
 |--------|-----------------|-------------|--------|
 | :yellow_circle: At Risk | [An Issue](https://github.com/org/repo/issues/7) | TBD | details |`,
 			want: []PreviousRow{
-				{IssueURL: "https://github.com/org/repo/issues/7", StatusEmoji: ":yellow_circle:", StatusCaption: "At Risk", TargetDate: "TBD"},
+				{IssueURL: "https://github.com/org/repo/issues/7", Title: "An Issue", StatusEmoji: ":yellow_circle:", StatusCaption: "At Risk", TargetDate: "TBD"},
 			},
 		},
 	}
@@ -83,6 +98,9 @@ func TestParseReport(t *testing.T) {
 				if row.IssueURL != w.IssueURL {
 					t.Errorf("row %d: IssueURL got %q, want %q", i, row.IssueURL, w.IssueURL)
 				}
+				if row.Title != w.Title {
+					t.Errorf("row %d: Title got %q, want %q", i, row.Title, w.Title)
+				}
 				if row.StatusEmoji != w.StatusEmoji {
 					t.Errorf("row %d: StatusEmoji got %q, want %q", i, row.StatusEmoji, w.StatusEmoji)
 				}
@@ -96,3 +114,125 @@ func TestParseReport(t *testing.T) {
 		})
 	}
 }
+
+func TestParsePreviousReport_JSON(t *testing.T) {
+	input := `[
+  {"url": "https://github.com/org/repo/issues/1", "title": "Issue One", "status": "On Track", "target_date": "2024-01-15"},
+  {"url": "https://github.com/org/repo/issues/2", "status": "at risk", "target_date": "TBD"},
+  {"status": "Off Track"}
+]`
+	got, err := ParsePreviousReport(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []PreviousRow{
+		{IssueURL: "https://github.com/org/repo/issues/1", Title: "Issue One", StatusEmoji: ":green_circle:", StatusCaption: "On Track", TargetDate: "2024-01-15"},
+		{IssueURL: "https://github.com/org/repo/issues/2", StatusEmoji: ":yellow_circle:", StatusCaption: "At Risk", TargetDate: "TBD"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d rows, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d: got %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestParseJSONReport_CaptionRoundTrip(t *testing.T) {
+	statuses := []derive.Status{derive.NeedsUpdate, derive.Shaping, derive.NotStarted, derive.Done}
+
+	var entries []jsonPreviousRow
+	var current []format.Row
+	for i, status := range statuses {
+		url := fmt.Sprintf("https://github.com/org/repo/issues/%d", i+1)
+		entries = append(entries, jsonPreviousRow{URL: url, Status: status.Caption, TargetDate: "TBD"})
+		current = append(current, makeRow(url, status.Emoji, status.Caption))
+	}
+	content, err := json.Marshal(entries)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+
+	previous, err := ParseJSONReport(string(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, row := range previous {
+		if row.StatusCaption != statuses[i].Caption || row.StatusEmoji != statuses[i].Emoji {
+			t.Errorf("row %d: got %s %s, want %s %s", i, row.StatusEmoji, row.StatusCaption, statuses[i].Emoji, statuses[i].Caption)
+		}
+	}
+
+	if _, notes := Compare(previous, current); len(notes) != 0 {
+		t.Errorf("expected no notes for unchanged statuses, got %+v", notes)
+	}
+}
+
+func TestParsePreviousReport_Markdown(t *testing.T) {
+	input := `| Status | Initiative/Epic | Target Date | Update |
+|--------|-----------------|-------------|--------|
+| :green_circle: On Track | [Issue One](https://github.com/org/repo/issues/1) | 2024-01-15 | ok |`
+	got, err := ParsePreviousReport(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].IssueURL != "https://github.com/org/repo/issues/1" {
+		t.Errorf("unexpected rows: %+v", got)
+	}
+}
+
+func TestParsePreviousReport_InvalidJSON(t *testing.T) {
+	if _, err := ParsePreviousReport(`[{"url": `); err == nil {
+		t.Error("expected error for malformed JSON")
+	}
+}
+
+func TestParseReport_DateColumnFromHeader(t *testing.T) {
+	target := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	rows := []format.Row{{
+		StatusEmoji:   ":green_circle:",
+		StatusCaption: "On Track",
+		EpicTitle:     "Issue One",
+		EpicURL:       "https://github.com/org/repo/issues/1",
+		TargetDate:    &target,
+		UpdateMD:      "Shipped",
+		ExtraColumns:  map[string]string{"Team": "Platform", "Area": "API"},
+	}}
+
+	tests := []struct {
+		name       string
+		opts       format.TableOptions
+		dateHeader string
+		want       string
+	}{
+		{name: "extra columns", opts: format.TableOptions{ExtraColumns: []string{"Team", "Area"}}, want: "2024-03-01"},
+		{name: "no date column with an extra column", opts: format.TableOptions{ExtraColumns: []string{"Team"}, NoDateColumn: true}, want: ""},
+		{
+			name:       "custom headers",
+			opts:       format.TableOptions{ExtraColumns: []string{"Team"}, Headers: []string{"State", "Epic", "ETA", "Notes"}},
+			dateHeader: "ETA",
+			want:       "2024-03-01",
+		},
+		{
+			name: "custom headers without the date header",
+			opts: format.TableOptions{Headers: []string{"State", "Epic", "ETA", "Notes"}},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseReportWithDateHeader(format.RenderTableWithOptions(rows, tt.opts), tt.dateHeader)
+			if len(got) != 1 {
+				t.Fatalf("expected 1 row, got %+v", got)
+			}
+			if got[0].IssueURL != "https://github.com/org/repo/issues/1" || got[0].StatusCaption != "On Track" {
+				t.Errorf("unexpected row: %+v", got[0])
+			}
+			if got[0].TargetDate != tt.want {
+				t.Errorf("TargetDate got %q, want %q", got[0].TargetDate, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+)
+
+// compactUpdateLimit is the maximum update length, in characters, on a compact line
+const compactUpdateLimit = 60
+
+// unicodeStatusEmoji maps GitHub emoji shortcodes used in Row.StatusEmoji to
+// the characters a terminal can display
+var unicodeStatusEmoji = map[string]string{
+	":green_circle:":                    "🟢",
+	":yellow_circle:":                   "🟡",
+	":red_circle:":                      "🔴",
+	":white_circle:":                    "⚪",
+	":purple_circle:":                   "🟣",
+	":black_circle:":                    "⚫",
+	":diamond_shape_with_a_dot_inside:": "💠",
+}
+
+// RenderCompact renders one line per row in the given order:
+// "<emoji> <date or TBD> <title> — <update>", with the update truncated
+func RenderCompact(rows []Row) string {
+	var builder strings.Builder
+
+	for _, row := range rows {
+		emoji, ok := unicodeStatusEmoji[row.StatusEmoji]
+		if !ok {
+			emoji = row.StatusEmoji
+		}
+
+		line := fmt.Sprintf("%s %s %s", emoji, derive.RenderTargetDate(row.TargetDate), collapseNewlines(row.EpicTitle))
+		if update := truncateText(collapseNewlines(row.UpdateMD), compactUpdateLimit); update != "" {
+			line += " — " + update
+		}
+		builder.WriteString(line + "\n")
+	}
+
+	return builder.String()
+}
+
+// truncateText shortens s to at most limit characters, ending in "…" when cut
+func truncateText(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return strings.TrimSpace(string(runes[:limit-1])) + "…"
+}
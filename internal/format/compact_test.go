@@ -0,0 +1,69 @@
+package format
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+)
+
+func TestRenderCompact(t *testing.T) {
+	date := time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC)
+	rows := []Row{
+		NewRow(derive.OnTrack, "User Auth", "https://github.com/org/repo/issues/1", &date, "Completed OAuth2"),
+		NewRow(derive.AtRisk, "Search", "https://github.com/org/repo/issues/2", nil, "Waiting on\nindex rebuild"),
+		NewRow(derive.Done, "Billing", "https://github.com/org/repo/issues/3", nil, ""),
+	}
+
+	expected := "🟢 2025-08-06 User Auth — Completed OAuth2\n" +
+		"🟡 TBD Search — Waiting on index rebuild\n" +
+		"🟣 TBD Billing\n"
+
+	if result := RenderCompact(rows); result != expected {
+		t.Errorf("Compact output mismatch\nExpected:\n%s\nGot:\n%s", expected, result)
+	}
+}
+
+func TestRenderCompact_TruncatesUpdate(t *testing.T) {
+	update := strings.Repeat("a", 55) + " ééééé ééééé"
+	rows := []Row{NewRow(derive.OffTrack, "Epic", "url", nil, update)}
+
+	result := strings.TrimSuffix(RenderCompact(rows), "\n")
+	_, shown, ok := strings.Cut(result, " — ")
+	if !ok {
+		t.Fatalf("expected update separator in %q", result)
+	}
+	if n := len([]rune(shown)); n != compactUpdateLimit {
+		t.Errorf("expected truncated update of %d characters, got %d: %q", compactUpdateLimit, n, shown)
+	}
+	if !strings.HasSuffix(shown, "…") {
+		t.Errorf("expected truncated update to end with an ellipsis, got %q", shown)
+	}
+	if !strings.HasPrefix(result, "🔴 TBD Epic") {
+		t.Errorf("unexpected line prefix: %q", result)
+	}
+}
+
+func TestTruncateText(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		limit    int
+		expected string
+	}{
+		{"short text unchanged", "hello", 10, "hello"},
+		{"exact length unchanged", "hello", 5, "hello"},
+		{"cut with ellipsis", "hello world", 8, "hello w…"},
+		{"trailing space trimmed before ellipsis", "hello world", 7, "hello…"},
+		{"multi-byte runes", "ééééé", 3, "éé…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := truncateText(tt.input, tt.limit); result != tt.expected {
+				t.Errorf("truncateText(%q, %d) = %q, expected %q", tt.input, tt.limit, result, tt.expected)
+			}
+		})
+	}
+}
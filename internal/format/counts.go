@@ -0,0 +1,80 @@
+package format
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+)
+
+// statusCountOrder is the display order for known status captions
+var statusCountOrder = []string{
+	derive.OnTrack.Caption,
+	derive.AtRisk.Caption,
+	derive.OffTrack.Caption,
+	derive.NotStarted.Caption,
+	derive.NeedsUpdate.Caption,
+	derive.Shaping.Caption,
+	derive.Done.Caption,
+	derive.Unknown.Caption,
+}
+
+// sortStatusCaptions orders captions by statusCountOrder, with any unknown
+// captions following alphabetically
+func sortStatusCaptions(captions []string) {
+	rank := make(map[string]int, len(statusCountOrder))
+	for i, caption := range statusCountOrder {
+		rank[caption] = i
+	}
+	sort.SliceStable(captions, func(i, j int) bool {
+		ri, okI := rank[captions[i]]
+		rj, okJ := rank[captions[j]]
+		switch {
+		case okI && okJ:
+			return ri < rj
+		case okI != okJ:
+			return okI
+		default:
+			return captions[i] < captions[j]
+		}
+	})
+}
+
+// RenderStatusCounts generates a compact tally of rows per status caption,
+// followed by the total and the number of rows without a target date.
+// Known statuses appear in a fixed order; any others follow alphabetically.
+func RenderStatusCounts(rows []Row) string {
+	counts := make(map[string]int)
+	tbd := 0
+	for _, row := range rows {
+		counts[row.StatusCaption]++
+		if row.TargetDate == nil {
+			tbd++
+		}
+	}
+
+	var others []string
+	known := make(map[string]bool, len(statusCountOrder))
+	for _, caption := range statusCountOrder {
+		known[caption] = true
+	}
+	for caption := range counts {
+		if !known[caption] {
+			others = append(others, caption)
+		}
+	}
+	sort.Strings(others)
+
+	var builder strings.Builder
+	for _, caption := range append(statusCountOrder, others...) {
+		if counts[caption] == 0 {
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("%s: %d\n", caption, counts[caption]))
+	}
+	builder.WriteString(fmt.Sprintf("Total: %d\n", len(rows)))
+	builder.WriteString(fmt.Sprintf("TBD: %d\n", tbd))
+
+	return builder.String()
+}
@@ -0,0 +1,39 @@
+package format
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRenderStatusCounts(t *testing.T) {
+	date := time.Date(2025, 8, 15, 0, 0, 0, 0, time.UTC)
+	rows := []Row{
+		{StatusCaption: "Done", TargetDate: &date},
+		{StatusCaption: "On Track", TargetDate: &date},
+		{StatusCaption: "At Risk"},
+		{StatusCaption: "On Track"},
+		{StatusCaption: "Custom"},
+		{StatusCaption: "Off Track", TargetDate: &date},
+	}
+
+	got := RenderStatusCounts(rows)
+	want := "On Track: 2\n" +
+		"At Risk: 1\n" +
+		"Off Track: 1\n" +
+		"Done: 1\n" +
+		"Custom: 1\n" +
+		"Total: 6\n" +
+		"TBD: 3\n"
+
+	if got != want {
+		t.Errorf("RenderStatusCounts() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderStatusCounts_Empty(t *testing.T) {
+	got := RenderStatusCounts(nil)
+	want := "Total: 0\nTBD: 0\n"
+	if got != want {
+		t.Errorf("RenderStatusCounts(nil) = %q, want %q", got, want)
+	}
+}
@@ -2,6 +2,7 @@ package format
 
 import (
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 )
@@ -15,6 +16,40 @@ type DescribeRow struct {
 	Assignees []string // Issue assignees (usernames)
 }
 
+// describeTableHeader is the header and separator of the describe table
+const describeTableHeader = "| Initiative | Labels | Assignee | Summary |\n" +
+	"|------------|--------|----------|--------|\n"
+
+// Section headings of the combined status and goals document
+const (
+	StatusSectionHeading = "## Status"
+	GoalsSectionHeading  = "## Goals"
+)
+
+// RenderGoalsSection renders rows as the goals section that follows the status
+// report in a combined document
+func RenderGoalsSection(rows []DescribeRow) string {
+	table := RenderDescribeTable(rows)
+	if table == "" {
+		table = RenderEmptyDescribeTable()
+	}
+	return GoalsSectionHeading + "\n\n" + table
+}
+
+// RenderStatusAndGoals assembles the combined document: status (the rendered
+// status report, including any legend and notes) under the status heading,
+// followed by the goals section with rows sorted by title. goals is not modified.
+func RenderStatusAndGoals(status string, goals []DescribeRow) string {
+	sorted := append([]DescribeRow(nil), goals...)
+	SortDescribeRowsByTitle(sorted)
+	return StatusSectionHeading + "\n\n" + status + "\n" + RenderGoalsSection(sorted)
+}
+
+// RenderEmptyDescribeTable renders the describe table header with no data rows
+func RenderEmptyDescribeTable() string {
+	return describeTableHeader
+}
+
 // RenderDescribeTable generates a markdown table for describe output
 // Columns: Initiative | Labels | Assignee | Summary
 func RenderDescribeTable(rows []DescribeRow) string {
@@ -25,8 +60,7 @@ func RenderDescribeTable(rows []DescribeRow) string {
 	var builder strings.Builder
 
 	// Write table header
-	builder.WriteString("| Initiative | Labels | Assignee | Summary |\n")
-	builder.WriteString("|------------|--------|----------|--------|\n")
+	builder.WriteString(describeTableHeader)
 
 	// Write each row
 	for _, row := range rows {
@@ -65,9 +99,20 @@ func RenderDescribeTable(rows []DescribeRow) string {
 	return builder.String()
 }
 
+// DetailedOptions holds optional settings for RenderDescribeDetailedWithOptions.
+// The zero value renders summaries as written.
+type DetailedOptions struct {
+	Checklist bool // Render bullet and numbered list items in summaries as "- [ ]" task items
+}
+
 // RenderDescribeDetailed generates detailed markdown sections for each issue
 // Each issue gets its own section with title, metadata, and full summary
 func RenderDescribeDetailed(rows []DescribeRow) string {
+	return RenderDescribeDetailedWithOptions(rows, DetailedOptions{})
+}
+
+// RenderDescribeDetailedWithOptions is RenderDescribeDetailed with options
+func RenderDescribeDetailedWithOptions(rows []DescribeRow, opts DetailedOptions) string {
 	if len(rows) == 0 {
 		return ""
 	}
@@ -94,7 +139,9 @@ func RenderDescribeDetailed(rows []DescribeRow) string {
 
 		// Summary section
 		builder.WriteString("\n### Summary\n\n")
-		if row.Summary != "" {
+		if row.Summary != "" && opts.Checklist {
+			builder.WriteString(toTaskList(row.Summary))
+		} else if row.Summary != "" {
 			builder.WriteString(row.Summary)
 		} else {
 			builder.WriteString("_No description available._")
@@ -110,9 +157,112 @@ func RenderDescribeDetailed(rows []DescribeRow) string {
 	return builder.String()
 }
 
+// listItemRe matches a bullet ("- ", "* ", "+ ") or numbered ("1. ", "1) ")
+// list item, capturing its indentation and text
+var listItemRe = regexp.MustCompile(`^(\s*)(?:[-*+]|\d+[.)])\s+(.*)$`)
+
+// taskItemRe matches a list item that is already a task item
+var taskItemRe = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+\[[ xX]\]`)
+
+// toTaskList rewrites the list items in text as unchecked "- [ ]" task items,
+// keeping their indentation. Paragraphs, existing task items, and lines inside
+// fenced code blocks are left as they are.
+func toTaskList(text string) string {
+	lines := strings.Split(text, "\n")
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || taskItemRe.MatchString(line) {
+			continue
+		}
+		if match := listItemRe.FindStringSubmatch(line); match != nil {
+			lines[i] = match[1] + "- [ ] " + match[2]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// describeJSONRow is the JSON representation of a DescribeRow
+type describeJSONRow struct {
+	Title     string   `json:"title"`
+	URL       string   `json:"url"`
+	Summary   string   `json:"summary"`
+	Labels    []string `json:"labels"`
+	Assignees []string `json:"assignees"`
+}
+
+// RenderDescribeJSON serializes describe rows as a compact JSON array.
+func RenderDescribeJSON(rows []DescribeRow) (string, error) {
+	return RenderDescribeJSONWithOptions(rows, JSONOptions{})
+}
+
+// RenderDescribeJSONWithOptions serializes describe rows as a JSON array.
+// Labels and assignees are always emitted as arrays (never null) so
+// downstream consumers don't need to special-case missing values.
+func RenderDescribeJSONWithOptions(rows []DescribeRow, opts JSONOptions) (string, error) {
+	out := make([]describeJSONRow, 0, len(rows))
+	for _, row := range rows {
+		labels := row.Labels
+		if labels == nil {
+			labels = []string{}
+		}
+		assignees := row.Assignees
+		if assignees == nil {
+			assignees = []string{}
+		}
+		out = append(out, describeJSONRow{
+			Title:     row.Title,
+			URL:       row.URL,
+			Summary:   row.Summary,
+			Labels:    labels,
+			Assignees: assignees,
+		})
+	}
+
+	jsonBytes, err := marshalJSON(out, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal describe rows: %w", err)
+	}
+
+	return string(jsonBytes) + "\n", nil
+}
+
 // SortDescribeRowsByTitle sorts describe rows alphabetically by title
 func SortDescribeRowsByTitle(rows []DescribeRow) {
 	sort.Slice(rows, func(i, j int) bool {
 		return strings.ToLower(rows[i].Title) < strings.ToLower(rows[j].Title)
 	})
 }
+
+// SortDescribeRowsByLabel sorts describe rows alphabetically by their first
+// label, with unlabeled rows last. Rows with the same first label keep their order.
+func SortDescribeRowsByLabel(rows []DescribeRow) {
+	sortDescribeRowsByFirst(rows, func(row DescribeRow) []string { return row.Labels })
+}
+
+// SortDescribeRowsByAssignee sorts describe rows alphabetically by their first
+// assignee, with unassigned rows last. Rows with the same first assignee keep their order.
+func SortDescribeRowsByAssignee(rows []DescribeRow) {
+	sortDescribeRowsByFirst(rows, func(row DescribeRow) []string { return row.Assignees })
+}
+
+// sortDescribeRowsByFirst stably sorts rows by the first of values(row),
+// case-insensitively, placing rows without values last
+func sortDescribeRowsByFirst(rows []DescribeRow, values func(DescribeRow) []string) {
+	first := func(row DescribeRow) string {
+		if v := values(row); len(v) > 0 {
+			return strings.ToLower(v[0])
+		}
+		return ""
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := first(rows[i]), first(rows[j])
+		if a == "" || b == "" {
+			return a != "" && b == ""
+		}
+		return a < b
+	})
+}
@@ -0,0 +1,239 @@
+package format
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRenderDescribeJSON(t *testing.T) {
+	rows := []DescribeRow{
+		{
+			Title:     "User Auth",
+			URL:       "https://github.com/org/repo/issues/1",
+			Summary:   "Implements OAuth2 login.",
+			Labels:    []string{"epic", "security"},
+			Assignees: []string{"alice"},
+		},
+		{
+			Title:   "Payments | Refactor",
+			URL:     "https://github.com/org/repo/issues/2",
+			Summary: "Refactors the payment module.",
+		},
+	}
+
+	output, err := RenderDescribeJSON(rows)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, output)
+	}
+	if len(decoded) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(decoded))
+	}
+
+	first := decoded[0]
+	if first["title"] != "User Auth" || first["url"] != "https://github.com/org/repo/issues/1" {
+		t.Errorf("unexpected first row: %v", first)
+	}
+	if labels, ok := first["labels"].([]interface{}); !ok || len(labels) != 2 {
+		t.Errorf("expected 2 labels, got %v", first["labels"])
+	}
+
+	second := decoded[1]
+	if second["title"] != "Payments | Refactor" {
+		t.Errorf("title should not be table-escaped in JSON, got %v", second["title"])
+	}
+	for _, key := range []string{"labels", "assignees"} {
+		arr, ok := second[key].([]interface{})
+		if !ok {
+			t.Errorf("expected %s to be an array, got %v", key, second[key])
+			continue
+		}
+		if len(arr) != 0 {
+			t.Errorf("expected empty %s, got %v", key, arr)
+		}
+	}
+}
+
+func TestRenderDescribeJSON_Empty(t *testing.T) {
+	output, err := RenderDescribeJSON(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "[]\n" {
+		t.Errorf("expected empty JSON array, got %q", output)
+	}
+}
+
+func TestRenderEmptyDescribeTable(t *testing.T) {
+	row := DescribeRow{Title: "Epic", URL: "https://github.com/org/repo/issues/1", Summary: "Goal"}
+	populated := RenderDescribeTable([]DescribeRow{row})
+
+	empty := RenderEmptyDescribeTable()
+	if !strings.HasPrefix(populated, empty) {
+		t.Errorf("expected empty table to be the header of a populated table\nEmpty:\n%s\nPopulated:\n%s", empty, populated)
+	}
+	if got := strings.Count(empty, "\n"); got != 2 {
+		t.Errorf("expected header and separator lines only, got %d lines", got)
+	}
+}
+
+func TestRenderGoalsSection(t *testing.T) {
+	row := DescribeRow{Title: "Epic", URL: "https://github.com/org/repo/issues/1", Summary: "Goal"}
+
+	got := RenderGoalsSection([]DescribeRow{row})
+	want := GoalsSectionHeading + "\n\n" + RenderDescribeTable([]DescribeRow{row})
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	if empty := RenderGoalsSection(nil); empty != GoalsSectionHeading+"\n\n"+RenderEmptyDescribeTable() {
+		t.Errorf("expected heading and empty table for no rows, got %q", empty)
+	}
+}
+
+func TestRenderStatusAndGoals(t *testing.T) {
+	goals := []DescribeRow{
+		{Title: "Zeta", URL: "https://github.com/org/repo/issues/2", Summary: "Second goal"},
+		{Title: "Alpha", URL: "https://github.com/org/repo/issues/1", Summary: "First goal"},
+	}
+
+	got := RenderStatusAndGoals("| status table |\n", goals)
+
+	want := StatusSectionHeading + "\n\n| status table |\n\n" +
+		RenderGoalsSection([]DescribeRow{goals[1], goals[0]})
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if goals[0].Title != "Zeta" {
+		t.Error("expected the caller's goal rows to keep their order")
+	}
+}
+
+func TestSortDescribeRows(t *testing.T) {
+	newRows := func() []DescribeRow {
+		return []DescribeRow{
+			{Title: "delta", Labels: []string{"team-b"}, Assignees: []string{"bob"}},
+			{Title: "Alpha"},
+			{Title: "charlie", Labels: []string{"Team-A", "urgent"}, Assignees: []string{"alice", "zed"}},
+			{Title: "bravo", Labels: []string{"team-a"}},
+			{Title: "echo", Assignees: []string{"Alice"}},
+		}
+	}
+
+	tests := []struct {
+		name string
+		sort func([]DescribeRow)
+		want string
+	}{
+		{name: "title", sort: SortDescribeRowsByTitle, want: "Alpha bravo charlie delta echo"},
+		// Same first label keeps input order; unlabeled rows go last in input order
+		{name: "label", sort: SortDescribeRowsByLabel, want: "charlie bravo delta Alpha echo"},
+		{name: "assignee", sort: SortDescribeRowsByAssignee, want: "charlie echo delta Alpha bravo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := newRows()
+			tt.sort(rows)
+			var titles []string
+			for _, row := range rows {
+				titles = append(titles, row.Title)
+			}
+			if got := strings.Join(titles, " "); got != tt.want {
+				t.Errorf("got order %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderDescribeJSONWithOptions_Stable(t *testing.T) {
+	rows := []DescribeRow{
+		{Title: "User Auth", URL: "https://github.com/org/repo/issues/1", Summary: "Implements OAuth2 login.", Labels: []string{"epic"}, Assignees: []string{"alice", "bob"}},
+		{Title: "Payments", URL: "https://github.com/org/repo/issues/2", Summary: "Refactors payments."},
+	}
+
+	for _, pretty := range []bool{false, true} {
+		opts := JSONOptions{Pretty: pretty}
+		first, err := RenderDescribeJSONWithOptions(rows, opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		second, err := RenderDescribeJSONWithOptions(rows, opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if first != second {
+			t.Errorf("pretty=%v: output differs between runs:\n%s\n%s", pretty, first, second)
+		}
+	}
+
+	pretty, err := RenderDescribeJSONWithOptions(rows[1:], JSONOptions{Pretty: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `[
+  {
+    "title": "Payments",
+    "url": "https://github.com/org/repo/issues/2",
+    "summary": "Refactors payments.",
+    "labels": [],
+    "assignees": []
+  }
+]
+`
+	if pretty != want {
+		t.Errorf("unexpected pretty output:\n%s", pretty)
+	}
+}
+
+func TestMarshalJSON_SortsMapKeys(t *testing.T) {
+	counts := map[string]int{"stale": 2, "at_risk": 1, "done": 5}
+	for i := 0; i < 10; i++ {
+		got, err := marshalJSON(counts, JSONOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(got) != `{"at_risk":1,"done":5,"stale":2}` {
+			t.Fatalf("got %s, want keys in sorted order", got)
+		}
+	}
+}
+
+func TestRenderDescribeDetailedWithOptions_Checklist(t *testing.T) {
+	summary := "Ship the new onboarding flow.\n\n" +
+		"- Launch the signup page\n" +
+		"* Migrate existing users\n" +
+		"  + Backfill profiles\n" +
+		"1. Announce the change\n" +
+		"- [x] Write the design doc\n\n" +
+		"```\n- not a task\n```\n" +
+		"Owners - platform team."
+	rows := []DescribeRow{{Title: "Onboarding", URL: "https://github.com/o/r/issues/1", Summary: summary}}
+
+	got := RenderDescribeDetailedWithOptions(rows, DetailedOptions{Checklist: true})
+	want := "Ship the new onboarding flow.\n\n" +
+		"- [ ] Launch the signup page\n" +
+		"- [ ] Migrate existing users\n" +
+		"  - [ ] Backfill profiles\n" +
+		"- [ ] Announce the change\n" +
+		"- [x] Write the design doc\n\n" +
+		"```\n- not a task\n```\n" +
+		"Owners - platform team."
+	if !strings.Contains(got, want) {
+		t.Errorf("got:\n%s\nwant the summary rendered as:\n%s", got, want)
+	}
+
+	if plain := RenderDescribeDetailed(rows); !strings.Contains(plain, summary) {
+		t.Errorf("expected the summary to be unchanged without Checklist, got:\n%s", plain)
+	}
+
+	rows[0].Summary = "A single paragraph with no list."
+	if got := RenderDescribeDetailedWithOptions(rows, DetailedOptions{Checklist: true}); !strings.Contains(got, "\n\nA single paragraph with no list.\n") {
+		t.Errorf("expected a paragraph summary to be untouched, got:\n%s", got)
+	}
+}
@@ -14,6 +14,7 @@ const (
 	GroupByAssignee GroupMode = iota
 	GroupByLabel
 	GroupByField
+	GroupByStatus
 )
 
 // GroupConfig holds the grouping mode and optional pattern (glob or field name).
@@ -35,6 +36,7 @@ type RowGroup struct {
 //	"assignee"       → GroupByAssignee
 //	"label:<glob>"   → GroupByLabel with pattern
 //	"field:<name>"   → GroupByField with pattern
+//	"status"         → GroupByStatus
 func ParseGroupBy(raw string) (GroupConfig, error) {
 	if raw == "" {
 		return GroupConfig{}, fmt.Errorf("grouping spec must not be empty")
@@ -44,6 +46,9 @@ func ParseGroupBy(raw string) (GroupConfig, error) {
 	case raw == "assignee":
 		return GroupConfig{Mode: GroupByAssignee}, nil
 
+	case raw == "status":
+		return GroupConfig{Mode: GroupByStatus}, nil
+
 	case strings.HasPrefix(raw, "label:"):
 		pattern := strings.TrimPrefix(raw, "label:")
 		if pattern == "" {
@@ -63,13 +68,15 @@ func ParseGroupBy(raw string) (GroupConfig, error) {
 		return GroupConfig{Mode: GroupByField, Pattern: name}, nil
 
 	default:
-		return GroupConfig{}, fmt.Errorf("unknown grouping spec %q; expected assignee, label:<glob>, or field:<name>", raw)
+		return GroupConfig{}, fmt.Errorf("unknown grouping spec %q; expected assignee, status, label:<glob>, or field:<name>", raw)
 	}
 }
 
-// GroupRows partitions rows into RowGroups according to config.
+// GroupRows partitions rows into RowGroups according to config. Rows with
+// several assignees appear in each assignee's group when grouping by assignee.
 // Each group's rows are sorted by target date. Groups are sorted alphabetically,
-// with the fallback group ("Unassigned" / "Other") placed last.
+// with the fallback group ("Unassigned" / "Other") placed last; status groups
+// follow the same fixed order as RenderStatusCounts instead.
 func GroupRows(rows []Row, config GroupConfig) []RowGroup {
 	if len(rows) == 0 {
 		return nil
@@ -78,8 +85,9 @@ func GroupRows(rows []Row, config GroupConfig) []RowGroup {
 	grouped := make(map[string][]Row)
 
 	for _, row := range rows {
-		key := groupKey(row, config)
-		grouped[key] = append(grouped[key], row)
+		for _, key := range groupKeys(row, config) {
+			grouped[key] = append(grouped[key], row)
+		}
 	}
 
 	fallback := fallbackTitle(config)
@@ -89,7 +97,11 @@ func GroupRows(rows []Row, config GroupConfig) []RowGroup {
 			keys = append(keys, k)
 		}
 	}
-	sort.Strings(keys)
+	if config.Mode == GroupByStatus {
+		sortStatusCaptions(keys)
+	} else {
+		sort.Strings(keys)
+	}
 	if _, hasFallback := grouped[fallback]; hasFallback {
 		keys = append(keys, fallback)
 	}
@@ -108,15 +120,30 @@ const (
 	fallbackOther    = "Other"
 )
 
-// groupKey returns the group key for a single row.
-func groupKey(row Row, config GroupConfig) string {
-	switch config.Mode {
-	case GroupByAssignee:
-		if len(row.Assignees) > 0 {
-			return row.Assignees[0]
+// groupKeys returns the groups a single row belongs to: one per distinct
+// assignee when grouping by assignee, otherwise exactly one.
+func groupKeys(row Row, config GroupConfig) []string {
+	if config.Mode != GroupByAssignee {
+		return []string{groupKey(row, config)}
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, assignee := range row.Assignees {
+		if assignee != "" && !seen[assignee] {
+			seen[assignee] = true
+			keys = append(keys, assignee)
 		}
-		return fallbackAssignee
+	}
+	if len(keys) == 0 {
+		return []string{fallbackAssignee}
+	}
+	return keys
+}
 
+// groupKey returns the group key for a single row in the single-key modes.
+func groupKey(row Row, config GroupConfig) string {
+	switch config.Mode {
 	case GroupByLabel:
 		for _, label := range row.Labels {
 			matched, err := filepath.Match(config.Pattern, label)
@@ -126,6 +153,12 @@ func groupKey(row Row, config GroupConfig) string {
 		}
 		return fallbackOther
 
+	case GroupByStatus:
+		if row.StatusCaption != "" {
+			return row.StatusCaption
+		}
+		return fallbackOther
+
 	case GroupByField:
 		if row.ExtraColumns != nil {
 			if val, ok := row.ExtraColumns[config.Pattern]; ok && val != "" {
@@ -1,6 +1,8 @@
 package format
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -14,6 +16,7 @@ func TestParseGroupBy_ValidFormats(t *testing.T) {
 		wantPattern string
 	}{
 		{"assignee", GroupByAssignee, ""},
+		{"status", GroupByStatus, ""},
 		{"label:team-*", GroupByLabel, "team-*"},
 		{"label:bug", GroupByLabel, "bug"},
 		{"field:Priority", GroupByField, "Priority"},
@@ -103,6 +106,56 @@ func TestGroupRows_ByAssignee(t *testing.T) {
 	}
 }
 
+func TestGroupRows_ByAssignee_MultipleAssignees(t *testing.T) {
+	shared := makeRow([]string{"bob", "alice", "bob"}, nil, nil, 1)
+	shared.EpicTitle = "shared"
+	rows := []Row{
+		shared,
+		makeRow([]string{"alice"}, nil, nil, 5),
+		makeRow(nil, nil, nil, 2),
+		makeRow([]string{}, nil, nil, 3),
+	}
+	groups := GroupRows(rows, GroupConfig{Mode: GroupByAssignee})
+
+	var titles []string
+	for _, g := range groups {
+		titles = append(titles, fmt.Sprintf("%s:%d", g.Title, len(g.Rows)))
+	}
+	// The shared issue is listed once under each distinct assignee; both
+	// rows without assignees land in Unassigned
+	want := "alice:2 bob:1 Unassigned:2"
+	if got := strings.Join(titles, " "); got != want {
+		t.Fatalf("got groups %q, want %q", got, want)
+	}
+	if groups[0].Rows[0].EpicTitle != "shared" || groups[1].Rows[0].EpicTitle != "shared" {
+		t.Errorf("expected the shared issue first under alice and under bob, got %+v", groups[:2])
+	}
+}
+
+// --- GroupRows by status ---
+
+func TestGroupRows_ByStatus(t *testing.T) {
+	rows := []Row{
+		{StatusCaption: "Done"},
+		{StatusCaption: "On Track"},
+		{StatusCaption: "At Risk"},
+		{StatusCaption: "On Track"},
+	}
+	groups := GroupRows(rows, GroupConfig{Mode: GroupByStatus})
+
+	var titles []string
+	for _, g := range groups {
+		titles = append(titles, g.Title)
+	}
+	// Fixed status order rather than alphabetical
+	if got := strings.Join(titles, ","); got != "On Track,At Risk,Done" {
+		t.Errorf("expected status order On Track,At Risk,Done, got %s", got)
+	}
+	if len(groups[0].Rows) != 2 {
+		t.Errorf("On Track: expected 2 rows, got %d", len(groups[0].Rows))
+	}
+}
+
 // --- GroupRows by label ---
 
 func TestGroupRows_ByLabel(t *testing.T) {
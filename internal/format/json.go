@@ -0,0 +1,19 @@
+package format
+
+import "encoding/json"
+
+// JSONOptions configures the JSON renderers
+type JSONOptions struct {
+	Pretty bool // Indent with two spaces instead of emitting a single line
+}
+
+// marshalJSON encodes v for the JSON renderers. Output is byte-stable for
+// identical input: struct fields keep their declaration order and map keys
+// are sorted, so renderers should use structs or maps, never hand-built
+// field lists, to keep week-over-week diffs clean.
+func marshalJSON(v any, opts JSONOptions) ([]byte, error) {
+	if opts.Pretty {
+		return json.MarshalIndent(v, "", "  ")
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,50 @@
+package format
+
+import (
+	"strings"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+)
+
+// RenderLegend generates a one-line key explaining every status emoji, e.g.
+// "**Legend:** :green_circle: On Track · :yellow_circle: At Risk · …"
+func RenderLegend() string {
+	return renderLegend(derive.Statuses)
+}
+
+// RenderRowLegend is RenderLegend limited to the statuses that appear in rows.
+// Rows whose caption isn't a predefined status (e.g. set from a project
+// field) are left out. Returns "" when no row has a predefined status.
+func RenderRowLegend(rows []Row) string {
+	present := make(map[derive.Status]bool)
+	for _, row := range rows {
+		status := row.Status
+		if status == (derive.Status{}) {
+			var ok bool
+			if status, ok = derive.ParseCaption(row.StatusCaption); !ok {
+				continue
+			}
+		}
+		present[status] = true
+	}
+
+	var statuses []derive.Status
+	for _, status := range derive.Statuses {
+		if present[status] {
+			statuses = append(statuses, status)
+		}
+	}
+	return renderLegend(statuses)
+}
+
+// renderLegend lists statuses in the given order
+func renderLegend(statuses []derive.Status) string {
+	if len(statuses) == 0 {
+		return ""
+	}
+	entries := make([]string, len(statuses))
+	for i, status := range statuses {
+		entries[i] = status.Emoji + " " + status.Caption
+	}
+	return "**Legend:** " + strings.Join(entries, " · ") + "\n"
+}
@@ -0,0 +1,38 @@
+package format
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+)
+
+func TestRenderLegend(t *testing.T) {
+	legend := RenderLegend()
+	if !strings.HasPrefix(legend, "**Legend:** :green_circle: On Track · :yellow_circle: At Risk") {
+		t.Errorf("got %q, want statuses in their predefined order", legend)
+	}
+	for _, status := range derive.Statuses {
+		if !strings.Contains(legend, status.Emoji+" "+status.Caption) {
+			t.Errorf("legend %q is missing %s", legend, status.Caption)
+		}
+	}
+}
+
+func TestRenderRowLegend(t *testing.T) {
+	rows := []Row{
+		{Status: derive.Done, StatusEmoji: derive.Done.Emoji, StatusCaption: derive.Done.Caption},
+		{StatusEmoji: derive.OnTrack.Emoji, StatusCaption: derive.OnTrack.Caption}, // untyped row
+		{Status: derive.Done, StatusEmoji: derive.Done.Emoji, StatusCaption: derive.Done.Caption},
+		{StatusEmoji: ":fire:", StatusCaption: "Escalated"},
+	}
+
+	want := "**Legend:** :green_circle: On Track · :purple_circle: Done\n"
+	if got := RenderRowLegend(rows); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if got := RenderRowLegend([]Row{{StatusEmoji: ":fire:", StatusCaption: "Escalated"}}); got != "" {
+		t.Errorf("got %q, want no legend without predefined statuses", got)
+	}
+}
@@ -11,14 +11,20 @@ import (
 
 // Row represents a single row in the markdown table
 type Row struct {
+	Status           derive.Status     // Typed status; StatusEmoji and StatusCaption are what renders
 	StatusEmoji      string            // Status emoji (e.g., ":green_circle:")
 	StatusCaption    string            // Status caption (e.g., "On Track")
 	StatusTransition *string           // e.g., ":yellow_circle:→:green_circle:" — rendered instead of emoji when set
 	NewItem          bool              // true if this item wasn't in the previous report
 	EpicTitle        string            // Epic/issue title
 	EpicURL          string            // Epic/issue URL
+	Number           int               // Issue number (0 = unknown), shown with TableOptions.ShowIssueNumber
 	TargetDate       *time.Time        // Target date (nil renders as "TBD")
 	UpdateMD         string            // Update summary/content (markdown-ready)
+	UpdateSourceURL  string            // Permalink of the comment the update came from, linked with TableOptions.LinkUpdates
+	UpdateItems      []string          // Separate raw updates, newest first, listed with TableOptions.ListUpdates (set only without an AI summary)
+	UpdateCount      int               // Reports in the window, shown with TableOptions.ShowUpdateCount
+	RawFallback      bool              // The update is raw text because AI summarization produced nothing, marked with TableOptions.MarkFallbacks
 	Assignees        []string          // For grouping by assignee
 	Labels           []string          // For grouping by label
 	ExtraColumns     map[string]string // For custom columns and field grouping
@@ -27,6 +33,7 @@ type Row struct {
 // NewRow creates a Row from components, handling status derivation and date parsing
 func NewRow(status derive.Status, epicTitle, epicURL string, targetDate *time.Time, updateMD string) Row {
 	return Row{
+		Status:        status,
 		StatusEmoji:   status.Emoji,
 		StatusCaption: status.Caption,
 		EpicTitle:     epicTitle,
@@ -36,67 +43,192 @@ func NewRow(status derive.Status, epicTitle, epicURL string, targetDate *time.Ti
 	}
 }
 
+// RawFallbackMarker is appended to the update cell of RawFallback rows when
+// TableOptions.MarkFallbacks is set
+const RawFallbackMarker = "_(raw)_"
+
+// DefaultTableHeaders are the column headers used when no override is given,
+// in order: status, initiative/epic, target date, update.
+var DefaultTableHeaders = []string{"Status", "Initiative/Epic", "Target Date", "Update"}
+
+// TableOptions controls how RenderTableWithOptions lays out the table.
+// The zero value renders the default 4-column table.
+type TableOptions struct {
+	ExtraColumns    []string // Column names inserted between the epic and target date columns
+	Headers         []string // Replacement for DefaultTableHeaders (nil = defaults)
+	NoDateColumn    bool     // Omit the target date column (rows keep their dates for sorting)
+	ShowIssueNumber bool     // Prefix linked titles with "#<number>" when the row has one
+	LinkUpdates     bool     // Append "([source](url))" to updates that have a source comment
+	ListUpdates     bool     // Render rows with several UpdateItems as a "• " list joined by <br>
+	ShowUpdateCount bool     // Append "(N updates)" to the update cell of rows with an UpdateCount
+	MarkFallbacks   bool     // Append RawFallbackMarker to the update cell of rows with RawFallback
+	NoEscape        bool     // Keep pipes and backslashes in cells as written; newlines are still collapsed
+}
+
+// ParseTableHeaders parses a comma-separated list of exactly four column headers
+// (status, initiative/epic, target date, update).
+func ParseTableHeaders(raw string) ([]string, error) {
+	parts := strings.Split(raw, ",")
+	if len(parts) != len(DefaultTableHeaders) {
+		return nil, fmt.Errorf("expected %d comma-separated headers, got %d", len(DefaultTableHeaders), len(parts))
+	}
+
+	headers := make([]string, len(parts))
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("header %d must not be empty", i+1)
+		}
+		if strings.Contains(part, "|") {
+			return nil, fmt.Errorf("header %q must not contain '|'", part)
+		}
+		headers[i] = part
+	}
+	return headers, nil
+}
+
 // RenderTable generates a markdown table from a slice of rows.
 // extraColumns are optional column names inserted between "Initiative/Epic" and "Target Date".
 // When nil or empty the output is identical to the original 4-column format.
 // Extra column values are read from row.ExtraColumns[columnName]; missing map or key → empty cell.
 func RenderTable(rows []Row, extraColumns []string) string {
+	return RenderTableWithOptions(rows, TableOptions{ExtraColumns: extraColumns})
+}
+
+// RenderTableWithOptions generates a markdown table from a slice of rows using
+// the headers and extra columns in opts.
+func RenderTableWithOptions(rows []Row, opts TableOptions) string {
 	if len(rows) == 0 {
 		return ""
 	}
 
 	var builder strings.Builder
+	builder.WriteString(renderTableHeader(opts))
+	for _, row := range rows {
+		builder.WriteString(RenderTableRow(row, opts))
+	}
 
-	// Build header
-	header := "| Status | Initiative/Epic |"
-	sep := "|--------|-----------------|"
-	for _, col := range extraColumns {
-		header += fmt.Sprintf(" %s |", col)
-		sep += fmt.Sprintf("%s|", strings.Repeat("-", len(col)+2))
+	return builder.String()
+}
+
+// RenderTableRow renders a single table line for row, matching the columns of
+// RenderEmptyTable(opts); streaming output prints the header once and then
+// one row at a time
+func RenderTableRow(row Row, opts TableOptions) string {
+	// Format status column
+	var statusCol string
+	if row.NewItem {
+		statusCol = fmt.Sprintf("🆕 %s %s", row.StatusEmoji, row.StatusCaption)
+	} else if row.StatusTransition != nil {
+		statusCol = fmt.Sprintf("%s %s", *row.StatusTransition, row.StatusCaption)
+	} else {
+		statusCol = fmt.Sprintf("%s %s", row.StatusEmoji, row.StatusCaption)
 	}
-	header += " Target Date | Update |"
-	sep += "-------------|--------|"
-	builder.WriteString(header + "\n")
-	builder.WriteString(sep + "\n")
 
-	// Write each row
-	for _, row := range rows {
-		// Format status column
-		var statusCol string
-		if row.NewItem {
-			statusCol = fmt.Sprintf("🆕 %s %s", row.StatusEmoji, row.StatusCaption)
-		} else if row.StatusTransition != nil {
-			statusCol = fmt.Sprintf("%s %s", *row.StatusTransition, row.StatusCaption)
-		} else {
-			statusCol = fmt.Sprintf("%s %s", row.StatusEmoji, row.StatusCaption)
+	// Format epic column with markdown link
+	epicTitle := tableCell(row.EpicTitle, opts)
+	if opts.ShowIssueNumber && row.Number > 0 {
+		epicTitle = fmt.Sprintf("#%d %s", row.Number, epicTitle)
+	}
+	epicCol := fmt.Sprintf("[%s](%s)", epicTitle, row.EpicURL)
+
+	// Format target date column
+	dateCell := ""
+	if !opts.NoDateColumn {
+		dateCell = fmt.Sprintf(" %s |", derive.RenderTargetDate(row.TargetDate))
+	}
+
+	updateCol := renderUpdateCell(row, opts)
+	if opts.MarkFallbacks && row.RawFallback {
+		updateCol = strings.TrimSpace(updateCol + " " + RawFallbackMarker)
+	}
+	if opts.LinkUpdates && row.UpdateSourceURL != "" {
+		updateCol = strings.TrimSpace(fmt.Sprintf("%s ([source](%s))", updateCol, row.UpdateSourceURL))
+	}
+	if opts.ShowUpdateCount && row.UpdateCount > 0 {
+		updateCol = strings.TrimSpace(fmt.Sprintf("%s (%s)", updateCol, pluralizeUpdates(row.UpdateCount)))
+	}
+
+	// Build extra column cells
+	extraCells := ""
+	for _, col := range opts.ExtraColumns {
+		val := ""
+		if row.ExtraColumns != nil {
+			val = tableCell(row.ExtraColumns[col], opts)
 		}
+		extraCells += fmt.Sprintf(" %s |", val)
+	}
+
+	return fmt.Sprintf("| %s | %s |%s%s %s |\n",
+		statusCol, epicCol, extraCells, dateCell, updateCol)
+}
 
-		// Format epic column with markdown link
-		epicCol := fmt.Sprintf("[%s](%s)",
-			escapeMarkdownTableCell(row.EpicTitle),
-			row.EpicURL)
-
-		// Format target date column
-		dateCol := derive.RenderTargetDate(row.TargetDate)
-
-		// Format update column (collapse newlines and escape pipes)
-		updateCol := escapeMarkdownTableCell(collapseNewlines(row.UpdateMD))
-
-		// Build extra column cells
-		extraCells := ""
-		for _, col := range extraColumns {
-			val := ""
-			if row.ExtraColumns != nil {
-				val = escapeMarkdownTableCell(row.ExtraColumns[col])
-			}
-			extraCells += fmt.Sprintf(" %s |", val)
+// renderUpdateCell formats a row's update column: the escaped UpdateMD, or a
+// "• " list joined by <br> (which survives inside a table cell) when
+// opts.ListUpdates is set and the row has several updates
+func renderUpdateCell(row Row, opts TableOptions) string {
+	if !opts.ListUpdates || len(row.UpdateItems) < 2 {
+		// Collapse newlines and escape pipes
+		return tableCell(row.UpdateMD, opts)
+	}
+
+	items := make([]string, 0, len(row.UpdateItems))
+	for _, item := range row.UpdateItems {
+		if item = tableCell(item, opts); item != "" {
+			items = append(items, "• "+item)
 		}
+	}
+	return strings.Join(items, "<br>")
+}
 
-		builder.WriteString(fmt.Sprintf("| %s | %s |%s %s | %s |\n",
-			statusCol, epicCol, extraCells, dateCol, updateCol))
+// pluralizeUpdates returns "N update" or "N updates" with proper pluralization
+func pluralizeUpdates(count int) string {
+	if count == 1 {
+		return "1 update"
 	}
+	return fmt.Sprintf("%d updates", count)
+}
 
-	return builder.String()
+// RenderEmptyTable renders just the header and separator lines for opts, a
+// valid markdown table with no data rows
+func RenderEmptyTable(opts TableOptions) string {
+	return renderTableHeader(opts)
+}
+
+// renderTableHeader builds the header and separator lines for opts
+func renderTableHeader(opts TableOptions) string {
+	headers := opts.Headers
+	if len(headers) != len(DefaultTableHeaders) {
+		headers = DefaultTableHeaders
+	}
+
+	// Each separator cell spans its header plus padding
+	header := "|"
+	sep := "|"
+	addColumn := func(col string) {
+		header += fmt.Sprintf(" %s |", col)
+		sep += fmt.Sprintf("%s|", strings.Repeat("-", len(col)+2))
+	}
+	addColumn(headers[0])
+	addColumn(headers[1])
+	for _, col := range opts.ExtraColumns {
+		addColumn(col)
+	}
+	if !opts.NoDateColumn {
+		addColumn(headers[2])
+	}
+	addColumn(headers[3])
+
+	return header + "\n" + sep + "\n"
+}
+
+// tableCell prepares content for a table cell: escaped, or with opts.NoEscape
+// only flattened onto one line, leaving pipes and backslashes to the renderer
+func tableCell(content string, opts TableOptions) string {
+	if opts.NoEscape {
+		return strings.TrimSpace(strings.ReplaceAll(collapseNewlines(content), "\t", " "))
+	}
+	return escapeMarkdownTableCell(content)
 }
 
 // escapeMarkdownTableCell escapes pipe characters and other problematic content for table cells
@@ -133,8 +265,8 @@ func collapseNewlines(content string) string {
 }
 
 // RenderTableWithTitle renders a table with an optional title/header
-func RenderTableWithTitle(title string, rows []Row, extraColumns []string) string {
-	table := RenderTable(rows, extraColumns)
+func RenderTableWithTitle(title string, rows []Row, opts TableOptions) string {
+	table := RenderTableWithOptions(rows, opts)
 	if table == "" {
 		return ""
 	}
@@ -159,13 +291,24 @@ func getSortPriority(row Row) int {
 		return 1
 	}
 
-	// Priority 3: Needs updates or not started (lowest priority among undated items)
-	if row.StatusCaption == "Needs Update" || row.StatusCaption == "Not Started" || row.StatusCaption == "Shaping" {
+	switch row.typedStatus() {
+	case derive.NeedsUpdate, derive.NotStarted, derive.Shaping:
+		// Priority 3: Needs updates or not started (lowest priority among undated items)
 		return 3
+	default:
+		// Priority 2: Has updates but no date
+		return 2
 	}
+}
 
-	// Priority 2: Has updates but no date
-	return 2
+// typedStatus returns row.Status, recovering it from StatusCaption for rows
+// built without one (e.g. in tests or from a parsed previous report)
+func (row Row) typedStatus() derive.Status {
+	if row.Status != (derive.Status{}) {
+		return row.Status
+	}
+	status, _ := derive.ParseCaption(row.StatusCaption)
+	return status
 }
 
 // SortRowsByTargetDate sorts a slice of rows by priority and target date
@@ -111,6 +111,7 @@ func TestNewRow(t *testing.T) {
 	)
 
 	expected := Row{
+		Status:        derive.OnTrack,
 		StatusEmoji:   ":green_circle:",
 		StatusCaption: "On Track",
 		EpicTitle:     "Test Epic",
@@ -326,7 +327,7 @@ func TestRenderTableWithTitle(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := RenderTableWithTitle(tt.title, tt.rows, nil)
+			result := RenderTableWithTitle(tt.title, tt.rows, TableOptions{})
 			if result != tt.expected {
 				t.Errorf("RenderTableWithTitle() mismatch\nExpected:\n%s\nGot:\n%s",
 					tt.expected, result)
@@ -402,6 +403,55 @@ func TestTableGoldenFormat(t *testing.T) {
 	}
 }
 
+func TestTableGoldenFormat_NoDateColumn(t *testing.T) {
+	utcTime := func(year int, month time.Month, day int) *time.Time {
+		t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	rows := []Row{
+		{
+			StatusEmoji:   ":red_circle:",
+			StatusCaption: "Off Track",
+			EpicTitle:     "Payment Gateway Integration",
+			EpicURL:       "https://github.com/owner/repo/issues/456",
+			TargetDate:    nil,
+			UpdateMD:      "Blocked by third-party API changes, need to redesign approach",
+		},
+		{
+			StatusEmoji:   ":purple_circle:",
+			StatusCaption: "Done",
+			EpicTitle:     "User Authentication System",
+			EpicURL:       "https://github.com/owner/repo/issues/123",
+			TargetDate:    utcTime(2025, 8, 6),
+			UpdateMD:      "Completed OAuth2 integration and session management with passing tests",
+		},
+	}
+
+	// Dated rows still sort first even though the date isn't rendered
+	SortRowsByTargetDate(rows)
+
+	expected := `| Status | Initiative/Epic | Update |
+|--------|-----------------|--------|
+| :purple_circle: Done | [User Authentication System](https://github.com/owner/repo/issues/123) | Completed OAuth2 integration and session management with passing tests |
+| :red_circle: Off Track | [Payment Gateway Integration](https://github.com/owner/repo/issues/456) | Blocked by third-party API changes, need to redesign approach |
+`
+
+	result := RenderTableWithOptions(rows, TableOptions{NoDateColumn: true})
+	if result != expected {
+		t.Errorf("Golden table format mismatch\nExpected:\n%s\nGot:\n%s",
+			expected, result)
+	}
+
+	for i, line := range strings.Split(strings.TrimSpace(result), "\n") {
+		columns := strings.Split(line, "|")
+		if len(columns) != 5 { // empty + 3 columns + empty
+			t.Errorf("Line %d has %d columns, expected 5 (including empty): %s",
+				i, len(columns), line)
+		}
+	}
+}
+
 func TestSortRowsByTargetDate(t *testing.T) {
 	utcTime := func(year int, month time.Month, day int) *time.Time {
 		t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
@@ -657,6 +707,33 @@ func TestGetSortPriority(t *testing.T) {
 	}
 }
 
+func TestGetSortPriority_TypedStatus(t *testing.T) {
+	// A renamed caption must not change the tier; the typed status decides it
+	renamed := derive.NeedsUpdate
+	renamed.Caption = "Stale"
+
+	tests := []struct {
+		name             string
+		row              Row
+		expectedPriority int
+	}{
+		{name: "needs update with renamed caption", row: Row{Status: derive.NeedsUpdate, StatusCaption: "Stale"}, expectedPriority: 3},
+		{name: "shaping via NewRow", row: NewRow(derive.Shaping, "Epic", "", nil, ""), expectedPriority: 3},
+		{name: "on track via NewRow", row: NewRow(derive.OnTrack, "Epic", "", nil, ""), expectedPriority: 2},
+		{name: "typed status wins over a misleading caption", row: Row{Status: derive.OnTrack, StatusCaption: "Needs Update"}, expectedPriority: 2},
+		{name: "unrecognized status", row: Row{Status: renamed}, expectedPriority: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priority := getSortPriority(tt.row)
+			if priority != tt.expectedPriority {
+				t.Errorf("getSortPriority() = %d, expected %d", priority, tt.expectedPriority)
+			}
+		})
+	}
+}
+
 func TestSortAndRenderIntegration(t *testing.T) {
 	// Test the complete flow: sort then render
 	utcTime := func(year int, month time.Month, day int) *time.Time {
@@ -861,3 +938,273 @@ func TestRenderTable_ExtraColumns(t *testing.T) {
 		}
 	})
 }
+
+func TestRenderTableWithOptions_CustomHeaders(t *testing.T) {
+	row := Row{
+		StatusEmoji:   ":green_circle:",
+		StatusCaption: "On Track",
+		EpicTitle:     "My Epic",
+		EpicURL:       "https://github.com/owner/repo/issues/1",
+		UpdateMD:      "Looking good",
+		ExtraColumns:  map[string]string{"Priority": "P1"},
+	}
+
+	t.Run("custom headers", func(t *testing.T) {
+		opts := TableOptions{Headers: []string{"RAG", "Workstream", "Due", "Notes"}}
+		expected := `| RAG | Workstream | Due | Notes |
+|-----|------------|-----|-------|
+| :green_circle: On Track | [My Epic](https://github.com/owner/repo/issues/1) | TBD | Looking good |
+`
+		if result := RenderTableWithOptions([]Row{row}, opts); result != expected {
+			t.Errorf("Custom header mismatch\nExpected:\n%s\nGot:\n%s", expected, result)
+		}
+	})
+
+	t.Run("custom headers with extra columns", func(t *testing.T) {
+		opts := TableOptions{
+			Headers:      []string{"RAG", "Workstream", "Due", "Notes"},
+			ExtraColumns: []string{"Priority"},
+		}
+		result := RenderTableWithOptions([]Row{row}, opts)
+		lines := strings.Split(result, "\n")
+		if lines[0] != "| RAG | Workstream | Priority | Due | Notes |" {
+			t.Errorf("Header mismatch, got: %s", lines[0])
+		}
+		if lines[1] != "|-----|------------|----------|-----|-------|" {
+			t.Errorf("Separator mismatch, got: %s", lines[1])
+		}
+	})
+
+	t.Run("nil headers match defaults", func(t *testing.T) {
+		if RenderTableWithOptions([]Row{row}, TableOptions{}) != RenderTable([]Row{row}, nil) {
+			t.Error("Expected zero-value options to render the default table")
+		}
+	})
+}
+
+func TestRenderTableWithOptions_ShowIssueNumber(t *testing.T) {
+	rows := []Row{
+		{
+			StatusEmoji:   ":green_circle:",
+			StatusCaption: "On Track",
+			EpicTitle:     "User Auth",
+			EpicURL:       "https://github.com/owner/repo/issues/123",
+			Number:        123,
+			UpdateMD:      "Looking good",
+		},
+		{
+			StatusEmoji:   ":yellow_circle:",
+			StatusCaption: "At Risk",
+			EpicTitle:     "Unnumbered",
+			EpicURL:       "https://example.com/item",
+			UpdateMD:      "Waiting",
+		},
+	}
+
+	expected := `| Status | Initiative/Epic | Target Date | Update |
+|--------|-----------------|-------------|--------|
+| :green_circle: On Track | [#123 User Auth](https://github.com/owner/repo/issues/123) | TBD | Looking good |
+| :yellow_circle: At Risk | [Unnumbered](https://example.com/item) | TBD | Waiting |
+`
+	if result := RenderTableWithOptions(rows, TableOptions{ShowIssueNumber: true}); result != expected {
+		t.Errorf("Numbered table mismatch\nExpected:\n%s\nGot:\n%s", expected, result)
+	}
+
+	if result := RenderTable(rows, nil); strings.Contains(result, "#123") {
+		t.Errorf("Expected default table to omit issue numbers, got:\n%s", result)
+	}
+}
+
+func TestRenderTableWithOptions_LinkUpdates(t *testing.T) {
+	rows := []Row{
+		{
+			StatusEmoji:     ":green_circle:",
+			StatusCaption:   "On Track",
+			EpicTitle:       "User Auth",
+			EpicURL:         "https://github.com/owner/repo/issues/1",
+			UpdateMD:        "Shipped A | B\nnext: C",
+			UpdateSourceURL: "https://github.com/owner/repo/issues/1#issuecomment-42",
+		},
+		{
+			StatusEmoji:   ":white_circle:",
+			StatusCaption: "Needs Update",
+			EpicTitle:     "Quiet",
+			EpicURL:       "https://github.com/owner/repo/issues/2",
+			UpdateMD:      "No update provided",
+		},
+	}
+
+	expected := `| Status | Initiative/Epic | Target Date | Update |
+|--------|-----------------|-------------|--------|
+| :green_circle: On Track | [User Auth](https://github.com/owner/repo/issues/1) | TBD | Shipped A \| B next: C ([source](https://github.com/owner/repo/issues/1#issuecomment-42)) |
+| :white_circle: Needs Update | [Quiet](https://github.com/owner/repo/issues/2) | TBD | No update provided |
+`
+	if result := RenderTableWithOptions(rows, TableOptions{LinkUpdates: true}); result != expected {
+		t.Errorf("Linked table mismatch\nExpected:\n%s\nGot:\n%s", expected, result)
+	}
+
+	if result := RenderTable(rows, nil); strings.Contains(result, "[source]") {
+		t.Errorf("Expected default table to omit source links, got:\n%s", result)
+	}
+}
+
+func TestRenderTableWithOptions_ListUpdates(t *testing.T) {
+	rows := []Row{
+		{
+			StatusEmoji:   ":green_circle:",
+			StatusCaption: "On Track",
+			EpicTitle:     "User Auth",
+			EpicURL:       "https://github.com/owner/repo/issues/1",
+			UpdateMD:      "Shipped A | B\nnext: C",
+			UpdateItems:   []string{"Shipped A | B\nnext: C", "Started design", "  "},
+		},
+		{
+			StatusEmoji:   ":green_circle:",
+			StatusCaption: "On Track",
+			EpicTitle:     "Single",
+			EpicURL:       "https://github.com/owner/repo/issues/2",
+			UpdateMD:      "Only update",
+			UpdateItems:   []string{"Only update"},
+		},
+	}
+
+	expected := `| Status | Initiative/Epic | Target Date | Update |
+|--------|-----------------|-------------|--------|
+| :green_circle: On Track | [User Auth](https://github.com/owner/repo/issues/1) | TBD | • Shipped A \| B next: C<br>• Started design |
+| :green_circle: On Track | [Single](https://github.com/owner/repo/issues/2) | TBD | Only update |
+`
+	if result := RenderTableWithOptions(rows, TableOptions{ListUpdates: true}); result != expected {
+		t.Errorf("Listed table mismatch\nExpected:\n%s\nGot:\n%s", expected, result)
+	}
+
+	if result := RenderTable(rows, nil); strings.Contains(result, "<br>") {
+		t.Errorf("Expected default table to show only UpdateMD, got:\n%s", result)
+	}
+}
+
+func TestRenderTableWithOptions_ShowUpdateCount(t *testing.T) {
+	rows := []Row{
+		{StatusEmoji: ":green_circle:", StatusCaption: "On Track", EpicTitle: "Busy", EpicURL: "https://github.com/owner/repo/issues/1", UpdateMD: "Shipped A", UpdateCount: 3},
+		{StatusEmoji: ":green_circle:", StatusCaption: "On Track", EpicTitle: "Single", EpicURL: "https://github.com/owner/repo/issues/2", UpdateMD: "Shipped B", UpdateCount: 1},
+		{StatusEmoji: ":white_circle:", StatusCaption: "Needs Update", EpicTitle: "Quiet", EpicURL: "https://github.com/owner/repo/issues/3", UpdateMD: "No update provided"},
+	}
+
+	expected := `| Status | Initiative/Epic | Target Date | Update |
+|--------|-----------------|-------------|--------|
+| :green_circle: On Track | [Busy](https://github.com/owner/repo/issues/1) | TBD | Shipped A (3 updates) |
+| :green_circle: On Track | [Single](https://github.com/owner/repo/issues/2) | TBD | Shipped B (1 update) |
+| :white_circle: Needs Update | [Quiet](https://github.com/owner/repo/issues/3) | TBD | No update provided |
+`
+	if result := RenderTableWithOptions(rows, TableOptions{ShowUpdateCount: true}); result != expected {
+		t.Errorf("Counted table mismatch\nExpected:\n%s\nGot:\n%s", expected, result)
+	}
+
+	if result := RenderTable(rows, nil); strings.Contains(result, "update)") || strings.Contains(result, "updates)") {
+		t.Errorf("Expected no counts without ShowUpdateCount, got:\n%s", result)
+	}
+}
+
+func TestRenderTableWithOptions_MarkFallbacks(t *testing.T) {
+	rows := []Row{
+		{StatusEmoji: ":green_circle:", StatusCaption: "On Track", EpicTitle: "Raw", EpicURL: "https://github.com/owner/repo/issues/1", UpdateMD: "wip on the api", RawFallback: true},
+		{StatusEmoji: ":green_circle:", StatusCaption: "On Track", EpicTitle: "Polished", EpicURL: "https://github.com/owner/repo/issues/2", UpdateMD: "The API is on track."},
+	}
+
+	expected := `| Status | Initiative/Epic | Target Date | Update |
+|--------|-----------------|-------------|--------|
+| :green_circle: On Track | [Raw](https://github.com/owner/repo/issues/1) | TBD | wip on the api _(raw)_ |
+| :green_circle: On Track | [Polished](https://github.com/owner/repo/issues/2) | TBD | The API is on track. |
+`
+	if result := RenderTableWithOptions(rows, TableOptions{MarkFallbacks: true}); result != expected {
+		t.Errorf("Marked table mismatch\nExpected:\n%s\nGot:\n%s", expected, result)
+	}
+
+	if result := RenderTable(rows, nil); strings.Contains(result, RawFallbackMarker) {
+		t.Errorf("Expected no marker without MarkFallbacks, got:\n%s", result)
+	}
+}
+
+func TestRenderTableWithOptions_NoEscape(t *testing.T) {
+	rows := []Row{
+		{StatusEmoji: ":green_circle:", StatusCaption: "On Track", EpicTitle: "A | B", EpicURL: "https://github.com/owner/repo/issues/1", UpdateMD: "Ran `a | b` in C:\\tmp\nthen\tshipped"},
+	}
+
+	escaped := "| :green_circle: On Track | [A \\| B](https://github.com/owner/repo/issues/1) | TBD | Ran `a \\| b` in C:\\\\tmp then shipped |\n"
+	if result := RenderTableRow(rows[0], TableOptions{}); result != escaped {
+		t.Errorf("Escaped row mismatch\nExpected: %q\nGot:      %q", escaped, result)
+	}
+
+	raw := "| :green_circle: On Track | [A | B](https://github.com/owner/repo/issues/1) | TBD | Ran `a | b` in C:\\tmp then shipped |\n"
+	if result := RenderTableRow(rows[0], TableOptions{NoEscape: true}); result != raw {
+		t.Errorf("Unescaped row mismatch\nExpected: %q\nGot:      %q", raw, result)
+	}
+}
+
+func TestRenderEmptyTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     TableOptions
+		expected string
+	}{
+		{
+			name: "default headers",
+			opts: TableOptions{},
+			expected: "| Status | Initiative/Epic | Target Date | Update |\n" +
+				"|--------|-----------------|-------------|--------|\n",
+		},
+		{
+			name: "extra columns without date",
+			opts: TableOptions{ExtraColumns: []string{"Priority"}, NoDateColumn: true},
+			expected: "| Status | Initiative/Epic | Priority | Update |\n" +
+				"|--------|-----------------|----------|--------|\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := RenderEmptyTable(tt.opts); result != tt.expected {
+				t.Errorf("Empty table mismatch\nExpected:\n%s\nGot:\n%s", tt.expected, result)
+			}
+		})
+	}
+
+	// The empty table is exactly the header of a populated one
+	row := Row{StatusEmoji: ":green_circle:", StatusCaption: "On Track", EpicTitle: "Epic", EpicURL: "url"}
+	if !strings.HasPrefix(RenderTable([]Row{row}, nil), RenderEmptyTable(TableOptions{})) {
+		t.Error("Expected RenderEmptyTable to match the populated table header")
+	}
+}
+
+func TestParseTableHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected []string
+		wantErr  bool
+	}{
+		{"four headers", "RAG,Workstream,Due,Notes", []string{"RAG", "Workstream", "Due", "Notes"}, false},
+		{"trims whitespace", " RAG , Workstream ,Due, Notes", []string{"RAG", "Workstream", "Due", "Notes"}, false},
+		{"too few", "RAG,Workstream,Due", nil, true},
+		{"too many", "RAG,Workstream,Owner,Due,Notes", nil, true},
+		{"empty header", "RAG,,Due,Notes", nil, true},
+		{"pipe in header", "RAG,Work|stream,Due,Notes", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseTableHeaders(tt.raw)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseTableHeaders(%q) expected error, got %v", tt.raw, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTableHeaders(%q) unexpected error: %v", tt.raw, err)
+			}
+			if strings.Join(result, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("ParseTableHeaders(%q) = %v, expected %v", tt.raw, result, tt.expected)
+			}
+		})
+	}
+}
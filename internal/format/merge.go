@@ -0,0 +1,127 @@
+package format
+
+import (
+	"strings"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+)
+
+// statusSeverityOrder ranks statuses from worst to best for merging;
+// statuses not listed rank after all of these
+var statusSeverityOrder = []derive.Status{
+	derive.OffTrack,
+	derive.AtRisk,
+	derive.NeedsUpdate,
+	derive.Unknown,
+	derive.NotStarted,
+	derive.Shaping,
+	derive.OnTrack,
+	derive.Done,
+}
+
+// statusSeverity returns the rank of status in statusSeverityOrder (lower is worse)
+func statusSeverity(status derive.Status) int {
+	for i, s := range statusSeverityOrder {
+		if s == status {
+			return i
+		}
+	}
+	return len(statusSeverityOrder)
+}
+
+// normalizeTitle folds case and whitespace so near-identical titles compare equal
+func normalizeTitle(title string) string {
+	return strings.ToLower(strings.Join(strings.Fields(title), " "))
+}
+
+// MergeRowsByTitle collapses rows whose normalized EpicTitle matches into the
+// first such row. The merged row takes the worst status, the latest target date,
+// and the distinct updates joined with " / " (and listed together in
+// UpdateItems); assignees and labels are unioned, update counts added, and the
+// row is a raw fallback if any merged row was. The update source link is kept
+// only when every merged row links the same comment.
+func MergeRowsByTitle(rows []Row) []Row {
+	index := make(map[string]int, len(rows))
+	var merged []Row
+
+	for _, row := range rows {
+		key := normalizeTitle(row.EpicTitle)
+		i, seen := index[key]
+		if !seen {
+			index[key] = len(merged)
+			merged = append(merged, row)
+			continue
+		}
+
+		target := &merged[i]
+		if statusSeverity(row.typedStatus()) < statusSeverity(target.typedStatus()) {
+			target.Status = row.Status
+			target.StatusEmoji = row.StatusEmoji
+			target.StatusCaption = row.StatusCaption
+			target.StatusTransition = row.StatusTransition
+		}
+		if row.TargetDate != nil && (target.TargetDate == nil || row.TargetDate.After(*target.TargetDate)) {
+			target.TargetDate = row.TargetDate
+		}
+		if len(target.UpdateItems) > 0 || len(row.UpdateItems) > 0 {
+			target.UpdateItems = unionStrings(updateItems(*target), updateItems(row))
+		}
+		target.UpdateMD = appendUpdate(target.UpdateMD, row.UpdateMD)
+		if target.UpdateSourceURL != row.UpdateSourceURL {
+			target.UpdateSourceURL = ""
+		}
+		target.UpdateCount += row.UpdateCount
+		target.RawFallback = target.RawFallback || row.RawFallback
+		target.Assignees = unionStrings(target.Assignees, row.Assignees)
+		target.Labels = unionStrings(target.Labels, row.Labels)
+		target.NewItem = target.NewItem && row.NewItem
+	}
+
+	return merged
+}
+
+// updateItems returns row's separate updates, or its UpdateMD as the only one
+// when it has none (e.g. an AI summary)
+func updateItems(row Row) []string {
+	if len(row.UpdateItems) > 0 {
+		return append([]string(nil), row.UpdateItems...)
+	}
+	if update := strings.TrimSpace(row.UpdateMD); update != "" {
+		return []string{update}
+	}
+	return nil
+}
+
+// appendUpdate joins next onto existing unless it is empty or already present
+func appendUpdate(existing, next string) string {
+	next = strings.TrimSpace(next)
+	switch {
+	case next == "":
+		return existing
+	case existing == "":
+		return next
+	}
+	for _, part := range strings.Split(existing, " / ") {
+		if part == next {
+			return existing
+		}
+	}
+	return existing + " / " + next
+}
+
+// unionStrings appends values from b not already in a, preserving order
+func unionStrings(a, b []string) []string {
+	for _, v := range b {
+		found := false
+		for _, existing := range a {
+			if existing == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a = append(a, v)
+		}
+	}
+	return a
+}
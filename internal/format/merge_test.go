@@ -0,0 +1,108 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
+)
+
+func TestMergeRowsByTitle_StatusPrecedence(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []derive.Status
+		expected derive.Status
+	}{
+		{"off track beats on track", []derive.Status{derive.OnTrack, derive.OffTrack}, derive.OffTrack},
+		{"at risk beats done", []derive.Status{derive.Done, derive.AtRisk}, derive.AtRisk},
+		{"needs update beats on track", []derive.Status{derive.OnTrack, derive.NeedsUpdate}, derive.NeedsUpdate},
+		{"first kept when equal", []derive.Status{derive.AtRisk, derive.AtRisk}, derive.AtRisk},
+		{"worst of three", []derive.Status{derive.OnTrack, derive.AtRisk, derive.OffTrack}, derive.OffTrack},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rows []Row
+			for i, status := range tt.statuses {
+				rows = append(rows, NewRow(status, "User Auth", fmt.Sprintf("https://github.com/org/repo/issues/%d", i+1), nil, ""))
+			}
+
+			merged := MergeRowsByTitle(rows)
+			if len(merged) != 1 {
+				t.Fatalf("expected 1 merged row, got %d", len(merged))
+			}
+			if merged[0].StatusCaption != tt.expected.Caption || merged[0].StatusEmoji != tt.expected.Emoji {
+				t.Errorf("got %s %s, want %s %s", merged[0].StatusEmoji, merged[0].StatusCaption, tt.expected.Emoji, tt.expected.Caption)
+			}
+			if merged[0].EpicURL != rows[0].EpicURL {
+				t.Errorf("expected merged row to keep the first URL, got %s", merged[0].EpicURL)
+			}
+		})
+	}
+}
+
+func TestMergeRowsByTitle_ConcatenatesUpdates(t *testing.T) {
+	early := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	first := NewRow(derive.OnTrack, "User Auth", "https://github.com/org/api/issues/1", &early, "API ready")
+	first.Assignees = []string{"alice"}
+	second := NewRow(derive.AtRisk, "  user   auth ", "https://github.com/org/web/issues/2", &late, "UI blocked on design")
+	second.Assignees = []string{"alice", "bob"}
+	duplicate := NewRow(derive.OnTrack, "User Auth", "https://github.com/org/docs/issues/3", nil, "API ready")
+	other := NewRow(derive.OnTrack, "Billing", "https://github.com/org/api/issues/4", nil, "On schedule")
+
+	merged := MergeRowsByTitle([]Row{first, other, second, duplicate})
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 rows after merge, got %d", len(merged))
+	}
+
+	auth := merged[0]
+	if auth.EpicTitle != "User Auth" {
+		t.Errorf("expected first title to be kept, got %q", auth.EpicTitle)
+	}
+	if auth.UpdateMD != "API ready / UI blocked on design" {
+		t.Errorf("unexpected merged update %q", auth.UpdateMD)
+	}
+	if auth.TargetDate == nil || !auth.TargetDate.Equal(late) {
+		t.Errorf("expected latest target date %v, got %v", late, auth.TargetDate)
+	}
+	if strings.Join(auth.Assignees, ",") != "alice,bob" {
+		t.Errorf("expected unioned assignees, got %v", auth.Assignees)
+	}
+	if merged[1].EpicTitle != "Billing" || merged[1].UpdateMD != "On schedule" {
+		t.Errorf("expected unrelated row untouched, got %+v", merged[1])
+	}
+}
+
+func TestMergeRowsByTitle_MergesUpdateDetails(t *testing.T) {
+	first := NewRow(derive.OnTrack, "User Auth", "https://github.com/org/api/issues/1", nil, "API ready")
+	first.UpdateItems = []string{"API ready", "Tokens rotated"}
+	first.UpdateSourceURL = "https://github.com/org/api/issues/1#issuecomment-1"
+	first.UpdateCount = 2
+	second := NewRow(derive.OnTrack, "User Auth", "https://github.com/org/web/issues/2", nil, "UI blocked on design")
+	second.UpdateSourceURL = "https://github.com/org/web/issues/2#issuecomment-2"
+	second.UpdateCount = 1
+	second.RawFallback = true
+
+	merged := MergeRowsByTitle([]Row{first, second})
+	if len(merged) != 1 {
+		t.Fatalf("expected 1 merged row, got %d", len(merged))
+	}
+
+	row := merged[0]
+	if strings.Join(row.UpdateItems, ",") != "API ready,Tokens rotated,UI blocked on design" {
+		t.Errorf("expected joined update items, got %v", row.UpdateItems)
+	}
+	if row.UpdateCount != 3 || !row.RawFallback || row.UpdateSourceURL != "" {
+		t.Errorf("unexpected merged update details: count=%d raw=%v source=%q", row.UpdateCount, row.RawFallback, row.UpdateSourceURL)
+	}
+
+	cell := RenderTableRow(row, TableOptions{ListUpdates: true, ShowUpdateCount: true, MarkFallbacks: true})
+	want := "• API ready<br>• Tokens rotated<br>• UI blocked on design " + RawFallbackMarker + " (3 updates)"
+	if !strings.Contains(cell, want) {
+		t.Errorf("expected the merged update list in %q", cell)
+	}
+}
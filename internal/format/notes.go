@@ -3,6 +3,9 @@ package format
 import (
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
 )
 
 // NoteKind represents the type of note to be generated
@@ -37,40 +40,142 @@ const (
 	// NoteStatusChanged indicates the status of an issue changed from
 	// the previous report to the current one.
 	NoteStatusChanged
+	// NoteOverdueTarget indicates the target date has passed but the issue
+	// is not done.
+	NoteOverdueTarget
+	// NoteSkippedInaccessible aggregates issues skipped because the token
+	// can't read them (private repositories, deleted issues).
+	NoteSkippedInaccessible
+	// NoteTargetDateChanged indicates the target date of an issue changed
+	// from the previous report to the current one.
+	NoteTargetDateChanged
+	// NoteUnknownStatus indicates the newest report's trending value didn't
+	// map to a known status.
+	NoteUnknownStatus
+	// NoteNoCommentsInWindow indicates the issue had no comments at all
+	// within the time window, as opposed to comments without a report.
+	NoteNoCommentsInWindow
+	// NoteMissingTargetDate indicates an issue that is not done has no
+	// target date.
+	NoteMissingTargetDate
 )
 
 // Note represents a note entry about an issue's status reporting
 type Note struct {
-	Kind            NoteKind // Type of note
-	IssueURL        string   // URL of the GitHub issue
-	SinceDays       int      // Number of days in the search window
-	ReportedStatus  string   // The original reported status caption (for sentiment mismatch)
-	SuggestedStatus string   // AI-suggested status caption (for sentiment mismatch)
-	Explanation     string   // AI explanation of the mismatch (for sentiment mismatch)
+	Kind               NoteKind   // Type of note
+	IssueURL           string     // URL of the GitHub issue
+	IssueTitle         string     // Issue title, rendered as a link to IssueURL (empty = bare URL)
+	SinceDays          int        // Number of days in the search window
+	ReportedStatus     string     // The original reported status caption (for sentiment mismatch) or raw trending value (for unknown status)
+	SuggestedStatus    string     // AI-suggested status caption (for sentiment mismatch)
+	Explanation        string     // AI explanation of the mismatch (for sentiment mismatch)
+	TargetDate         *time.Time // Missed target date (for overdue target) or new target date (for target date changed)
+	DaysAgo            int        // Days since the target date passed or the last update (for overdue target, no updates)
+	Count              int        // Number of issues the note covers (for skipped inaccessible)
+	PreviousTargetDate string     // Target date rendered in the previous report (for target date changed)
+}
+
+// NotesOptions controls how RenderNotesWithOptions renders the notes section.
+// The zero value renders the "## Notes" section with linked issue titles.
+type NotesOptions struct {
+	Collapsible bool // Wrap the section in a <details> block
+	Plain       bool // Show bare issue URLs instead of linked titles
 }
 
 // RenderNotes generates a markdown notes section from a slice of notes
 // Returns empty string if no notes are provided
-// Format: "## Notes" header followed by bullet points
+// Format: "## Notes" header followed by one bullet per note kind, with the
+// notes of that kind as sub-bullets
 func RenderNotes(notes []Note) string {
+	return RenderNotesWithOptions(notes, NotesOptions{})
+}
+
+// RenderNotesWithOptions generates the notes section laid out by opts
+func RenderNotesWithOptions(notes []Note, opts NotesOptions) string {
 	if len(notes) == 0 {
 		return ""
 	}
 
 	var builder strings.Builder
+	if opts.Collapsible {
+		builder.WriteString(fmt.Sprintf("<details>\n<summary>📝 Notes (%d)</summary>\n\n", len(notes)))
+		writeNoteGroups(&builder, notes, opts)
+		builder.WriteString("\n</details>\n")
+		return builder.String()
+	}
 
 	// Write section header
 	builder.WriteString("## Notes\n\n")
+	writeNoteGroups(&builder, notes, opts)
+
+	return builder.String()
+}
 
-	// Write each note as a bullet point
+// writeNoteGroups writes notes grouped by kind, in order of each kind's first
+// appearance; notes keep their relative order within a group
+func writeNoteGroups(builder *strings.Builder, notes []Note, opts NotesOptions) {
+	var kinds []NoteKind
+	bullets := make(map[NoteKind][]string)
 	for _, note := range notes {
+		if opts.Plain {
+			note.IssueTitle = ""
+		}
 		bullet := renderNoteBullet(note)
-		if bullet != "" {
-			builder.WriteString(fmt.Sprintf("- %s\n", bullet))
+		if bullet == "" {
+			continue
+		}
+		if _, seen := bullets[note.Kind]; !seen {
+			kinds = append(kinds, note.Kind)
 		}
+		bullets[note.Kind] = append(bullets[note.Kind], bullet)
 	}
 
-	return builder.String()
+	for _, kind := range kinds {
+		builder.WriteString(fmt.Sprintf("- **%s**\n", noteKindHeading(kind)))
+		for _, bullet := range bullets[kind] {
+			builder.WriteString(fmt.Sprintf("  - %s\n", bullet))
+		}
+	}
+}
+
+// noteKindHeading returns the group heading for notes of the given kind
+func noteKindHeading(kind NoteKind) string {
+	switch kind {
+	case NoteMultipleUpdates:
+		return "Multiple updates"
+	case NoteNoUpdatesInWindow:
+		return "No recent updates"
+	case NoteNoCommentsInWindow:
+		return "No recent comments"
+	case NoteUnstructuredFallback:
+		return "Unstructured updates"
+	case NoteSentimentMismatch:
+		return "Sentiment mismatches"
+	case NoteNewIssueShaping:
+		return "New issues"
+	case NoteSemiStructuredFallback:
+		return "Markdown-formatted updates"
+	case NoteLabelFallback:
+		return "Status from labels"
+	case NoteNewItem:
+		return "New items"
+	case NoteRemovedItem:
+		return "Removed items"
+	case NoteStatusChanged:
+		return "Status changes"
+	case NoteOverdueTarget:
+		return "Overdue targets"
+	case NoteSkippedInaccessible:
+		return "Skipped items"
+	case NoteTargetDateChanged:
+		return "Target date changes"
+	case NoteUnknownStatus:
+		return "Unknown status"
+	case NoteMissingTargetDate:
+		return "Missing target dates"
+	default:
+		return "Other"
+	}
 }
 
 // renderNoteBullet generates the bullet point text for a single note
@@ -80,43 +185,80 @@ func renderNoteBullet(note Note) string {
 		// Handle pluralization for days
 		dayText := pluralizeDays(note.SinceDays)
 		return fmt.Sprintf("%s: multiple structured updates in last %s",
-			note.IssueURL, dayText)
+			noteIssue(note), dayText)
 
 	case NoteNoUpdatesInWindow:
 		// Handle pluralization for days
 		dayText := pluralizeDays(note.SinceDays)
+		if note.DaysAgo > 0 {
+			return fmt.Sprintf("%s: no update in last %s (last update %s ago)",
+				noteIssue(note), dayText, pluralizeDays(note.DaysAgo))
+		}
 		return fmt.Sprintf("%s: no update in last %s",
-			note.IssueURL, dayText)
+			noteIssue(note), dayText)
+
+	case NoteNoCommentsInWindow:
+		dayText := pluralizeDays(note.SinceDays)
+		if note.DaysAgo > 0 {
+			return fmt.Sprintf("%s: no comments in last %s (last update %s ago)",
+				noteIssue(note), dayText, pluralizeDays(note.DaysAgo))
+		}
+		return fmt.Sprintf("%s: no comments in last %s",
+			noteIssue(note), dayText)
 
 	case NoteUnstructuredFallback:
 		return fmt.Sprintf("%s: no structured update found — summary derived from most recent comment",
-			note.IssueURL)
+			noteIssue(note))
 
 	case NoteSentimentMismatch:
 		return fmt.Sprintf("%s: reported as %s, but sentiment suggests %s — %s",
-			note.IssueURL, note.ReportedStatus, note.SuggestedStatus, note.Explanation)
+			noteIssue(note), note.ReportedStatus, note.SuggestedStatus, note.Explanation)
 
 	case NoteNewIssueShaping:
 		return fmt.Sprintf("%s: new issue — still being shaped",
-			note.IssueURL)
+			noteIssue(note))
 
 	case NoteSemiStructuredFallback:
 		return fmt.Sprintf(
 			"%s: status derived from markdown-formatted comment (not structured report)",
-			note.IssueURL)
+			noteIssue(note))
 
 	case NoteLabelFallback:
 		return fmt.Sprintf("%s: status derived from issue label",
-			note.IssueURL)
+			noteIssue(note))
 
 	case NoteNewItem:
-		return fmt.Sprintf("%s: new item (not in previous report)", note.IssueURL)
+		return fmt.Sprintf("%s: new item (not in previous report)", noteIssue(note))
 
 	case NoteRemovedItem:
-		return fmt.Sprintf("%s: removed (was %s in previous report)", note.IssueURL, note.ReportedStatus)
+		return fmt.Sprintf("%s: removed (was %s in previous report)", noteIssue(note), note.ReportedStatus)
 
 	case NoteStatusChanged:
-		return fmt.Sprintf("%s: status changed from %s to %s", note.IssueURL, note.ReportedStatus, note.SuggestedStatus)
+		return fmt.Sprintf("%s: status changed from %s to %s", noteIssue(note), note.ReportedStatus, note.SuggestedStatus)
+
+	case NoteTargetDateChanged:
+		return fmt.Sprintf("%s: target date changed from %s to %s",
+			noteIssue(note), note.PreviousTargetDate, derive.RenderTargetDate(note.TargetDate))
+
+	case NoteOverdueTarget:
+		return fmt.Sprintf("%s: target date %s passed %s ago",
+			noteIssue(note), derive.RenderTargetDate(note.TargetDate), pluralizeDays(note.DaysAgo))
+
+	case NoteUnknownStatus:
+		if note.ReportedStatus == "" {
+			return fmt.Sprintf("%s: report has no trending value — status unknown", noteIssue(note))
+		}
+		return fmt.Sprintf("%s: trending value %q doesn't map to a known status", noteIssue(note), note.ReportedStatus)
+
+	case NoteMissingTargetDate:
+		return fmt.Sprintf("%s: no target date set", noteIssue(note))
+
+	case NoteSkippedInaccessible:
+		items := "items"
+		if note.Count == 1 {
+			items = "item"
+		}
+		return fmt.Sprintf("%d %s skipped due to access (private repository or missing issue)", note.Count, items)
 
 	default:
 		// Unknown note kind, return empty string
@@ -124,6 +266,18 @@ func renderNoteBullet(note Note) string {
 	}
 }
 
+// noteTitleEscaper keeps brackets in a title from closing its link text early
+var noteTitleEscaper = strings.NewReplacer("[", "\\[", "]", "\\]")
+
+// noteIssue renders the issue a note is about: a link titled with the issue
+// title when the note has one, otherwise the bare URL
+func noteIssue(note Note) string {
+	if note.IssueTitle == "" {
+		return note.IssueURL
+	}
+	return fmt.Sprintf("[%s](%s)", noteTitleEscaper.Replace(note.IssueTitle), note.IssueURL)
+}
+
 // pluralizeDays returns "N day" or "N days" with proper pluralization
 func pluralizeDays(days int) string {
 	if days == 1 {
@@ -134,19 +288,7 @@ func pluralizeDays(days int) string {
 
 // RenderNotesCollapsible generates a collapsible notes section wrapped in HTML <details>.
 func RenderNotesCollapsible(notes []Note) string {
-	if len(notes) == 0 {
-		return ""
-	}
-	var builder strings.Builder
-	builder.WriteString(fmt.Sprintf("<details>\n<summary>📝 Notes (%d)</summary>\n\n", len(notes)))
-	for _, note := range notes {
-		bullet := renderNoteBullet(note)
-		if bullet != "" {
-			builder.WriteString(fmt.Sprintf("- %s\n", bullet))
-		}
-	}
-	builder.WriteString("\n</details>\n")
-	return builder.String()
+	return RenderNotesWithOptions(notes, NotesOptions{Collapsible: true})
 }
 
 // HasNotesOfKind checks if any notes of the specified kind exist
@@ -159,6 +301,29 @@ func HasNotesOfKind(notes []Note, kind NoteKind) bool {
 	return false
 }
 
+// IsNoUpdateKind reports whether kind marks an issue with no update in the
+// window, whether or not it had any comments
+func IsNoUpdateKind(kind NoteKind) bool {
+	return kind == NoteNoUpdatesInWindow || kind == NoteNoCommentsInWindow
+}
+
+// CountStaleItems returns the number of distinct issues with no update in the
+// window: those with a no-update note or a Needs Update row
+func CountStaleItems(rows []Row, notes []Note) int {
+	stale := make(map[string]bool)
+	for _, note := range notes {
+		if IsNoUpdateKind(note.Kind) {
+			stale[note.IssueURL] = true
+		}
+	}
+	for _, row := range rows {
+		if row.StatusCaption == derive.NeedsUpdate.Caption {
+			stale[row.EpicURL] = true
+		}
+	}
+	return len(stale)
+}
+
 // FilterNotesByKind returns only notes of the specified kind
 func FilterNotesByKind(notes []Note, kind NoteKind) []Note {
 	var filtered []Note
@@ -170,6 +335,17 @@ func FilterNotesByKind(notes []Note, kind NoteKind) []Note {
 	return filtered
 }
 
+// ExcludeNotesByKind returns the notes that are not of the specified kind
+func ExcludeNotesByKind(notes []Note, kind NoteKind) []Note {
+	var filtered []Note
+	for _, note := range notes {
+		if note.Kind != kind {
+			filtered = append(filtered, note)
+		}
+	}
+	return filtered
+}
+
 // CountNotesByKind returns the count of notes of the specified kind
 func CountNotesByKind(notes []Note, kind NoteKind) int {
 	count := 0
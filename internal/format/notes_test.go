@@ -3,9 +3,13 @@ package format
 import (
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/derive"
 )
 
 func TestRenderNotes(t *testing.T) {
+	overdueTarget := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
 	tests := []struct {
 		name     string
 		notes    []Note
@@ -27,7 +31,8 @@ func TestRenderNotes(t *testing.T) {
 			},
 			expected: `## Notes
 
-- https://github.com/owner/repo/issues/123: multiple structured updates in last 7 days
+- **Multiple updates**
+  - https://github.com/owner/repo/issues/123: multiple structured updates in last 7 days
 `,
 		},
 		{
@@ -41,7 +46,8 @@ func TestRenderNotes(t *testing.T) {
 			},
 			expected: `## Notes
 
-- https://github.com/owner/repo/issues/456: no update in last 14 days
+- **No recent updates**
+  - https://github.com/owner/repo/issues/456: no update in last 14 days
 `,
 		},
 		{
@@ -65,9 +71,11 @@ func TestRenderNotes(t *testing.T) {
 			},
 			expected: `## Notes
 
-- https://github.com/owner/repo/issues/123: multiple structured updates in last 7 days
-- https://github.com/owner/repo/issues/456: no update in last 14 days
-- https://github.com/owner/repo/issues/789: multiple structured updates in last 3 days
+- **Multiple updates**
+  - https://github.com/owner/repo/issues/123: multiple structured updates in last 7 days
+  - https://github.com/owner/repo/issues/789: multiple structured updates in last 3 days
+- **No recent updates**
+  - https://github.com/owner/repo/issues/456: no update in last 14 days
 `,
 		},
 		{
@@ -86,8 +94,10 @@ func TestRenderNotes(t *testing.T) {
 			},
 			expected: `## Notes
 
-- https://github.com/owner/repo/issues/1: no update in last 1 day
-- https://github.com/owner/repo/issues/2: multiple structured updates in last 1 day
+- **No recent updates**
+  - https://github.com/owner/repo/issues/1: no update in last 1 day
+- **Multiple updates**
+  - https://github.com/owner/repo/issues/2: multiple structured updates in last 1 day
 `,
 		},
 		{
@@ -98,7 +108,7 @@ func TestRenderNotes(t *testing.T) {
 					IssueURL: "https://github.com/owner/repo/issues/42",
 				},
 			},
-			expected: "## Notes\n\n- https://github.com/owner/repo/issues/42: no structured update found \u2014 summary derived from most recent comment\n",
+			expected: "## Notes\n\n- **Unstructured updates**\n  - https://github.com/owner/repo/issues/42: no structured update found \u2014 summary derived from most recent comment\n",
 		},
 		{
 			name: "mixed notes including unstructured fallback",
@@ -120,9 +130,12 @@ func TestRenderNotes(t *testing.T) {
 			},
 			expected: `## Notes
 
-- https://github.com/owner/repo/issues/1: multiple structured updates in last 7 days
-- https://github.com/owner/repo/issues/2: no structured update found` + " \u2014 " + `summary derived from most recent comment
-- https://github.com/owner/repo/issues/3: no update in last 14 days
+- **Multiple updates**
+  - https://github.com/owner/repo/issues/1: multiple structured updates in last 7 days
+- **Unstructured updates**
+  - https://github.com/owner/repo/issues/2: no structured update found` + " \u2014 " + `summary derived from most recent comment
+- **No recent updates**
+  - https://github.com/owner/repo/issues/3: no update in last 14 days
 `,
 		},
 		{
@@ -136,7 +149,7 @@ func TestRenderNotes(t *testing.T) {
 					Explanation:     "Update mentions two unresolved blockers.",
 				},
 			},
-			expected: "## Notes\n\n- https://github.com/owner/repo/issues/99: reported as On Track, but sentiment suggests At Risk \u2014 Update mentions two unresolved blockers.\n",
+			expected: "## Notes\n\n- **Sentiment mismatches**\n  - https://github.com/owner/repo/issues/99: reported as On Track, but sentiment suggests At Risk \u2014 Update mentions two unresolved blockers.\n",
 		},
 		{
 			name: "mixed notes including sentiment mismatch",
@@ -154,7 +167,7 @@ func TestRenderNotes(t *testing.T) {
 					Explanation:     "Blocked on upstream dependency.",
 				},
 			},
-			expected: "## Notes\n\n- https://github.com/owner/repo/issues/1: multiple structured updates in last 7 days\n- https://github.com/owner/repo/issues/2: reported as On Track, but sentiment suggests Off Track \u2014 Blocked on upstream dependency.\n",
+			expected: "## Notes\n\n- **Multiple updates**\n  - https://github.com/owner/repo/issues/1: multiple structured updates in last 7 days\n- **Sentiment mismatches**\n  - https://github.com/owner/repo/issues/2: reported as On Track, but sentiment suggests Off Track \u2014 Blocked on upstream dependency.\n",
 		},
 		{
 			name: "new issue shaping note",
@@ -164,7 +177,7 @@ func TestRenderNotes(t *testing.T) {
 					IssueURL: "https://github.com/owner/repo/issues/77",
 				},
 			},
-			expected: "## Notes\n\n- https://github.com/owner/repo/issues/77: new issue \u2014 still being shaped\n",
+			expected: "## Notes\n\n- **New issues**\n  - https://github.com/owner/repo/issues/77: new issue \u2014 still being shaped\n",
 		},
 		{
 			name: "mixed notes including new issue shaping",
@@ -179,7 +192,7 @@ func TestRenderNotes(t *testing.T) {
 					IssueURL: "https://github.com/owner/repo/issues/2",
 				},
 			},
-			expected: "## Notes\n\n- https://github.com/owner/repo/issues/1: no update in last 7 days\n- https://github.com/owner/repo/issues/2: new issue \u2014 still being shaped\n",
+			expected: "## Notes\n\n- **No recent updates**\n  - https://github.com/owner/repo/issues/1: no update in last 7 days\n- **New issues**\n  - https://github.com/owner/repo/issues/2: new issue \u2014 still being shaped\n",
 		},
 		{
 			name: "semi-structured fallback note",
@@ -189,7 +202,7 @@ func TestRenderNotes(t *testing.T) {
 					IssueURL: "https://github.com/owner/repo/issues/88",
 				},
 			},
-			expected: "## Notes\n\n- https://github.com/owner/repo/issues/88: status derived from markdown-formatted comment (not structured report)\n",
+			expected: "## Notes\n\n- **Markdown-formatted updates**\n  - https://github.com/owner/repo/issues/88: status derived from markdown-formatted comment (not structured report)\n",
 		},
 		{
 			name: "label fallback note",
@@ -199,7 +212,53 @@ func TestRenderNotes(t *testing.T) {
 					IssueURL: "https://github.com/owner/repo/issues/99",
 				},
 			},
-			expected: "## Notes\n\n- https://github.com/owner/repo/issues/99: status derived from issue label\n",
+			expected: "## Notes\n\n- **Status from labels**\n  - https://github.com/owner/repo/issues/99: status derived from issue label\n",
+		},
+		{
+			name: "no updates note with last update age",
+			notes: []Note{
+				{
+					Kind:      NoteNoUpdatesInWindow,
+					IssueURL:  "https://github.com/owner/repo/issues/6",
+					SinceDays: 7,
+					DaysAgo:   23,
+				},
+			},
+			expected: "## Notes\n\n- **No recent updates**\n  - https://github.com/owner/repo/issues/6: no update in last 7 days (last update 23 days ago)\n",
+		},
+		{
+			name: "overdue target note",
+			notes: []Note{
+				{
+					Kind:       NoteOverdueTarget,
+					IssueURL:   "https://github.com/owner/repo/issues/7",
+					TargetDate: &overdueTarget,
+					DaysAgo:    12,
+				},
+			},
+			expected: "## Notes\n\n- **Overdue targets**\n  - https://github.com/owner/repo/issues/7: target date 2025-08-01 passed 12 days ago\n",
+		},
+		{
+			name:     "skipped inaccessible note",
+			notes:    []Note{{Kind: NoteSkippedInaccessible, Count: 3}},
+			expected: "## Notes\n\n- **Skipped items**\n  - 3 items skipped due to access (private repository or missing issue)\n",
+		},
+		{
+			name: "target date changed note",
+			notes: []Note{
+				{
+					Kind:               NoteTargetDateChanged,
+					IssueURL:           "https://github.com/owner/repo/issues/8",
+					TargetDate:         &overdueTarget,
+					PreviousTargetDate: "2025-07-15",
+				},
+			},
+			expected: "## Notes\n\n- **Target date changes**\n  - https://github.com/owner/repo/issues/8: target date changed from 2025-07-15 to 2025-08-01\n",
+		},
+		{
+			name:     "single skipped inaccessible item",
+			notes:    []Note{{Kind: NoteSkippedInaccessible, Count: 1}},
+			expected: "## Notes\n\n- **Skipped items**\n  - 1 item skipped due to access (private repository or missing issue)\n",
 		},
 		{
 			name: "mixed notes including all fallback types",
@@ -217,7 +276,7 @@ func TestRenderNotes(t *testing.T) {
 					IssueURL: "https://github.com/owner/repo/issues/3",
 				},
 			},
-			expected: "## Notes\n\n- https://github.com/owner/repo/issues/1: status derived from markdown-formatted comment (not structured report)\n- https://github.com/owner/repo/issues/2: status derived from issue label\n- https://github.com/owner/repo/issues/3: no structured update found \u2014 summary derived from most recent comment\n",
+			expected: "## Notes\n\n- **Markdown-formatted updates**\n  - https://github.com/owner/repo/issues/1: status derived from markdown-formatted comment (not structured report)\n- **Status from labels**\n  - https://github.com/owner/repo/issues/2: status derived from issue label\n- **Unstructured updates**\n  - https://github.com/owner/repo/issues/3: no structured update found \u2014 summary derived from most recent comment\n",
 		},
 	}
 
@@ -605,9 +664,9 @@ func TestNotesStructureAndFormat(t *testing.T) {
 	result := RenderNotes(notes)
 	lines := strings.Split(strings.TrimSpace(result), "\n")
 
-	// Should have header + empty line + 2 bullet points = 4 lines
-	if len(lines) != 4 {
-		t.Errorf("Expected 4 lines in notes output, got %d", len(lines))
+	// Should have header + empty line + 2 groups of one note each = 6 lines
+	if len(lines) != 6 {
+		t.Fatalf("Expected 6 lines in notes output, got %d", len(lines))
 	}
 
 	// Check header
@@ -620,27 +679,61 @@ func TestNotesStructureAndFormat(t *testing.T) {
 		t.Errorf("Expected empty line after header, got %q", lines[1])
 	}
 
-	// Check bullet points start with "- "
+	// Group headings are top-level bullets; notes are indented sub-bullets
 	for i, line := range lines[2:] {
-		if !strings.HasPrefix(line, "- ") {
-			t.Errorf("Line %d should start with '- ', got %q", i+2, line)
+		prefix := "- **"
+		if i%2 == 1 {
+			prefix = "  - "
+		}
+		if !strings.HasPrefix(line, prefix) {
+			t.Errorf("Line %d should start with %q, got %q", i+2, prefix, line)
 		}
 	}
 
 	// Check content format
-	expectedPatterns := []string{
-		"multiple structured updates in last 7 days",
-		"no update in last 14 days",
+	expectedPatterns := map[int]string{
+		3: "multiple structured updates in last 7 days",
+		5: "no update in last 14 days",
 	}
 
 	for i, pattern := range expectedPatterns {
-		if !strings.Contains(lines[i+2], pattern) {
+		if !strings.Contains(lines[i], pattern) {
 			t.Errorf("Line %d should contain %q, got %q",
-				i+2, pattern, lines[i+2])
+				i, pattern, lines[i])
 		}
 	}
 }
 
+func TestRenderNotes_GroupsByKind(t *testing.T) {
+	notes := []Note{
+		{Kind: NoteUnknownStatus, IssueURL: "https://github.com/owner/repo/issues/1", ReportedStatus: "purple-ish"},
+		{Kind: NoteMultipleUpdates, IssueURL: "https://github.com/owner/repo/issues/2", SinceDays: 7},
+		{Kind: NoteUnknownStatus, IssueURL: "https://github.com/owner/repo/issues/3"},
+		{Kind: NoteMultipleUpdates, IssueURL: "https://github.com/owner/repo/issues/4", SinceDays: 7},
+	}
+
+	expected := `## Notes
+
+- **Unknown status**
+  - https://github.com/owner/repo/issues/1: trending value "purple-ish" doesn't map to a known status
+  - https://github.com/owner/repo/issues/3: report has no trending value — status unknown
+- **Multiple updates**
+  - https://github.com/owner/repo/issues/2: multiple structured updates in last 7 days
+  - https://github.com/owner/repo/issues/4: multiple structured updates in last 7 days
+`
+	if got := RenderNotes(notes); got != expected {
+		t.Errorf("RenderNotes() mismatch\nExpected:\n%s\nGot:\n%s", expected, got)
+	}
+
+	collapsible := RenderNotesCollapsible(notes)
+	if !strings.Contains(collapsible, "<summary>📝 Notes (4)</summary>") {
+		t.Errorf("expected note count in summary, got %q", collapsible)
+	}
+	if !strings.Contains(collapsible, strings.TrimPrefix(expected, "## Notes\n\n")) {
+		t.Errorf("expected grouped notes inside <details>, got %q", collapsible)
+	}
+}
+
 func TestRenderNotesCollapsible_Empty(t *testing.T) {
 	result := RenderNotesCollapsible([]Note{})
 	if result != "" {
@@ -675,10 +768,10 @@ func TestRenderNotesCollapsible_Multiple(t *testing.T) {
 	if !strings.Contains(result, "<summary>📝 Notes (2)</summary>") {
 		t.Errorf("Expected summary with count 2, got %q", result)
 	}
-	if !strings.Contains(result, "- https://github.com/org/repo/issues/1: no update in last 7 days") {
+	if !strings.Contains(result, "  - https://github.com/org/repo/issues/1: no update in last 7 days") {
 		t.Errorf("Expected first bullet, got %q", result)
 	}
-	if !strings.Contains(result, "- https://github.com/org/repo/issues/2: new issue — still being shaped") {
+	if !strings.Contains(result, "  - https://github.com/org/repo/issues/2: new issue — still being shaped") {
 		t.Errorf("Expected second bullet, got %q", result)
 	}
 }
@@ -716,6 +809,50 @@ func TestRenderNoteBullet_DiffKinds(t *testing.T) {
 			},
 			expected: "https://github.com/owner/repo/issues/3: status changed from At Risk to On Track",
 		},
+		{
+			name: "NoteUnknownStatus",
+			note: Note{
+				Kind:           NoteUnknownStatus,
+				IssueURL:       "https://github.com/owner/repo/issues/4",
+				ReportedStatus: "🔵 vibes",
+			},
+			expected: `https://github.com/owner/repo/issues/4: trending value "🔵 vibes" doesn't map to a known status`,
+		},
+		{
+			name: "NoteUnknownStatus without trending",
+			note: Note{
+				Kind:     NoteUnknownStatus,
+				IssueURL: "https://github.com/owner/repo/issues/5",
+			},
+			expected: "https://github.com/owner/repo/issues/5: report has no trending value — status unknown",
+		},
+		{
+			name: "NoteNoCommentsInWindow",
+			note: Note{
+				Kind:      NoteNoCommentsInWindow,
+				IssueURL:  "https://github.com/owner/repo/issues/6",
+				SinceDays: 7,
+			},
+			expected: "https://github.com/owner/repo/issues/6: no comments in last 7 days",
+		},
+		{
+			name: "NoteNoCommentsInWindow with last update",
+			note: Note{
+				Kind:      NoteNoCommentsInWindow,
+				IssueURL:  "https://github.com/owner/repo/issues/7",
+				SinceDays: 7,
+				DaysAgo:   1,
+			},
+			expected: "https://github.com/owner/repo/issues/7: no comments in last 7 days (last update 1 day ago)",
+		},
+		{
+			name: "NoteMissingTargetDate",
+			note: Note{
+				Kind:     NoteMissingTargetDate,
+				IssueURL: "https://github.com/owner/repo/issues/8",
+			},
+			expected: "https://github.com/owner/repo/issues/8: no target date set",
+		},
 	}
 
 	for _, tc := range tests {
@@ -727,3 +864,138 @@ func TestRenderNoteBullet_DiffKinds(t *testing.T) {
 		})
 	}
 }
+
+func TestCountStaleItems(t *testing.T) {
+	stale := NewRow(derive.NeedsUpdate, "Stale", "https://github.com/owner/repo/issues/1", nil, "")
+	fresh := NewRow(derive.OnTrack, "Fresh", "https://github.com/owner/repo/issues/2", nil, "")
+
+	tests := []struct {
+		name     string
+		rows     []Row
+		notes    []Note
+		expected int
+	}{
+		{name: "nothing stale", rows: []Row{fresh}, expected: 0},
+		{
+			name:     "unrelated notes",
+			rows:     []Row{fresh},
+			notes:    []Note{{Kind: NoteMultipleUpdates, IssueURL: fresh.EpicURL}},
+			expected: 0,
+		},
+		{
+			name:     "no updates note",
+			rows:     []Row{fresh},
+			notes:    []Note{{Kind: NoteNoUpdatesInWindow, IssueURL: "https://github.com/owner/repo/issues/3", SinceDays: 7}},
+			expected: 1,
+		},
+		{
+			name:     "no comments note",
+			rows:     []Row{fresh},
+			notes:    []Note{{Kind: NoteNoCommentsInWindow, IssueURL: "https://github.com/owner/repo/issues/4", SinceDays: 7}},
+			expected: 1,
+		},
+		{name: "needs update row", rows: []Row{stale, fresh}, expected: 1},
+		{
+			name:     "note and row for the same issue count once",
+			rows:     []Row{stale},
+			notes:    []Note{{Kind: NoteNoUpdatesInWindow, IssueURL: stale.EpicURL, SinceDays: 7}},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CountStaleItems(tt.rows, tt.notes); got != tt.expected {
+				t.Errorf("CountStaleItems() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRenderNotes_NoCommentsAndNoUpdatesGroupSeparately(t *testing.T) {
+	notes := []Note{
+		{Kind: NoteNoUpdatesInWindow, IssueURL: "https://github.com/owner/repo/issues/1", SinceDays: 7},
+		{Kind: NoteNoCommentsInWindow, IssueURL: "https://github.com/owner/repo/issues/2", SinceDays: 7},
+	}
+
+	expected := "## Notes\n\n" +
+		"- **No recent updates**\n" +
+		"  - https://github.com/owner/repo/issues/1: no update in last 7 days\n" +
+		"- **No recent comments**\n" +
+		"  - https://github.com/owner/repo/issues/2: no comments in last 7 days\n"
+	if got := RenderNotes(notes); got != expected {
+		t.Errorf("Expected %q\nGot %q", expected, got)
+	}
+}
+
+func TestExcludeNotesByKind(t *testing.T) {
+	notes := []Note{
+		{Kind: NoteMultipleUpdates, IssueURL: "url1"},
+		{Kind: NoteNoUpdatesInWindow, IssueURL: "url2"},
+		{Kind: NoteMultipleUpdates, IssueURL: "url3"},
+	}
+
+	kept := ExcludeNotesByKind(notes, NoteMultipleUpdates)
+	if len(kept) != 1 || kept[0].IssueURL != "url2" {
+		t.Errorf("expected only the no-updates note, got %+v", kept)
+	}
+	if len(ExcludeNotesByKind(nil, NoteMultipleUpdates)) != 0 {
+		t.Error("expected no notes from nil input")
+	}
+}
+
+func TestRenderNoteBullet_LinkedTitle(t *testing.T) {
+	tests := []struct {
+		name     string
+		note     Note
+		expected string
+	}{
+		{
+			name:     "no updates, plural days",
+			note:     Note{Kind: NoteNoUpdatesInWindow, IssueURL: "https://github.com/o/r/issues/1", IssueTitle: "User Auth", SinceDays: 7},
+			expected: "[User Auth](https://github.com/o/r/issues/1): no update in last 7 days",
+		},
+		{
+			name:     "no updates, single day with last update",
+			note:     Note{Kind: NoteNoUpdatesInWindow, IssueURL: "https://github.com/o/r/issues/1", IssueTitle: "User Auth", SinceDays: 1, DaysAgo: 1},
+			expected: "[User Auth](https://github.com/o/r/issues/1): no update in last 1 day (last update 1 day ago)",
+		},
+		{
+			name:     "brackets in the title are escaped",
+			note:     Note{Kind: NoteMultipleUpdates, IssueURL: "https://github.com/o/r/issues/2", IssueTitle: "[Epic] Payments", SinceDays: 14},
+			expected: `[\[Epic\] Payments](https://github.com/o/r/issues/2): multiple structured updates in last 14 days`,
+		},
+		{
+			name:     "no title falls back to the URL",
+			note:     Note{Kind: NoteNewIssueShaping, IssueURL: "https://github.com/o/r/issues/3"},
+			expected: "https://github.com/o/r/issues/3: new issue — still being shaped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderNoteBullet(tt.note); got != tt.expected {
+				t.Errorf("renderNoteBullet() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRenderNotesWithOptions_Plain(t *testing.T) {
+	notes := []Note{
+		{Kind: NoteNoUpdatesInWindow, IssueURL: "https://github.com/o/r/issues/1", IssueTitle: "User Auth", SinceDays: 7},
+	}
+
+	linked := RenderNotesWithOptions(notes, NotesOptions{})
+	if !strings.Contains(linked, "  - [User Auth](https://github.com/o/r/issues/1): no update in last 7 days\n") {
+		t.Errorf("expected a linked title, got:\n%s", linked)
+	}
+
+	plain := RenderNotesWithOptions(notes, NotesOptions{Plain: true, Collapsible: true})
+	if !strings.Contains(plain, "  - https://github.com/o/r/issues/1: no update in last 7 days\n") || strings.Contains(plain, "User Auth") {
+		t.Errorf("expected the bare URL only, got:\n%s", plain)
+	}
+	if !strings.HasPrefix(plain, "<details>") {
+		t.Errorf("expected the collapsible layout, got:\n%s", plain)
+	}
+}
@@ -0,0 +1,38 @@
+package format
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// StatusFileName returns the file name used for a status group when splitting
+// output by status, e.g. "On Track" → "on-track.md".
+func StatusFileName(caption string) string {
+	slug := strings.ToLower(strings.Join(strings.Fields(caption), "-"))
+	if slug == "" {
+		slug = "other"
+	}
+	return slug + ".md"
+}
+
+// WriteStatusFiles groups rows by status and writes each group's table to its
+// own file in dir. Statuses with no rows produce no file. It returns the paths
+// written, in status order.
+func WriteStatusFiles(dir string, rows []Row, opts TableOptions) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	var written []string
+	for _, group := range GroupRows(rows, GroupConfig{Mode: GroupByStatus}) {
+		path := filepath.Join(dir, StatusFileName(group.Title))
+		content := RenderTableWithTitle(group.Title, group.Rows, opts)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return written, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		written = append(written, path)
+	}
+	return written, nil
+}
@@ -0,0 +1,67 @@
+package format
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStatusFileName(t *testing.T) {
+	tests := []struct {
+		caption  string
+		expected string
+	}{
+		{"On Track", "on-track.md"},
+		{"At Risk", "at-risk.md"},
+		{"Needs Update", "needs-update.md"},
+		{"Done", "done.md"},
+		{"", "other.md"},
+	}
+
+	for _, tt := range tests {
+		if got := StatusFileName(tt.caption); got != tt.expected {
+			t.Errorf("StatusFileName(%q) = %q, expected %q", tt.caption, got, tt.expected)
+		}
+	}
+}
+
+func TestWriteStatusFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "reports")
+	rows := []Row{
+		{StatusEmoji: ":green_circle:", StatusCaption: "On Track", EpicTitle: "Alpha", EpicURL: "https://github.com/o/r/issues/1", UpdateMD: "fine"},
+		{StatusEmoji: ":yellow_circle:", StatusCaption: "At Risk", EpicTitle: "Beta", EpicURL: "https://github.com/o/r/issues/2", UpdateMD: "slipping"},
+		{StatusEmoji: ":green_circle:", StatusCaption: "On Track", EpicTitle: "Gamma", EpicURL: "https://github.com/o/r/issues/3", UpdateMD: "fine too"},
+	}
+
+	written, err := WriteStatusFiles(dir, rows, TableOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantFiles := []string{filepath.Join(dir, "on-track.md"), filepath.Join(dir, "at-risk.md")}
+	if strings.Join(written, ",") != strings.Join(wantFiles, ",") {
+		t.Errorf("written = %v, expected %v", written, wantFiles)
+	}
+
+	onTrack, err := os.ReadFile(filepath.Join(dir, "on-track.md"))
+	if err != nil {
+		t.Fatalf("failed to read on-track.md: %v", err)
+	}
+	if !strings.HasPrefix(string(onTrack), "# On Track\n\n| Status |") {
+		t.Errorf("expected titled table, got:\n%s", onTrack)
+	}
+	if !strings.Contains(string(onTrack), "[Alpha]") || !strings.Contains(string(onTrack), "[Gamma]") {
+		t.Errorf("expected both on-track rows, got:\n%s", onTrack)
+	}
+	if strings.Contains(string(onTrack), "[Beta]") {
+		t.Errorf("expected at-risk row to be excluded, got:\n%s", onTrack)
+	}
+
+	// Statuses without rows produce no file
+	for _, name := range []string{"off-track.md", "done.md", "needs-update.md"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); !os.IsNotExist(err) {
+			t.Errorf("expected no %s for an empty group", name)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package format
+
+import (
+	"fmt"
+	"time"
+)
+
+// ISOWeekLabel returns the ISO 8601 week label for t, e.g. "2025-W32".
+// The year is the ISO week-numbering year, which can differ from the
+// calendar year for days at the start or end of January/December.
+func ISOWeekLabel(t time.Time) string {
+	year, week := t.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// WeekDateRange renders the window from since to now as a short human range,
+// e.g. "Aug 4–Aug 10". Years are included only when the window spans two years.
+func WeekDateRange(since, now time.Time) string {
+	if since.Year() != now.Year() {
+		return fmt.Sprintf("%s–%s", since.Format("Jan 2, 2006"), now.Format("Jan 2, 2006"))
+	}
+	return fmt.Sprintf("%s–%s", since.Format("Jan 2"), now.Format("Jan 2"))
+}
+
+// WeeklyReportTitle builds the auto-generated report title for the window,
+// labelled with the ISO week that now falls in,
+// e.g. "Weekly Report — 2025-W32 (Aug 4–Aug 10)". A non-empty project title
+// is included after the prefix: "Weekly Report — Roadmap — 2025-W32 (...)".
+func WeeklyReportTitle(project string, since, now time.Time) string {
+	prefix := "Weekly Report"
+	if project != "" {
+		prefix += " — " + project
+	}
+	return fmt.Sprintf("%s — %s (%s)", prefix, ISOWeekLabel(now), WeekDateRange(since, now))
+}
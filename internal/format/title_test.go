@@ -0,0 +1,71 @@
+package format
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeeklyReportTitle(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		project  string
+		since    time.Time
+		now      time.Time
+		expected string
+	}{
+		{
+			name:     "week within a month",
+			since:    date(2025, 8, 4),
+			now:      date(2025, 8, 10),
+			expected: "Weekly Report — 2025-W32 (Aug 4–Aug 10)",
+		},
+		{
+			name:     "week crossing into a new month",
+			since:    date(2025, 7, 28),
+			now:      date(2025, 8, 3),
+			expected: "Weekly Report — 2025-W31 (Jul 28–Aug 3)",
+		},
+		{
+			name:     "week crossing into a new year",
+			since:    date(2025, 12, 29),
+			now:      date(2026, 1, 4),
+			expected: "Weekly Report — 2026-W01 (Dec 29, 2025–Jan 4, 2026)",
+		},
+		{
+			name:     "with project title",
+			project:  "Platform Roadmap",
+			since:    date(2025, 8, 4),
+			now:      date(2025, 8, 10),
+			expected: "Weekly Report — Platform Roadmap — 2025-W32 (Aug 4–Aug 10)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeeklyReportTitle(tt.project, tt.since, tt.now); got != tt.expected {
+				t.Errorf("WeeklyReportTitle() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestISOWeekLabel(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected string
+	}{
+		{time.Date(2025, 8, 6, 0, 0, 0, 0, time.UTC), "2025-W32"},
+		{time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC), "2026-W01"},
+		{time.Date(2021, 1, 3, 0, 0, 0, 0, time.UTC), "2020-W53"},
+	}
+
+	for _, tt := range tests {
+		if got := ISOWeekLabel(tt.date); got != tt.expected {
+			t.Errorf("ISOWeekLabel(%s) = %q, expected %q", tt.date.Format("2006-01-02"), got, tt.expected)
+		}
+	}
+}
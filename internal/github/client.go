@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/go-github/v66/github"
 	"golang.org/x/oauth2"
 
+	"github.com/Attamusc/weekly-report-cli/internal/input"
 	"github.com/Attamusc/weekly-report-cli/internal/retry"
 )
 
@@ -20,8 +22,12 @@ const (
 	requestTimeoutSec = 30   // 30 second timeout per request
 )
 
-// New creates a new GitHub client with OAuth2 authentication and retry logic
-func New(ctx context.Context, token string) *github.Client {
+// New creates a new GitHub client with OAuth2 authentication and retry logic.
+// Requests are sent through base, or http.DefaultTransport when base is nil.
+func New(ctx context.Context, token string, base http.RoundTripper) *github.Client {
+	if base == nil {
+		base = http.DefaultTransport
+	}
 	// Create OAuth2 token source
 	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
 
@@ -31,7 +37,7 @@ func New(ctx context.Context, token string) *github.Client {
 		Transport: &retryTransport{
 			base: &oauth2.Transport{
 				Source: ts,
-				Base:   http.DefaultTransport,
+				Base:   base,
 			},
 		},
 	}
@@ -43,6 +49,60 @@ func New(ctx context.Context, token string) *github.Client {
 	return client
 }
 
+// NewForHost creates a client like New for a GitHub Enterprise Server host
+// (e.g. "github.example.com"). An empty host or github.com returns New's client.
+func NewForHost(ctx context.Context, host, token string, base http.RoundTripper) (*github.Client, error) {
+	client := New(ctx, token, base)
+	if host == "" || strings.EqualFold(host, "github.com") {
+		return client, nil
+	}
+
+	apiURL := "https://" + host + "/api/v3/"
+	client, err := client.WithEnterpriseURLs(apiURL, "https://"+host+"/api/uploads/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub host %q: %w", host, err)
+	}
+	return client, nil
+}
+
+// Clients routes issue refs to the client for the host they live on: the
+// github.com client for refs without a Host, otherwise the client created for
+// that GitHub Enterprise Server host
+type Clients struct {
+	Default *github.Client
+	hosts   map[string]*github.Client // By lowercased host
+}
+
+// NewClients builds the github.com client for token plus one client per host
+// in hostTokens (lowercased host to token), all sending through base
+func NewClients(ctx context.Context, token string, hostTokens map[string]string, base http.RoundTripper) (*Clients, error) {
+	clients := &Clients{
+		Default: New(ctx, token, base),
+		hosts:   make(map[string]*github.Client, len(hostTokens)),
+	}
+	for host, hostToken := range hostTokens {
+		client, err := NewForHost(ctx, host, hostToken, base)
+		if err != nil {
+			return nil, err
+		}
+		clients.hosts[strings.ToLower(host)] = client
+	}
+	return clients, nil
+}
+
+// For returns the client for the host ref lives on. A host without a client
+// is an error rather than a silent fallback to github.com.
+func (c *Clients) For(ref input.IssueRef) (*github.Client, error) {
+	if ref.Host == "" || strings.EqualFold(ref.Host, "github.com") {
+		return c.Default, nil
+	}
+	client, ok := c.hosts[strings.ToLower(ref.Host)]
+	if !ok {
+		return nil, fmt.Errorf("no GitHub client for host %s (list it with --github-hosts)", ref.Host)
+	}
+	return client, nil
+}
+
 // retryTransport wraps http.RoundTripper with retry logic for GitHub API
 type retryTransport struct {
 	base http.RoundTripper
@@ -60,9 +120,15 @@ func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		resp, err := rt.base.RoundTrip(reqClone)
 		if err != nil {
 			lastErr = err
+			// Don't retry once the caller has given up (e.g. the run deadline passed)
+			if req.Context().Err() != nil {
+				return nil, err
+			}
 			if attempt < maxRetries {
 				backoffDuration := retry.CalculateBackoff(attempt, baseBackoffMs)
-				time.Sleep(backoffDuration)
+				if err := sleepContext(req.Context(), backoffDuration); err != nil {
+					return nil, err
+				}
 			}
 			continue
 		}
@@ -82,7 +148,9 @@ func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 					_ = resp.Body.Close()
 
 					if attempt < maxRetries {
-						time.Sleep(retryAfter)
+						if err := sleepContext(req.Context(), retryAfter); err != nil {
+							return nil, err
+						}
 						continue
 					}
 				}
@@ -93,7 +161,9 @@ func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 				_ = resp.Body.Close()
 				if attempt < maxRetries {
 					backoffDuration := retry.CalculateBackoff(attempt, baseBackoffMs)
-					time.Sleep(backoffDuration)
+					if err := sleepContext(req.Context(), backoffDuration); err != nil {
+						return nil, err
+					}
 					continue
 				}
 			}
@@ -107,6 +177,17 @@ func (rt *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return nil, fmt.Errorf("GitHub API request failed after %d attempts: %w", maxRetries+1, lastErr)
 }
 
+// sleepContext waits for d or until ctx is done, whichever comes first.
+// Returns ctx.Err() if the context was cancelled while waiting.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
 // shouldRetry determines if a response should be retried
 func shouldRetry(resp *http.Response) bool {
 	// Retry on 5xx server errors
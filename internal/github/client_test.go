@@ -0,0 +1,74 @@
+package github
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+)
+
+func TestNewForHost(t *testing.T) {
+	tests := []struct {
+		host        string
+		wantAPI     string
+		wantGraphQL string
+	}{
+		{host: "", wantAPI: "https://api.github.com/", wantGraphQL: "https://api.github.com/graphql"},
+		{host: "github.com", wantAPI: "https://api.github.com/", wantGraphQL: "https://api.github.com/graphql"},
+		{host: "github.example.com", wantAPI: "https://github.example.com/api/v3/", wantGraphQL: "https://github.example.com/api/graphql"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			client, err := NewForHost(context.Background(), tt.host, "token", nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := client.BaseURL.String(); got != tt.wantAPI {
+				t.Errorf("got base URL %q, want %q", got, tt.wantAPI)
+			}
+
+			req, err := client.NewRequest("POST", graphqlPath, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := req.URL.String(); got != tt.wantGraphQL {
+				t.Errorf("got GraphQL URL %q, want %q", got, tt.wantGraphQL)
+			}
+		})
+	}
+}
+
+func TestClients_For(t *testing.T) {
+	clients, err := NewClients(context.Background(), "token", map[string]string{"github.example.com": "ghes-token"}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		host    string
+		wantAPI string
+	}{
+		{name: "github.com ref", host: "", wantAPI: "https://api.github.com/"},
+		{name: "explicit github.com host", host: "github.com", wantAPI: "https://api.github.com/"},
+		{name: "enterprise ref", host: "github.example.com", wantAPI: "https://github.example.com/api/v3/"},
+		{name: "enterprise host in other case", host: "GitHub.Example.com", wantAPI: "https://github.example.com/api/v3/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := clients.For(input.IssueRef{Host: tt.host, Owner: "o", Repo: "r", Number: 1})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := client.BaseURL.String(); got != tt.wantAPI {
+				t.Errorf("got base URL %q, want %q", got, tt.wantAPI)
+			}
+		})
+	}
+
+	if _, err := clients.For(input.IssueRef{Host: "unknown.example.com", Owner: "o", Repo: "r", Number: 1}); err == nil {
+		t.Error("expected an error for a host without a client instead of falling back to github.com")
+	}
+}
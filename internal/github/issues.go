@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -21,6 +22,20 @@ const (
 // Default close reason message
 const defaultCloseReason = "Issue was closed"
 
+// ErrInaccessible indicates the token can't read an issue (403 or 404), e.g. a
+// project item from a private repository
+var ErrInaccessible = errors.New("issue inaccessible")
+
+// accessError keeps the descriptive message from enhanceGitHubError while
+// matching ErrInaccessible with errors.Is
+type accessError struct {
+	msg string
+}
+
+func (e *accessError) Error() string { return e.msg }
+
+func (e *accessError) Is(target error) bool { return target == ErrInaccessible }
+
 // IssueData represents GitHub issue metadata
 type IssueData struct {
 	URL         string
@@ -166,7 +181,12 @@ func findClosingComment(ctx context.Context, client *github.Client, ref input.Is
 
 // FetchCommentsSince retrieves issue comments created since the specified time
 // Uses pagination to fetch all comments and filters by CreatedAt
-func FetchCommentsSince(ctx context.Context, client *github.Client, ref input.IssueRef, since time.Time) ([]Comment, error) {
+//
+// maxPages caps the number of pages kept (0 = no cap). The API lists comments
+// oldest-first and can't sort them, so the first page doubles as a probe: when
+// its Link header shows more pages than the cap, its comments are dropped and
+// the last maxPages pages are fetched instead, keeping the newest comments.
+func FetchCommentsSince(ctx context.Context, client *github.Client, ref input.IssueRef, since time.Time, maxPages int) ([]Comment, error) {
 	// Get logger from context if available
 	logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger)
 	if !ok {
@@ -186,7 +206,7 @@ func FetchCommentsSince(ctx context.Context, client *github.Client, ref input.Is
 		},
 	}
 
-	for {
+	for fetched := 1; ; fetched++ {
 		logger.Debug("Fetching comments page", "issue", ref.String(), "page", opts.Page)
 
 		// Fetch page of comments
@@ -204,6 +224,17 @@ func FetchCommentsSince(ctx context.Context, client *github.Client, ref input.Is
 
 		logger.Debug("Comments page fetched", "issue", ref.String(), "page", opts.Page, "count", len(comments))
 
+		// The first page showed there are more pages than the cap: drop it and
+		// restart from the page where the newest maxPages pages begin
+		if opts.Page == 1 && maxPages > 0 && resp.LastPage > maxPages {
+			skipTo := resp.LastPage - maxPages + 1
+			logger.Warn("Comment page cap reached, skipping to newest pages", "issue", ref.String(),
+				"max_pages", maxPages, "from_page", resp.NextPage, "to_page", skipTo)
+			opts.Page = skipTo
+			fetched = 0
+			continue
+		}
+
 		// Convert GitHub comments to our Comment type
 		pageComments := 0
 		for _, comment := range comments {
@@ -227,6 +258,12 @@ func FetchCommentsSince(ctx context.Context, client *github.Client, ref input.Is
 			break
 		}
 
+		// Without a last-page link there is nowhere to skip to, so stop at the cap
+		if maxPages > 0 && fetched >= maxPages {
+			logger.Warn("Comment page cap reached, newer comments skipped", "issue", ref.String(), "max_pages", maxPages)
+			break
+		}
+
 		// Move to next page
 		opts.Page = resp.NextPage
 	}
@@ -235,26 +272,84 @@ func FetchCommentsSince(ctx context.Context, client *github.Client, ref input.Is
 	return allComments, nil
 }
 
+// FetchComment retrieves a single issue comment by ID
+func FetchComment(ctx context.Context, client *github.Client, ref input.IssueRef, commentID int64) (Comment, error) {
+	logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger)
+	if !ok {
+		logger = slog.Default()
+	}
+
+	logger.Debug("Fetching comment", "issue", ref.String(), "comment", commentID)
+
+	comment, _, err := client.Issues.GetComment(ctx, ref.Owner, ref.Repo, commentID)
+	if err != nil {
+		logger.Debug("GitHub API comment fetch failed", "issue", ref.String(), "comment", commentID, "error", err)
+
+		if enhancedErr := enhanceGitHubError(err, ref); enhancedErr != nil {
+			return Comment{}, enhancedErr
+		}
+
+		return Comment{}, fmt.Errorf("failed to fetch comment %d on issue %s: %w", commentID, ref.String(), err)
+	}
+
+	return Comment{
+		Body:      comment.GetBody(),
+		CreatedAt: comment.GetCreatedAt().Time,
+		Author:    comment.GetUser().GetLogin(),
+		URL:       comment.GetHTMLURL(),
+	}, nil
+}
+
+// authFailedMessage is the 401 hint shared by enhanceGitHubError and
+// enhanceTokenError; target names what was accessed ("" for the token itself)
+func authFailedMessage(target string) string {
+	return fmt.Sprintf("GitHub API authentication failed%s. Please check your GITHUB_TOKEN is valid and has the required permissions. Visit https://github.com/settings/tokens to create or update your token", forTarget(target))
+}
+
+// ssoRequiredMessage is the 403 hint for tokens that need SSO authorization
+func ssoRequiredMessage(target string) string {
+	return fmt.Sprintf("GitHub API access denied%s. Your token may require SSO authorization for this organization. Visit: https://github.com/settings/tokens and authorize your token for SSO", forTarget(target))
+}
+
+// permissionDeniedMessage is the generic 403 hint; callers append what the
+// token was missing
+func permissionDeniedMessage(target string) string {
+	return fmt.Sprintf("GitHub API access denied%s. Your token may not have sufficient permissions", forTarget(target))
+}
+
+// isSSODenial reports whether a 403 looks like an SSO authorization issue
+func isSSODenial(ghErr *github.ErrorResponse) bool {
+	message := strings.ToLower(ghErr.Message)
+	return strings.Contains(message, "sso") || strings.Contains(message, "organization")
+}
+
+// forTarget renders " for <target>", or nothing for an empty target
+func forTarget(target string) string {
+	if target == "" {
+		return ""
+	}
+	return " for " + target
+}
+
 // enhanceGitHubError checks for common GitHub API error conditions and provides helpful error messages
 func enhanceGitHubError(err error, ref input.IssueRef) error {
 	// Convert to GitHub ErrorResponse if possible
 	if ghErr, ok := err.(*github.ErrorResponse); ok {
 		switch ghErr.Response.StatusCode {
 		case http.StatusUnauthorized:
-			return fmt.Errorf("GitHub API authentication failed for %s. Please check your GITHUB_TOKEN is valid and has the required permissions", ref.String())
+			return errors.New(authFailedMessage(ref.String()))
 
 		case http.StatusForbidden:
 			// Check if this might be an SSO authorization issue
-			if strings.Contains(strings.ToLower(ghErr.Message), "sso") ||
-				strings.Contains(strings.ToLower(ghErr.Message), "organization") {
-				return fmt.Errorf("GitHub API access denied for %s. Your token may require SSO authorization for this organization. Visit: https://github.com/settings/tokens and authorize your token for SSO", ref.String())
+			if isSSODenial(ghErr) {
+				return &accessError{msg: ssoRequiredMessage(ref.String())}
 			}
 
 			// Generic 403 error
-			return fmt.Errorf("GitHub API access denied for %s. Your token may not have sufficient permissions to access this repository", ref.String())
+			return &accessError{msg: permissionDeniedMessage(ref.String()) + " to access this repository"}
 
 		case http.StatusNotFound:
-			return fmt.Errorf("GitHub issue %s not found. This could mean the repository is private and your token lacks access, or the issue doesn't exist", ref.String())
+			return &accessError{msg: fmt.Sprintf("GitHub issue %s not found. This could mean the repository is private and your token lacks access, or the issue doesn't exist", ref.String())}
 		}
 	}
 
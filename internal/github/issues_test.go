@@ -1,11 +1,17 @@
 package github
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -257,7 +263,7 @@ func TestFetchCommentsSince(t *testing.T) {
 
 	// Fetch comments
 	ctx := context.Background()
-	comments, err := FetchCommentsSince(ctx, client, ref, sinceTime)
+	comments, err := FetchCommentsSince(ctx, client, ref, sinceTime, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -317,6 +323,52 @@ func TestFetchIssue_NotFound(t *testing.T) {
 	}
 }
 
+func TestFetchIssue_ClassifiesInaccessible(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/owner/repo/issues/1":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(github.Issue{Title: github.String("Readable"), State: github.String("open")})
+		case "/repos/owner/private/issues/2":
+			http.Error(w, `{"message":"Not Found"}`, http.StatusNotFound)
+		case "/repos/owner/locked/issues/3":
+			http.Error(w, `{"message":"Resource not accessible by integration"}`, http.StatusForbidden)
+		default:
+			// A validation failure isn't an access problem
+			http.Error(w, `{"message":"Server Error"}`, http.StatusUnprocessableEntity)
+		}
+	}))
+	defer server.Close()
+
+	client := github.NewClient(server.Client())
+	baseURL, _ := url.Parse(server.URL + "/")
+	client.BaseURL = baseURL
+
+	tests := []struct {
+		name             string
+		ref              input.IssueRef
+		wantErr          bool
+		wantInaccessible bool
+	}{
+		{"accessible issue", input.IssueRef{Owner: "owner", Repo: "repo", Number: 1}, false, false},
+		{"404 is inaccessible", input.IssueRef{Owner: "owner", Repo: "private", Number: 2}, true, true},
+		{"403 is inaccessible", input.IssueRef{Owner: "owner", Repo: "locked", Number: 3}, true, true},
+		{"other errors are not", input.IssueRef{Owner: "owner", Repo: "broken", Number: 4}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FetchIssue(context.Background(), client, tt.ref)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got err=%v, wantErr=%v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, ErrInaccessible); got != tt.wantInaccessible {
+				t.Errorf("errors.Is(err, ErrInaccessible) = %v, want %v (err=%v)", got, tt.wantInaccessible, err)
+			}
+		})
+	}
+}
+
 func TestFetchCommentsSince_NoComments(t *testing.T) {
 	// Create test server with empty comments
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -333,7 +385,7 @@ func TestFetchCommentsSince_NoComments(t *testing.T) {
 	sinceTime := time.Now().Add(-24 * time.Hour)
 
 	ctx := context.Background()
-	comments, err := FetchCommentsSince(ctx, client, ref, sinceTime)
+	comments, err := FetchCommentsSince(ctx, client, ref, sinceTime, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -342,3 +394,161 @@ func TestFetchCommentsSince_NoComments(t *testing.T) {
 		t.Errorf("expected 0 comments, got %d", len(comments))
 	}
 }
+
+func TestFetchComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/repos/owner/repo/issues/comments/987" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.IssueComment{
+			Body:    github.String("<!-- data key=\"isReport\" value=\"true\" -->"),
+			User:    &github.User{Login: github.String("author")},
+			HTMLURL: github.String("https://github.com/owner/repo/issues/1#issuecomment-987"),
+		})
+	}))
+	defer server.Close()
+
+	client := github.NewClient(server.Client())
+	baseURL, _ := url.Parse(server.URL + "/")
+	client.BaseURL = baseURL
+
+	ref := input.IssueRef{Owner: "owner", Repo: "repo", Number: 1}
+	comment, err := FetchComment(context.Background(), client, ref, 987)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if comment.Author != "author" || !strings.Contains(comment.Body, "isReport") {
+		t.Errorf("unexpected comment: %+v", comment)
+	}
+
+	if _, err := FetchComment(context.Background(), client, ref, 1); err == nil {
+		t.Error("expected error for missing comment")
+	}
+}
+
+func TestFetchCommentsSince_MaxPages(t *testing.T) {
+	sinceTime := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	const lastPage = 10
+
+	tests := []struct {
+		name      string
+		maxPages  int
+		wantPages []string
+		wantKept  []string
+	}{
+		{name: "no cap walks every page", maxPages: 0, wantPages: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}, wantKept: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}},
+		{name: "cap keeps the newest pages", maxPages: 3, wantPages: []string{"1", "8", "9", "10"}, wantKept: []string{"8", "9", "10"}},
+		{name: "cap of one keeps the last page", maxPages: 1, wantPages: []string{"1", "10"}, wantKept: []string{"10"}},
+		{name: "cap above page count", maxPages: 20, wantPages: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}, wantKept: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pages []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				page := r.URL.Query().Get("page")
+				if page == "" {
+					page = "1"
+				}
+				pages = append(pages, page)
+
+				n, _ := strconv.Atoi(page)
+				if n < lastPage {
+					w.Header().Set("Link", fmt.Sprintf(
+						`</repos/owner/repo/issues/1/comments?page=%d>; rel="next", </repos/owner/repo/issues/1/comments?page=%d>; rel="last"`,
+						n+1, lastPage))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode([]github.IssueComment{{
+					Body:      github.String("Update on page " + page),
+					CreatedAt: &github.Timestamp{Time: sinceTime.Add(time.Duration(n) * time.Hour)},
+				}})
+			}))
+			defer server.Close()
+
+			client := github.NewClient(server.Client())
+			baseURL, _ := url.Parse(server.URL + "/")
+			client.BaseURL = baseURL
+
+			ref := input.IssueRef{Owner: "owner", Repo: "repo", Number: 1}
+			comments, err := FetchCommentsSince(context.Background(), client, ref, sinceTime, tt.maxPages)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if strings.Join(pages, ",") != strings.Join(tt.wantPages, ",") {
+				t.Errorf("requested pages %v, want %v", pages, tt.wantPages)
+			}
+			var kept []string
+			for _, comment := range comments {
+				kept = append(kept, strings.TrimPrefix(comment.Body, "Update on page "))
+			}
+			if strings.Join(kept, ",") != strings.Join(tt.wantKept, ",") {
+				t.Errorf("kept comments from pages %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
+
+func TestFetchIssue_RunDeadlineCancelsSlowRequest(t *testing.T) {
+	// Server that takes far longer than the caller is willing to wait
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// Use the production client so the retry transport is exercised
+	client := New(ctx, "test-token", nil)
+	baseURL, _ := url.Parse(server.URL + "/")
+	client.BaseURL = baseURL
+
+	ref := input.IssueRef{Owner: "owner", Repo: "repo", Number: 1}
+
+	start := time.Now()
+	_, err := FetchIssue(ctx, client, ref)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected error when the run deadline is exceeded")
+	}
+	if elapsed > 2*time.Second {
+		t.Errorf("expected request to be cancelled promptly without retry backoff, took %v", elapsed)
+	}
+}
+
+func TestFetchIssue_UsesContextLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&github.Issue{
+			Title:   github.String("Logged issue"),
+			State:   github.String("open"),
+			HTMLURL: github.String("https://github.com/owner/repo/issues/7"),
+		})
+	}))
+	defer server.Close()
+
+	client := github.NewClient(server.Client())
+	baseURL, _ := url.Parse(server.URL + "/")
+	client.BaseURL = baseURL
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	ctx := context.WithValue(context.Background(), input.LoggerContextKey{}, logger)
+
+	ref := input.IssueRef{Owner: "owner", Repo: "repo", Number: 7}
+	if _, err := FetchIssue(ctx, client, ref); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "Fetching issue metadata") {
+		t.Errorf("expected context logger to receive debug output, got %q", buf.String())
+	}
+}
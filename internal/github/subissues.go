@@ -0,0 +1,141 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+	"github.com/google/go-github/v66/github"
+)
+
+// subIssuesQuery lists the direct sub-issues of one issue, a page at a time
+const subIssuesQuery = `query($owner: String!, $repo: String!, $number: Int!, $cursor: String) {
+  repository(owner: $owner, name: $repo) {
+    issue(number: $number) {
+      subIssues(first: 100, after: $cursor) {
+        nodes {
+          number
+          url
+          repository {
+            name
+            owner { login }
+          }
+        }
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+      }
+    }
+  }
+}`
+
+// graphqlPath is the GraphQL endpoint relative to the client's REST base URL.
+// It resolves to api.github.com/graphql on github.com and, from a GitHub
+// Enterprise Server base of /api/v3/, to /api/graphql.
+const graphqlPath = "../graphql"
+
+// subIssuesRequest is the GraphQL request body for subIssuesQuery
+type subIssuesRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+// subIssuesResponse mirrors the parts of the GraphQL response we read
+type subIssuesResponse struct {
+	Data struct {
+		Repository *struct {
+			Issue *struct {
+				SubIssues struct {
+					Nodes []struct {
+						Number     int    `json:"number"`
+						URL        string `json:"url"`
+						Repository struct {
+							Name  string `json:"name"`
+							Owner struct {
+								Login string `json:"login"`
+							} `json:"owner"`
+						} `json:"repository"`
+					} `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"subIssues"`
+			} `json:"issue"`
+		} `json:"repository"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// FetchSubIssues retrieves the direct sub-issues of an issue via the GraphQL API
+// Sub-issues may live in other repositories; their refs carry the child's own owner/repo
+func FetchSubIssues(ctx context.Context, client *github.Client, ref input.IssueRef) ([]input.IssueRef, error) {
+	// Get logger from context if available
+	logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger)
+	if !ok {
+		logger = slog.Default()
+	}
+
+	logger.Debug("Fetching sub-issues", "issue", ref.String())
+
+	var refs []input.IssueRef
+	var cursor *string
+
+	for {
+		body := subIssuesRequest{
+			Query: subIssuesQuery,
+			Variables: map[string]any{
+				"owner":  ref.Owner,
+				"repo":   ref.Repo,
+				"number": ref.Number,
+				"cursor": cursor,
+			},
+		}
+
+		req, err := client.NewRequest("POST", graphqlPath, body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build sub-issues request for %s: %w", ref.String(), err)
+		}
+
+		var resp subIssuesResponse
+		if _, err := client.Do(ctx, req, &resp); err != nil {
+			logger.Debug("GitHub API sub-issues fetch failed", "issue", ref.String(), "error", err)
+
+			if enhancedErr := enhanceGitHubError(err, ref); enhancedErr != nil {
+				return nil, enhancedErr
+			}
+
+			return nil, fmt.Errorf("failed to fetch sub-issues for %s: %w", ref.String(), err)
+		}
+
+		if len(resp.Errors) > 0 {
+			return nil, fmt.Errorf("failed to fetch sub-issues for %s: %s", ref.String(), resp.Errors[0].Message)
+		}
+		if resp.Data.Repository == nil || resp.Data.Repository.Issue == nil {
+			return nil, fmt.Errorf("GitHub issue %s not found when fetching sub-issues", ref.String())
+		}
+
+		subIssues := resp.Data.Repository.Issue.SubIssues
+		for _, node := range subIssues.Nodes {
+			refs = append(refs, input.IssueRef{
+				Host:   ref.Host,
+				Owner:  node.Repository.Owner.Login,
+				Repo:   node.Repository.Name,
+				Number: node.Number,
+				URL:    node.URL,
+			})
+		}
+
+		if !subIssues.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &subIssues.PageInfo.EndCursor
+	}
+
+	logger.Debug("Sub-issues fetch completed", "issue", ref.String(), "total", len(refs))
+	return refs, nil
+}
@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+	"github.com/google/go-github/v66/github"
+)
+
+func newTestGraphQLClient(t *testing.T, handler http.HandlerFunc) *github.Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(server.Client())
+	baseURL, _ := url.Parse(server.URL + "/")
+	client.BaseURL = baseURL
+	return client
+}
+
+func TestFetchSubIssues(t *testing.T) {
+	client := newTestGraphQLClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/graphql" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		var req subIssuesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.Variables["owner"] != "owner" || req.Variables["repo"] != "repo" || req.Variables["number"] != float64(10) {
+			t.Errorf("unexpected variables: %v", req.Variables)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"repository":{"issue":{"subIssues":{
+			"nodes":[
+				{"number":11,"url":"https://github.com/owner/repo/issues/11","repository":{"name":"repo","owner":{"login":"owner"}}},
+				{"number":7,"url":"https://github.com/owner/other/issues/7","repository":{"name":"other","owner":{"login":"owner"}}}
+			],
+			"pageInfo":{"hasNextPage":false,"endCursor":""}
+		}}}}}`))
+	})
+
+	parent := input.IssueRef{Owner: "owner", Repo: "repo", Number: 10, URL: "https://github.com/owner/repo/issues/10"}
+	refs, err := FetchSubIssues(context.Background(), client, parent)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 sub-issues, got %d", len(refs))
+	}
+	if refs[0].String() != "owner/repo#11" || refs[0].URL != "https://github.com/owner/repo/issues/11" {
+		t.Errorf("unexpected first sub-issue: %+v", refs[0])
+	}
+	if refs[1].String() != "owner/other#7" {
+		t.Errorf("expected cross-repo sub-issue owner/other#7, got %s", refs[1].String())
+	}
+}
+
+func TestFetchSubIssues_Paginates(t *testing.T) {
+	requests := 0
+	client := newTestGraphQLClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var req subIssuesRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		if req.Variables["cursor"] == nil {
+			_, _ = w.Write([]byte(`{"data":{"repository":{"issue":{"subIssues":{
+				"nodes":[{"number":1,"url":"https://github.com/o/r/issues/1","repository":{"name":"r","owner":{"login":"o"}}}],
+				"pageInfo":{"hasNextPage":true,"endCursor":"c1"}
+			}}}}}`))
+			return
+		}
+		if req.Variables["cursor"] != "c1" {
+			t.Errorf("expected cursor c1, got %v", req.Variables["cursor"])
+		}
+		_, _ = w.Write([]byte(`{"data":{"repository":{"issue":{"subIssues":{
+			"nodes":[{"number":2,"url":"https://github.com/o/r/issues/2","repository":{"name":"r","owner":{"login":"o"}}}],
+			"pageInfo":{"hasNextPage":false,"endCursor":"c2"}
+		}}}}}`))
+	})
+
+	refs, err := FetchSubIssues(context.Background(), client, input.IssueRef{Owner: "o", Repo: "r", Number: 9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(refs) != 2 || requests != 2 {
+		t.Errorf("expected 2 refs over 2 requests, got %d refs over %d requests", len(refs), requests)
+	}
+}
+
+func TestFetchSubIssues_GraphQLError(t *testing.T) {
+	client := newTestGraphQLClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"repository":null},"errors":[{"message":"Could not resolve to a Repository"}]}`))
+	})
+
+	_, err := FetchSubIssues(context.Background(), client, input.IssueRef{Owner: "o", Repo: "missing", Number: 1})
+	if err == nil {
+		t.Fatal("expected error for GraphQL errors response")
+	}
+}
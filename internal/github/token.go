@@ -0,0 +1,70 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// TokenInfo describes the account a token authenticates as
+type TokenInfo struct {
+	Login string
+	// Scopes are a classic token's OAuth scopes; nil for fine-grained tokens
+	// and app tokens, which don't report them
+	Scopes []string
+}
+
+// HasScope reports whether the token was granted scope. A write scope
+// covers its read: counterpart (e.g. "project" covers "read:project").
+// Tokens that don't report scopes are assumed to have it.
+func (t TokenInfo) HasScope(scope string) bool {
+	if t.Scopes == nil {
+		return true
+	}
+	for _, have := range t.Scopes {
+		if have == scope || "read:"+have == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckToken verifies the client's token by fetching the authenticated user
+func CheckToken(ctx context.Context, client *github.Client) (TokenInfo, error) {
+	user, resp, err := client.Users.Get(ctx, "")
+	if err != nil {
+		return TokenInfo{}, enhanceTokenError(err)
+	}
+
+	info := TokenInfo{Login: user.GetLogin()}
+	if header, ok := resp.Header["X-Oauth-Scopes"]; ok {
+		info.Scopes = []string{}
+		for _, scope := range strings.Split(strings.Join(header, ","), ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				info.Scopes = append(info.Scopes, scope)
+			}
+		}
+	}
+	return info, nil
+}
+
+// enhanceTokenError gives CheckToken failures the remediation hints of enhanceGitHubError
+func enhanceTokenError(err error) error {
+	if ghErr, ok := err.(*github.ErrorResponse); ok {
+		switch ghErr.Response.StatusCode {
+		case http.StatusUnauthorized:
+			return errors.New(authFailedMessage(""))
+
+		case http.StatusForbidden:
+			if isSSODenial(ghErr) {
+				return errors.New(ssoRequiredMessage(""))
+			}
+			return fmt.Errorf("%s: %s", permissionDeniedMessage(""), ghErr.Message)
+		}
+	}
+	return fmt.Errorf("failed to verify GitHub token: %w", err)
+}
@@ -0,0 +1,95 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v66/github"
+)
+
+// newUserServer serves GET /user with the given status, scopes header and body
+func newUserServer(t *testing.T, status int, scopes *string, body string) *github.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		if scopes != nil {
+			w.Header().Set("X-OAuth-Scopes", *scopes)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	client := github.NewClient(server.Client())
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	return client
+}
+
+func TestCheckToken_Success(t *testing.T) {
+	scopes := "repo, read:org, project"
+	client := newUserServer(t, http.StatusOK, &scopes, `{"login": "octocat"}`)
+
+	info, err := CheckToken(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Login != "octocat" {
+		t.Errorf("got login %q, want octocat", info.Login)
+	}
+	if len(info.Scopes) != 3 {
+		t.Errorf("got scopes %v, want 3", info.Scopes)
+	}
+	if !info.HasScope("read:project") {
+		t.Error("expected the project scope to cover read:project")
+	}
+	if info.HasScope("workflow") {
+		t.Error("expected workflow scope to be missing")
+	}
+}
+
+func TestCheckToken_FineGrainedTokenReportsNoScopes(t *testing.T) {
+	client := newUserServer(t, http.StatusOK, nil, `{"login": "octocat"}`)
+
+	info, err := CheckToken(context.Background(), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Scopes != nil {
+		t.Errorf("got scopes %v, want nil", info.Scopes)
+	}
+	if !info.HasScope("read:project") {
+		t.Error("expected unknown scopes to be assumed present")
+	}
+}
+
+func TestCheckToken_Forbidden(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "sso", body: `{"message": "Resource protected by organization SAML enforcement. You must grant your Personal Access token access to this organization via SSO."}`, want: "SSO authorization"},
+		{name: "permissions", body: `{"message": "Resource not accessible by personal access token"}`, want: "sufficient permissions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newUserServer(t, http.StatusForbidden, nil, tt.body)
+
+			_, err := CheckToken(context.Background(), client)
+			if err == nil {
+				t.Fatal("expected an error for a 403")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %q, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package httpclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// NewTransport returns a transport for the GitHub, Projects, and Models clients.
+// Requests go through proxyURL when set; otherwise HTTP_PROXY, HTTPS_PROXY,
+// and NO_PROXY are honored.
+func NewTransport(proxyURL *url.URL) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	} else {
+		transport.Proxy = http.ProxyFromEnvironment
+	}
+	return transport
+}
+
+// ParseProxyURL parses a --proxy value. Only http, https, and socks5 proxies
+// with a host are accepted; "" returns nil.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	proxyURL, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", raw, err)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https, or socks5", raw)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", raw)
+	}
+
+	return proxyURL, nil
+}
@@ -12,6 +12,7 @@ import (
 
 // IssueRef represents a GitHub issue reference
 type IssueRef struct {
+	Host        string // GitHub Enterprise Server host the issue lives on (empty = github.com)
 	Owner       string
 	Repo        string
 	Number      int
@@ -28,10 +29,64 @@ func (ref IssueRef) String() string {
 // githubIssueRegex matches GitHub issue URLs
 var githubIssueRegex = regexp.MustCompile(`^https://github\.com/([^/]+)/([^/]+)/issues/(\d+)`)
 
+// hostIssueRegex matches issue URLs on any host; the host must be github.com
+// or one passed to ParseIssueLinksWithHosts
+var hostIssueRegex = regexp.MustCompile(`^https://([^/]+)/([^/]+)/([^/]+)/issues/(\d+)`)
+
+// commentFragmentRegex matches the fragment of a GitHub issue comment permalink
+var commentFragmentRegex = regexp.MustCompile(`^issuecomment-(\d+)$`)
+
+// ParseCommentURL parses a GitHub issue comment permalink of the form
+// https://github.com/{owner}/{repo}/issues/{number}#issuecomment-{id}
+func ParseCommentURL(raw string) (IssueRef, int64, error) {
+	parsedURL, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return IssueRef{}, 0, fmt.Errorf("invalid URL format: %s", raw)
+	}
+
+	matches := githubIssueRegex.FindStringSubmatch(parsedURL.String())
+	if matches == nil {
+		return IssueRef{}, 0, fmt.Errorf("invalid GitHub issue URL format: %s", raw)
+	}
+
+	fragment := commentFragmentRegex.FindStringSubmatch(parsedURL.Fragment)
+	if fragment == nil {
+		return IssueRef{}, 0, fmt.Errorf("URL does not link to a comment (expected #issuecomment-<id>): %s", raw)
+	}
+
+	number, err := strconv.Atoi(matches[3])
+	if err != nil {
+		return IssueRef{}, 0, fmt.Errorf("invalid issue number in URL: %s", raw)
+	}
+	commentID, err := strconv.ParseInt(fragment[1], 10, 64)
+	if err != nil {
+		return IssueRef{}, 0, fmt.Errorf("invalid comment ID in URL: %s", raw)
+	}
+
+	return IssueRef{
+		Owner:  matches[1],
+		Repo:   matches[2],
+		Number: number,
+		URL:    fmt.Sprintf("https://github.com/%s/%s/issues/%d", matches[1], matches[2], number),
+	}, commentID, nil
+}
+
 // ParseIssueLinks parses GitHub issue URLs from a reader
 // Accepts URLs in the form: https://github.com/{owner}/{repo}/issues/{number}
 // Allows query parameters and fragments. Deduplicates while maintaining stable order.
 func ParseIssueLinks(r io.Reader) ([]IssueRef, error) {
+	return ParseIssueLinksWithHosts(r, nil)
+}
+
+// ParseIssueLinksWithHosts parses issue URLs like ParseIssueLinks, also accepting
+// the same path on the GitHub Enterprise Server hosts (e.g. "github.example.com")
+// in hosts and keeping that host on the ref
+func ParseIssueLinksWithHosts(r io.Reader, hosts []string) ([]IssueRef, error) {
+	allowedHosts := make(map[string]bool, len(hosts))
+	for _, host := range hosts {
+		allowedHosts[strings.ToLower(host)] = true
+	}
+
 	var refs []IssueRef
 	seen := make(map[string]bool)
 	scanner := bufio.NewScanner(r)
@@ -51,14 +106,22 @@ func ParseIssueLinks(r io.Reader) ([]IssueRef, error) {
 		}
 
 		// Match against the GitHub issue pattern
-		matches := githubIssueRegex.FindStringSubmatch(parsedURL.String())
+		matches := hostIssueRegex.FindStringSubmatch(parsedURL.String())
 		if matches == nil {
 			return nil, fmt.Errorf("invalid GitHub issue URL format: %s", line)
 		}
 
-		owner := matches[1]
-		repo := matches[2]
-		numberStr := matches[3]
+		host := strings.ToLower(matches[1])
+		switch {
+		case host == "github.com":
+			host = ""
+		case !allowedHosts[host]:
+			return nil, fmt.Errorf("unsupported GitHub host %s in %s (list GitHub Enterprise Server hosts with --github-hosts)", host, line)
+		}
+
+		owner := matches[2]
+		repo := matches[3]
+		numberStr := matches[4]
 
 		number, err := strconv.Atoi(numberStr)
 		if err != nil {
@@ -66,7 +129,7 @@ func ParseIssueLinks(r io.Reader) ([]IssueRef, error) {
 		}
 
 		// Create canonical URL without query/fragment for deduplication
-		canonicalURL := fmt.Sprintf("https://github.com/%s/%s/issues/%d", owner, repo, number)
+		canonicalURL := fmt.Sprintf("https://%s/%s/%s/issues/%d", hostOrDefault(host), owner, repo, number)
 
 		// Skip if we've already seen this issue
 		if seen[canonicalURL] {
@@ -75,6 +138,7 @@ func ParseIssueLinks(r io.Reader) ([]IssueRef, error) {
 		seen[canonicalURL] = true
 
 		refs = append(refs, IssueRef{
+			Host:   host,
 			Owner:  owner,
 			Repo:   repo,
 			Number: number,
@@ -88,3 +152,11 @@ func ParseIssueLinks(r io.Reader) ([]IssueRef, error) {
 
 	return refs, nil
 }
+
+// hostOrDefault returns host, or github.com when it is empty
+func hostOrDefault(host string) string {
+	if host == "" {
+		return "github.com"
+	}
+	return host
+}
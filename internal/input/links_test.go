@@ -127,6 +127,39 @@ func TestParseIssueLinks_InvalidURLs(t *testing.T) {
 	}
 }
 
+func TestParseIssueLinks_MultipleHosts(t *testing.T) {
+	hosts := []string{"GitHub.Example.com"}
+
+	input := `https://github.com/owner/repo/issues/1
+https://github.example.com/corp/platform/issues/2#issuecomment-9
+https://GITHUB.EXAMPLE.COM/corp/platform/issues/2
+https://github.example.com/owner/repo/issues/1`
+
+	refs, err := ParseIssueLinksWithHosts(strings.NewReader(input), hosts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []IssueRef{
+		{Owner: "owner", Repo: "repo", Number: 1, URL: "https://github.com/owner/repo/issues/1"},
+		{Host: "github.example.com", Owner: "corp", Repo: "platform", Number: 2, URL: "https://github.example.com/corp/platform/issues/2"},
+		{Host: "github.example.com", Owner: "owner", Repo: "repo", Number: 1, URL: "https://github.example.com/owner/repo/issues/1"},
+	}
+	if !reflect.DeepEqual(refs, expected) {
+		t.Errorf("expected %+v, got %+v", expected, refs)
+	}
+
+	_, err = ParseIssueLinksWithHosts(strings.NewReader("https://github.other.com/owner/repo/issues/1"), hosts)
+	if err == nil || !strings.Contains(err.Error(), "unsupported GitHub host github.other.com") {
+		t.Errorf("expected an unlisted host to be rejected, got %v", err)
+	}
+
+	_, err = ParseIssueLinks(strings.NewReader("https://github.example.com/corp/platform/issues/2"))
+	if err == nil || !strings.Contains(err.Error(), "unsupported GitHub host github.example.com") {
+		t.Errorf("expected ParseIssueLinks to accept only github.com, got %v", err)
+	}
+}
+
 func TestParseIssueLinks_EmptyInput(t *testing.T) {
 	reader := strings.NewReader("")
 	refs, err := ParseIssueLinks(reader)
@@ -153,3 +186,27 @@ func TestIssueRef_String(t *testing.T) {
 		t.Errorf("expected %s, got %s", expected, ref.String())
 	}
 }
+
+func TestParseCommentURL(t *testing.T) {
+	ref, id, err := ParseCommentURL("https://github.com/owner/repo/issues/42#issuecomment-1234567")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ref.Owner != "owner" || ref.Repo != "repo" || ref.Number != 42 || ref.URL != "https://github.com/owner/repo/issues/42" {
+		t.Errorf("unexpected ref: %+v", ref)
+	}
+	if id != 1234567 {
+		t.Errorf("got comment ID %d, want 1234567", id)
+	}
+
+	invalid := []string{
+		"https://github.com/owner/repo/issues/42",
+		"https://github.com/owner/repo/issues/42#top",
+		"https://github.com/owner/repo/pull/42#issuecomment-1",
+	}
+	for _, raw := range invalid {
+		if _, _, err := ParseCommentURL(raw); err == nil {
+			t.Errorf("expected error for %q", raw)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 )
 
 // LoggerContextKey is the context key type for the structured logger.
@@ -47,17 +48,29 @@ func (m InputMode) String() string {
 // ResolverConfig holds configuration for input resolution
 type ResolverConfig struct {
 	// Project board settings
-	ProjectURL         string
-	ProjectFieldName   string
-	ProjectFieldValues []string
-	ProjectIncludePRs  bool
-	ProjectMaxItems    int
-	ProjectView        string // View name to filter by
-	ProjectViewID      string // View ID (takes precedence over ProjectView)
+	ProjectURL          string
+	ProjectFieldName    string
+	ProjectFieldValues  []string
+	ProjectIncludePRs   bool
+	ProjectMaxItems     int
+	ProjectView         string   // View name to filter by
+	ProjectViewID       string   // View ID (takes precedence over ProjectView)
+	ProjectFieldAlias   []string // "alias=canonical" value spellings matched alongside filter values
+	ProjectStrict       bool     // Fail on items with two values for one field
+	ProjectUpdatedSince string   // Only items updated on or after this YYYY-MM-DD date (empty = all)
 
 	// URL list settings
-	URLListPath string // File path or empty for stdin
-	UseStdin    bool   // Whether to read from stdin
+	URLListPath string   // File path or empty for stdin
+	UseStdin    bool     // Whether to read from stdin
+	GitHubHosts []string // GitHub Enterprise Server hosts whose issue URLs are accepted besides github.com
+
+	// Repository filters applied after resolution (entries are "owner/repo")
+	RepoAllowlist []string // When non-empty, only these repositories are kept
+	RepoDenylist  []string // Repositories to drop, applied after the allowlist
+
+	ExpandSubIssues bool // Add each resolved issue's direct sub-issues (see ExpandSubIssues)
+
+	MaxIssues int // Keep only the first N issues after merging and filtering sources (0 = no cap)
 }
 
 // ProjectClient is an interface for fetching project items
@@ -66,6 +79,11 @@ type ProjectClient interface {
 	FetchProjectItems(ctx context.Context, config ResolverConfig) ([]IssueRef, error)
 }
 
+// SubIssueFetcher lists the direct sub-issues of an issue
+type SubIssueFetcher interface {
+	FetchSubIssues(ctx context.Context, ref IssueRef) ([]IssueRef, error)
+}
+
 // ResolveIssueRefs determines input mode and returns deduplicated issue refs
 // This is the main entry point for getting issues from any source
 func ResolveIssueRefs(ctx context.Context, cfg ResolverConfig, projectClient ProjectClient) ([]IssueRef, error) {
@@ -115,11 +133,63 @@ func ResolveIssueRefs(ctx context.Context, cfg ResolverConfig, projectClient Pro
 	// Deduplicate
 	logger.Debug("Deduplicating issue references", "total", len(allRefs))
 	unique := deduplicateRefs(allRefs)
+
+	if len(cfg.RepoAllowlist) > 0 || len(cfg.RepoDenylist) > 0 {
+		filtered := filterRefsByRepo(unique, cfg.RepoAllowlist, cfg.RepoDenylist)
+		if dropped := len(unique) - len(filtered); dropped > 0 {
+			logger.Info("Issues dropped by repository filter", "dropped", dropped)
+		}
+		unique = filtered
+	}
+
+	// The cap applies to the merged list, so project items (fetched first) are
+	// kept ahead of URL list entries
+	if cfg.MaxIssues > 0 && len(unique) > cfg.MaxIssues {
+		logger.Warn("Too many issues resolved, keeping the first ones", "resolved", len(unique), "maxIssues", cfg.MaxIssues)
+		unique = unique[:cfg.MaxIssues]
+	}
+
 	logger.Info("Input resolution complete", "uniqueIssues", len(unique), "mode", mode.String())
 
 	return unique, nil
 }
 
+// ExpandSubIssues appends the direct sub-issues of each ref, deduplicated
+// against the refs already resolved. Sub-issues pass through the same repository
+// filters as cfg and count toward cfg.MaxIssues; a parent whose sub-issues
+// can't be fetched is logged and skipped.
+func ExpandSubIssues(ctx context.Context, cfg ResolverConfig, refs []IssueRef, fetcher SubIssueFetcher) []IssueRef {
+	logger, ok := ctx.Value(LoggerContextKey{}).(*slog.Logger)
+	if !ok {
+		logger = slog.Default()
+	}
+
+	var children []IssueRef
+	for _, ref := range refs {
+		subIssues, err := fetcher.FetchSubIssues(ctx, ref)
+		if err != nil {
+			logger.Warn("Failed to fetch sub-issues, skipping", "issue", ref.String(), "error", err)
+			continue
+		}
+		children = append(children, subIssues...)
+	}
+
+	if len(cfg.RepoAllowlist) > 0 || len(cfg.RepoDenylist) > 0 {
+		children = filterRefsByRepo(children, cfg.RepoAllowlist, cfg.RepoDenylist)
+	}
+
+	expanded := deduplicateRefs(append(append([]IssueRef{}, refs...), children...))
+	logger.Info("Sub-issues expanded", "added", len(expanded)-len(refs))
+
+	// Parents come first, so the cap drops sub-issues before any resolved ref
+	if cfg.MaxIssues > 0 && len(expanded) > cfg.MaxIssues {
+		logger.Warn("Too many issues after sub-issue expansion, keeping the first ones", "expanded", len(expanded), "maxIssues", cfg.MaxIssues)
+		expanded = expanded[:cfg.MaxIssues]
+	}
+
+	return expanded
+}
+
 // detectInputMode determines which input mode to use based on configuration
 func detectInputMode(cfg ResolverConfig) InputMode {
 	hasProject := cfg.ProjectURL != ""
@@ -145,6 +215,26 @@ func validateConfig(cfg ResolverConfig) error {
 		if cfg.ProjectMaxItems < 1 || cfg.ProjectMaxItems > 1000 {
 			return fmt.Errorf("--project-max-items must be between 1 and 1000, got %d", cfg.ProjectMaxItems)
 		}
+		if cfg.ProjectUpdatedSince != "" {
+			if _, err := time.Parse("2006-01-02", cfg.ProjectUpdatedSince); err != nil {
+				return fmt.Errorf("--project-updated-since must be a YYYY-MM-DD date, got %q", cfg.ProjectUpdatedSince)
+			}
+		}
+	}
+
+	if cfg.MaxIssues < 0 {
+		return fmt.Errorf("--max-issues must be 0 (no cap) or greater, got %d", cfg.MaxIssues)
+	}
+
+	for _, repo := range cfg.RepoAllowlist {
+		if !isRepoName(repo) {
+			return fmt.Errorf("--repo-allowlist entries must be owner/repo, got %q", repo)
+		}
+	}
+	for _, repo := range cfg.RepoDenylist {
+		if !isRepoName(repo) {
+			return fmt.Errorf("--repo-denylist entries must be owner/repo, got %q", repo)
+		}
 	}
 
 	return nil
@@ -189,8 +279,8 @@ func fetchFromURLList(cfg ResolverConfig) ([]IssueRef, error) {
 		return nil, fmt.Errorf("no URL list source specified")
 	}
 
-	// Use existing ParseIssueLinks function
-	refs, err := ParseIssueLinks(reader)
+	// Use existing ParseIssueLinksWithHosts function
+	refs, err := ParseIssueLinksWithHosts(reader, cfg.GitHubHosts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse issue links: %w", err)
 	}
@@ -198,22 +288,74 @@ func fetchFromURLList(cfg ResolverConfig) ([]IssueRef, error) {
 	return refs, nil
 }
 
-// deduplicateRefs removes duplicate issue references while preserving order
+// deduplicateRefs removes duplicate issue references while preserving order.
+// The first occurrence is kept, with its URL in canonical form; if it has no project field values and a
+// duplicate does (e.g. a URL list entry also on the board), it takes them.
 func deduplicateRefs(refs []IssueRef) []IssueRef {
-	seen := make(map[string]bool)
+	seen := make(map[string]int)
 	var unique []IssueRef
 
 	for _, ref := range refs {
 		// Use canonical URL as the key for deduplication
-		if !seen[ref.URL] {
-			seen[ref.URL] = true
-			unique = append(unique, ref)
+		key := canonicalIssueURL(ref.URL)
+		if i, ok := seen[key]; ok {
+			if unique[i].FieldValues == nil {
+				unique[i].FieldValues = ref.FieldValues
+			}
+			continue
 		}
+		seen[key] = len(unique)
+		ref.URL = key
+		unique = append(unique, ref)
 	}
 
 	return unique
 }
 
+// canonicalIssueURL strips any query string, fragment, and trailing slash so
+// that links to the same issue (e.g. a comment permalink) compare equal,
+// matching the canonical form ParseIssueLinks produces
+func canonicalIssueURL(raw string) string {
+	if i := strings.IndexAny(raw, "?#"); i >= 0 {
+		raw = raw[:i]
+	}
+	return strings.TrimSuffix(raw, "/")
+}
+
+// filterRefsByRepo keeps refs whose owner/repo is in the allowlist (when non-empty)
+// and not in the denylist. Matching is case-insensitive, as GitHub names are.
+func filterRefsByRepo(refs []IssueRef, allowlist, denylist []string) []IssueRef {
+	toSet := func(repos []string) map[string]bool {
+		set := make(map[string]bool, len(repos))
+		for _, repo := range repos {
+			set[strings.ToLower(repo)] = true
+		}
+		return set
+	}
+	allowed := toSet(allowlist)
+	denied := toSet(denylist)
+
+	var kept []IssueRef
+	for _, ref := range refs {
+		key := strings.ToLower(ref.Owner + "/" + ref.Repo)
+		if len(allowed) > 0 && !allowed[key] {
+			continue
+		}
+		if denied[key] {
+			continue
+		}
+		kept = append(kept, ref)
+	}
+
+	return kept
+}
+
+// isRepoName reports whether s looks like "owner/repo"
+func isRepoName(s string) bool {
+	owner, repo, ok := strings.Cut(s, "/")
+	return ok && owner != "" && repo != "" && !strings.Contains(repo, "/")
+}
+
 // ParseFieldValues splits a comma-separated string into field values
 // Trims whitespace and filters empty values
 func ParseFieldValues(raw string) []string {
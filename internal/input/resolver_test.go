@@ -2,8 +2,11 @@ package input
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -165,6 +168,25 @@ func TestFetchFromURLList_File(t *testing.T) {
 	}
 }
 
+func TestFetchFromURLList_GitHubHosts(t *testing.T) {
+	tempFile := createTempFile(t, "https://github.example.com/corp/platform/issues/2\n")
+	defer os.Remove(tempFile)
+
+	cfg := ResolverConfig{
+		URLListPath: tempFile,
+		GitHubHosts: []string{"github.example.com"},
+	}
+
+	refs, err := fetchFromURLList(cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(refs) != 1 || refs[0].Host != "github.example.com" {
+		t.Fatalf("expected 1 ref on github.example.com, got %+v", refs)
+	}
+}
+
 func TestFetchFromURLList_FileNotFound(t *testing.T) {
 	cfg := ResolverConfig{
 		URLListPath: "/nonexistent/file.txt",
@@ -229,6 +251,235 @@ func TestDeduplicateRefs_NoDuplicates(t *testing.T) {
 	}
 }
 
+// stubProjectClient returns a fixed set of refs for project mode tests
+type stubProjectClient struct {
+	refs []IssueRef
+}
+
+func (c *stubProjectClient) FetchProjectItems(_ context.Context, _ ResolverConfig) ([]IssueRef, error) {
+	return c.refs, nil
+}
+
+func TestResolveIssueRefs_DeduplicatesFragmentURLsAcrossSources(t *testing.T) {
+	tempFile := createTempFile(t, "https://github.com/org/api/issues/123\nhttps://github.com/org/api/issues/456\n")
+
+	projectClient := &stubProjectClient{refs: []IssueRef{
+		{Owner: "org", Repo: "api", Number: 123, URL: "https://github.com/org/api/issues/123#issuecomment-987"},
+		{Owner: "org", Repo: "api", Number: 456, URL: "https://github.com/org/api/issues/456?tab=timeline"},
+	}}
+
+	cfg := ResolverConfig{
+		ProjectURL:         "org:org/5",
+		ProjectFieldName:   "Status",
+		ProjectFieldValues: []string{"In Progress"},
+		ProjectMaxItems:    100,
+		URLListPath:        tempFile,
+	}
+
+	refs, err := ResolveIssueRefs(context.Background(), cfg, projectClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 refs after dedup, got %d: %+v", len(refs), refs)
+	}
+	if refs[0].Number != 123 || refs[1].Number != 456 {
+		t.Errorf("expected project refs to be kept in order, got %+v", refs)
+	}
+	if refs[0].URL != "https://github.com/org/api/issues/123" || refs[1].URL != "https://github.com/org/api/issues/456" {
+		t.Errorf("expected kept refs to carry canonical URLs, got %q and %q", refs[0].URL, refs[1].URL)
+	}
+}
+
+func TestResolveIssueRefs_MaxIssues(t *testing.T) {
+	tempFile := createTempFile(t, "https://github.com/org/api/issues/2\nhttps://github.com/org/api/issues/10\nhttps://github.com/org/api/issues/11\n")
+
+	projectClient := &stubProjectClient{refs: []IssueRef{
+		{Owner: "org", Repo: "api", Number: 3, URL: "https://github.com/org/api/issues/3"},
+		{Owner: "org", Repo: "api", Number: 2, URL: "https://github.com/org/api/issues/2"},
+	}}
+
+	cfg := ResolverConfig{
+		ProjectURL:         "org:org/5",
+		ProjectFieldName:   "Status",
+		ProjectFieldValues: []string{"In Progress"},
+		ProjectMaxItems:    100,
+		URLListPath:        tempFile,
+		MaxIssues:          3,
+	}
+
+	// The duplicate #2 counts once, so the cap keeps both board items and the
+	// first URL-list-only issue
+	for i := 0; i < 3; i++ {
+		refs, err := ResolveIssueRefs(context.Background(), cfg, projectClient)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var numbers []int
+		for _, ref := range refs {
+			numbers = append(numbers, ref.Number)
+		}
+		if fmt.Sprint(numbers) != "[3 2 10]" {
+			t.Fatalf("got issues %v, want [3 2 10]", numbers)
+		}
+	}
+
+	cfg.MaxIssues = -1
+	if _, err := ResolveIssueRefs(context.Background(), cfg, projectClient); err == nil || !strings.Contains(err.Error(), "--max-issues") {
+		t.Errorf("got %v, want a --max-issues validation error", err)
+	}
+}
+
+func TestResolveIssueRefs_KeepsProjectFieldValues(t *testing.T) {
+	tempFile := createTempFile(t, "https://github.com/org/api/issues/123\nhttps://github.com/org/api/issues/789\n")
+
+	projectClient := &stubProjectClient{refs: []IssueRef{
+		{Owner: "org", Repo: "api", Number: 123, URL: "https://github.com/org/api/issues/123", FieldValues: map[string]string{"Status": "In Progress", "Target Date": "2025-09-30"}},
+	}}
+
+	cfg := ResolverConfig{
+		ProjectURL:         "org:org/5",
+		ProjectFieldName:   "Status",
+		ProjectFieldValues: []string{"In Progress"},
+		ProjectMaxItems:    100,
+		URLListPath:        tempFile,
+	}
+
+	refs, err := ResolveIssueRefs(context.Background(), cfg, projectClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 refs, got %d: %+v", len(refs), refs)
+	}
+	if refs[0].FieldValues["Target Date"] != "2025-09-30" {
+		t.Errorf("expected project field values on the board issue, got %+v", refs[0].FieldValues)
+	}
+	if refs[1].FieldValues != nil {
+		t.Errorf("expected no field values for a URL-list-only issue, got %+v", refs[1].FieldValues)
+	}
+}
+
+func TestDeduplicateRefs_TakesFieldValuesFromDuplicate(t *testing.T) {
+	refs := []IssueRef{
+		{Owner: "org", Repo: "api", Number: 1, URL: "https://github.com/org/api/issues/1"},
+		{Owner: "org", Repo: "api", Number: 1, URL: "https://github.com/org/api/issues/1#issuecomment-5", FieldValues: map[string]string{"Status": "Done"}},
+	}
+
+	unique := deduplicateRefs(refs)
+	if len(unique) != 1 {
+		t.Fatalf("expected 1 ref, got %d", len(unique))
+	}
+	if unique[0].URL != "https://github.com/org/api/issues/1" || unique[0].FieldValues["Status"] != "Done" {
+		t.Errorf("expected the first ref with the duplicate's field values, got %+v", unique[0])
+	}
+}
+
+func TestCanonicalIssueURL(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"https://github.com/org/repo/issues/1", "https://github.com/org/repo/issues/1"},
+		{"https://github.com/org/repo/issues/1#issuecomment-42", "https://github.com/org/repo/issues/1"},
+		{"https://github.com/org/repo/issues/1?foo=bar", "https://github.com/org/repo/issues/1"},
+		{"https://github.com/org/repo/issues/1/", "https://github.com/org/repo/issues/1"},
+	}
+
+	for _, tt := range tests {
+		if got := canonicalIssueURL(tt.input); got != tt.expected {
+			t.Errorf("canonicalIssueURL(%q) = %q, expected %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestFilterRefsByRepo(t *testing.T) {
+	refs := []IssueRef{
+		{Owner: "org", Repo: "api", Number: 1, URL: "url1"},
+		{Owner: "org", Repo: "web", Number: 2, URL: "url2"},
+		{Owner: "other", Repo: "private", Number: 3, URL: "url3"},
+		{Owner: "Org", Repo: "API", Number: 4, URL: "url4"},
+	}
+
+	tests := []struct {
+		name      string
+		allowlist []string
+		denylist  []string
+		want      []int
+	}{
+		{name: "no filters", want: []int{1, 2, 3, 4}},
+		{name: "allowlist", allowlist: []string{"org/api", "org/web"}, want: []int{1, 2, 4}},
+		{name: "allowlist case-insensitive", allowlist: []string{"ORG/api"}, want: []int{1, 4}},
+		{name: "denylist", denylist: []string{"other/private"}, want: []int{1, 2, 4}},
+		{name: "allowlist and denylist", allowlist: []string{"org/api", "org/web"}, denylist: []string{"org/web"}, want: []int{1, 4}},
+		{name: "allowlist matches nothing", allowlist: []string{"nobody/nothing"}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterRefsByRepo(refs, tt.allowlist, tt.denylist)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d refs, want %d", len(got), len(tt.want))
+			}
+			for i, ref := range got {
+				if ref.Number != tt.want[i] {
+					t.Errorf("ref[%d].Number = %d, want %d", i, ref.Number, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConfig_InvalidRepoFilter(t *testing.T) {
+	if err := validateConfig(ResolverConfig{RepoAllowlist: []string{"just-a-name"}}); err == nil {
+		t.Error("expected error for allowlist entry without owner")
+	}
+	if err := validateConfig(ResolverConfig{RepoDenylist: []string{"a/b/c"}}); err == nil {
+		t.Error("expected error for malformed denylist entry")
+	}
+	if err := validateConfig(ResolverConfig{RepoAllowlist: []string{"org/repo"}}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidateConfig_ProjectUpdatedSince(t *testing.T) {
+	cfg := ResolverConfig{ProjectURL: "org:test/5", ProjectMaxItems: 100, ProjectUpdatedSince: "2025-08-01"}
+	if err := validateConfig(cfg); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	for _, bad := range []string{"08/01/2025", "2025-8-1", "last week"} {
+		cfg.ProjectUpdatedSince = bad
+		if err := validateConfig(cfg); err == nil || !strings.Contains(err.Error(), "--project-updated-since") {
+			t.Errorf("expected --project-updated-since error for %q, got %v", bad, err)
+		}
+	}
+}
+
+func TestResolveIssueRefs_RepoAllowlist(t *testing.T) {
+	tempFile := createTempFile(t, "https://github.com/org/api/issues/1\nhttps://github.com/other/private/issues/2\nhttps://github.com/org/api/issues/3\n")
+
+	cfg := ResolverConfig{
+		URLListPath:   tempFile,
+		RepoAllowlist: []string{"org/api"},
+	}
+
+	refs, err := ResolveIssueRefs(context.Background(), cfg, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 refs, got %d", len(refs))
+	}
+	for _, ref := range refs {
+		if ref.Owner != "org" || ref.Repo != "api" {
+			t.Errorf("unexpected ref %s survived allowlist", ref.String())
+		}
+	}
+}
+
 func TestParseFieldValues_CommaSeparated(t *testing.T) {
 	raw := "In Progress,Blocked,Done"
 	values := ParseFieldValues(raw)
@@ -352,6 +603,93 @@ func TestResolveIssueRefs_URLListMode(t *testing.T) {
 	}
 }
 
+// stubSubIssueFetcher returns fixed sub-issues keyed by parent "owner/repo#number"
+type stubSubIssueFetcher struct {
+	children map[string][]IssueRef
+	errs     map[string]error
+}
+
+func (f *stubSubIssueFetcher) FetchSubIssues(_ context.Context, ref IssueRef) ([]IssueRef, error) {
+	if err := f.errs[ref.String()]; err != nil {
+		return nil, err
+	}
+	return f.children[ref.String()], nil
+}
+
+func TestExpandSubIssues(t *testing.T) {
+	refs := []IssueRef{
+		{Owner: "org", Repo: "api", Number: 1, URL: "https://github.com/org/api/issues/1"},
+		{Owner: "org", Repo: "api", Number: 2, URL: "https://github.com/org/api/issues/2"},
+	}
+	fetcher := &stubSubIssueFetcher{children: map[string][]IssueRef{
+		"org/api#1": {
+			{Owner: "org", Repo: "api", Number: 2, URL: "https://github.com/org/api/issues/2"},
+			{Owner: "org", Repo: "web", Number: 30, URL: "https://github.com/org/web/issues/30"},
+		},
+	}}
+
+	expanded := ExpandSubIssues(context.Background(), ResolverConfig{}, refs, fetcher)
+
+	var got []string
+	for _, ref := range expanded {
+		got = append(got, ref.String())
+	}
+	want := []string{"org/api#1", "org/api#2", "org/web#30"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestExpandSubIssues_AppliesRepoFiltersAndSkipsErrors(t *testing.T) {
+	refs := []IssueRef{
+		{Owner: "org", Repo: "api", Number: 1, URL: "https://github.com/org/api/issues/1"},
+		{Owner: "org", Repo: "api", Number: 2, URL: "https://github.com/org/api/issues/2"},
+	}
+	fetcher := &stubSubIssueFetcher{
+		children: map[string][]IssueRef{
+			"org/api#1": {
+				{Owner: "org", Repo: "api", Number: 10, URL: "https://github.com/org/api/issues/10"},
+				{Owner: "org", Repo: "secret", Number: 11, URL: "https://github.com/org/secret/issues/11"},
+			},
+		},
+		errs: map[string]error{"org/api#2": errors.New("boom")},
+	}
+
+	cfg := ResolverConfig{RepoDenylist: []string{"org/secret"}}
+	expanded := ExpandSubIssues(context.Background(), cfg, refs, fetcher)
+
+	if len(expanded) != 3 {
+		t.Fatalf("expected 3 refs, got %d: %v", len(expanded), expanded)
+	}
+	if expanded[2].String() != "org/api#10" {
+		t.Errorf("expected org/api#10 appended, got %s", expanded[2].String())
+	}
+}
+
+func TestExpandSubIssues_MaxIssues(t *testing.T) {
+	refs := []IssueRef{
+		{Owner: "org", Repo: "api", Number: 1, URL: "https://github.com/org/api/issues/1"},
+		{Owner: "org", Repo: "api", Number: 2, URL: "https://github.com/org/api/issues/2"},
+	}
+	fetcher := &stubSubIssueFetcher{children: map[string][]IssueRef{
+		"org/api#1": {
+			{Owner: "org", Repo: "api", Number: 10, URL: "https://github.com/org/api/issues/10"},
+			{Owner: "org", Repo: "api", Number: 11, URL: "https://github.com/org/api/issues/11"},
+		},
+	}}
+
+	expanded := ExpandSubIssues(context.Background(), ResolverConfig{MaxIssues: 3}, refs, fetcher)
+
+	var got []string
+	for _, ref := range expanded {
+		got = append(got, ref.String())
+	}
+	want := []string{"org/api#1", "org/api#2", "org/api#10"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
 // Helper function to create a temporary file with content
 func createTempFile(t *testing.T, content string) string {
 	t.Helper()
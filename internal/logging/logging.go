@@ -0,0 +1,43 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+)
+
+// Log output formats accepted by --log-format
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// ValidateFormat returns an error unless format is empty, FormatText, or FormatJSON
+func ValidateFormat(format string) error {
+	switch format {
+	case "", FormatText, FormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("invalid log format '%s': must be '%s' or '%s'", format, FormatText, FormatJSON)
+	}
+}
+
+// NewHandler returns the slog handler for format writing to w at level.
+// Text output drops timestamps for clean interactive progress; JSON keeps them
+// for log pipelines. An empty format selects text.
+func NewHandler(w io.Writer, format string, level slog.Leveler) slog.Handler {
+	if format == FormatJSON {
+		return slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})
+	}
+
+	return slog.NewTextHandler(w, &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// Remove time stamps for cleaner progress output
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+}
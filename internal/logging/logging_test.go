@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestNewHandler_SelectsHandlerType(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		isJSON bool
+	}{
+		{name: "default is text", format: "", isJSON: false},
+		{name: "text", format: FormatText, isJSON: false},
+		{name: "json", format: FormatJSON, isJSON: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHandler(&bytes.Buffer{}, tt.format, slog.LevelInfo)
+			_, isJSON := handler.(*slog.JSONHandler)
+			_, isText := handler.(*slog.TextHandler)
+			if isJSON != tt.isJSON || isText == tt.isJSON {
+				t.Errorf("NewHandler(%q) returned %T", tt.format, handler)
+			}
+		})
+	}
+}
+
+func TestNewHandler_Timestamps(t *testing.T) {
+	var text bytes.Buffer
+	slog.New(NewHandler(&text, FormatText, slog.LevelInfo)).Info("hello", "count", 2)
+	if strings.Contains(text.String(), "time=") {
+		t.Errorf("expected text output without timestamps, got %q", text.String())
+	}
+
+	var jsonOut bytes.Buffer
+	slog.New(NewHandler(&jsonOut, FormatJSON, slog.LevelInfo)).Info("hello", "count", 2)
+	var record map[string]any
+	if err := json.Unmarshal(jsonOut.Bytes(), &record); err != nil {
+		t.Fatalf("expected a JSON record, got %q: %v", jsonOut.String(), err)
+	}
+	if _, ok := record["time"]; !ok {
+		t.Errorf("expected JSON output to keep timestamps, got %v", record)
+	}
+	if record["msg"] != "hello" || record["count"] != float64(2) {
+		t.Errorf("unexpected JSON record: %v", record)
+	}
+}
+
+func TestValidateFormat(t *testing.T) {
+	for _, format := range []string{"", FormatText, FormatJSON} {
+		if err := ValidateFormat(format); err != nil {
+			t.Errorf("ValidateFormat(%q) unexpected error: %v", format, err)
+		}
+	}
+	if err := ValidateFormat("yaml"); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
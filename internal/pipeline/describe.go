@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/Attamusc/weekly-report-cli/internal/ai"
 	"github.com/Attamusc/weekly-report-cli/internal/format"
@@ -11,7 +12,9 @@ import (
 )
 
 // CollectDescribeIssueData fetches GitHub issue data for the describe command.
-func CollectDescribeIssueData(ctx context.Context, fetcher IssueFetcher, ref input.IssueRef) (DescribeIssueData, error) {
+// The fallback description is the first excerptChars characters of the body
+// (0 = the whole body).
+func CollectDescribeIssueData(ctx context.Context, fetcher IssueFetcher, ref input.IssueRef, excerptChars int) (DescribeIssueData, error) {
 	logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger)
 	if !ok {
 		logger = slog.Default()
@@ -24,19 +27,33 @@ func CollectDescribeIssueData(ctx context.Context, fetcher IssueFetcher, ref inp
 		return DescribeIssueData{}, fmt.Errorf("failed to fetch issue: %w", err)
 	}
 
-	fallback := issueData.Body
-	if len(fallback) > 500 {
-		fallback = fallback[:500] + "..."
+	return newDescribeIssueData(ref.URL, issueData.Title, issueData.Body, issueData.Labels, issueData.Assignees, excerptChars), nil
+}
+
+// DescribeDataFromIssues reuses issues collected for generate as describe
+// input, so a combined status and goals report fetches each issue once
+func DescribeDataFromIssues(allData []IssueData) []DescribeIssueData {
+	describeData := make([]DescribeIssueData, len(allData))
+	for i, data := range allData {
+		describeData[i] = newDescribeIssueData(data.IssueURL, data.IssueTitle, data.IssueBody, data.Labels, data.Assignees, DefaultDescribeExcerptChars)
 	}
+	return describeData
+}
 
+// newDescribeIssueData builds describe input for one issue
+func newDescribeIssueData(url, title, body string, labels, assignees []string, excerptChars int) DescribeIssueData {
+	fallback := strings.TrimSpace(body)
+	if excerptChars > 0 {
+		fallback = truncateBody(body, excerptChars)
+	}
 	return DescribeIssueData{
-		IssueURL:            ref.URL,
-		IssueTitle:          issueData.Title,
-		IssueBody:           issueData.Body,
-		Labels:              issueData.Labels,
-		Assignees:           issueData.Assignees,
+		IssueURL:            url,
+		IssueTitle:          title,
+		IssueBody:           body,
+		Labels:              labels,
+		Assignees:           assignees,
 		FallbackDescription: fallback,
-	}, nil
+	}
 }
 
 // AssembleDescribeResults creates describe rows from collected data and AI descriptions.
@@ -65,6 +82,21 @@ func AssembleDescribeResults(allData []DescribeIssueData, descriptions map[strin
 	return rows
 }
 
+// MissingDescriptions returns the URLs of issues that were eligible for AI
+// description but have no entry in descriptions, in collection order.
+func MissingDescriptions(allData []DescribeIssueData, descriptions map[string]string) []string {
+	var missing []string
+	for _, data := range allData {
+		if data.IssueBody == "" {
+			continue
+		}
+		if _, ok := descriptions[data.IssueURL]; !ok {
+			missing = append(missing, data.IssueURL)
+		}
+	}
+	return missing
+}
+
 // BatchDescribe generates descriptions for all collected issue data in a single API call.
 func BatchDescribe(ctx context.Context, summarizer ai.Summarizer, allData []DescribeIssueData, logger *slog.Logger) (map[string]string, error) {
 	var batchItems []ai.DescribeBatchItem
@@ -93,3 +125,34 @@ func BatchDescribe(ctx context.Context, summarizer ai.Summarizer, allData []Desc
 	logger.Info("Batch description completed", "descriptions", len(descriptions))
 	return descriptions, nil
 }
+
+// DescribeEach generates descriptions one issue at a time instead of in a single
+// batch call. Issues whose call fails are left out (their fallback is used); an
+// error is returned only if every call fails.
+func DescribeEach(ctx context.Context, summarizer ai.Summarizer, allData []DescribeIssueData, logger *slog.Logger) (map[string]string, error) {
+	descriptions := make(map[string]string, len(allData))
+	var attempted int
+	var lastErr error
+
+	for _, data := range allData {
+		if data.IssueBody == "" {
+			continue
+		}
+		attempted++
+
+		description, err := summarizer.Describe(ctx, data.IssueTitle, data.IssueURL, data.IssueBody)
+		if err != nil {
+			logger.Warn("Description failed, using fallback", "issue", data.IssueURL, "error", err)
+			lastErr = err
+			continue
+		}
+		descriptions[data.IssueURL] = description
+	}
+
+	if attempted > 0 && len(descriptions) == 0 {
+		return descriptions, lastErr
+	}
+
+	logger.Info("Per-issue description completed", "descriptions", len(descriptions), "attempted", attempted)
+	return descriptions, nil
+}
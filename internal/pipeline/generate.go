@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/Attamusc/weekly-report-cli/internal/ai"
@@ -21,7 +22,146 @@ type IssueFetcher interface {
 }
 
 // CollectIssueData fetches GitHub data and extracts reports without AI summarization.
-func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.IssueRef, since time.Time, sinceDays int) (IssueData, error) {
+func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.IssueRef, since time.Time, sinceDays int, opts CollectOptions) (IssueData, error) {
+	result, err := collectIssueData(ctx, fetcher, ref, since, sinceDays, opts)
+	if err != nil || result.Excluded {
+		return result, err
+	}
+	since, _ = issueWindow(result.IssueState, since, sinceDays, opts)
+	ApplyStatusField(&result, opts.StatusField)
+	ApplyTargetDateField(&result, opts.TargetDateField, opts.Location)
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	ApplyOverdueTarget(&result, now)
+	ApplyMissingTargetDate(&result)
+	ApplyUnknownStatus(&result)
+
+	if result.Note != nil && format.IsNoUpdateKind(result.Note.Kind) {
+		if last, ok := lastUpdateTime(ctx, fetcher, ref, opts); ok && last.Before(since) {
+			result.Note.DaysAgo = derive.DaysSince(last.In(location(opts)), now)
+		}
+	}
+
+	// Notes link the issue by its title
+	for _, note := range []*format.Note{result.Note, result.OverdueNote, result.MissingTargetNote, result.UnknownStatusNote} {
+		if note != nil {
+			note.IssueTitle = result.IssueTitle
+		}
+	}
+
+	return result, nil
+}
+
+// issueWindow returns the reporting window for an issue in the given state.
+// Closed issues use opts.DoneSinceDays when set, so a final report posted
+// weeks before closing is still picked up.
+func issueWindow(state string, since time.Time, sinceDays int, opts CollectOptions) (time.Time, int) {
+	if state != github.StateClosed || opts.DoneSinceDays <= 0 {
+		return since, sinceDays
+	}
+	return since.AddDate(0, 0, sinceDays-opts.DoneSinceDays), opts.DoneSinceDays
+}
+
+// fetchIssueAndComments fetches an issue and its comments concurrently. The
+// issue's state isn't known until the fetch returns, so comments are fetched
+// from the wider of the open and closed windows; callers trim them with
+// commentsSince. The first failure cancels the other call, and when both fail
+// the issue error is reported, as it would be sequentially.
+func fetchIssueAndComments(ctx context.Context, fetcher IssueFetcher, ref input.IssueRef, since time.Time, sinceDays int, opts CollectOptions) (github.IssueData, []github.Comment, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	commentsFrom := since
+	if opts.DoneSinceDays > sinceDays {
+		commentsFrom, _ = issueWindow(github.StateClosed, since, sinceDays, opts)
+	}
+
+	var issueData github.IssueData
+	var issueErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		issueData, issueErr = fetcher.FetchIssue(ctx, ref)
+		if issueErr != nil {
+			cancel()
+		}
+	}()
+
+	comments, commentsErr := fetcher.FetchCommentsSince(ctx, ref, commentsFrom)
+	if commentsErr != nil {
+		cancel()
+	}
+	<-done
+
+	if issueErr != nil {
+		return github.IssueData{}, nil, fmt.Errorf("failed to fetch issue: %w", issueErr)
+	}
+	if commentsErr != nil {
+		return github.IssueData{}, nil, fmt.Errorf("failed to fetch comments: %w", commentsErr)
+	}
+	return issueData, comments, nil
+}
+
+// location returns the timezone target dates are handled in
+func location(opts CollectOptions) *time.Location {
+	if opts.Location == nil {
+		return time.UTC
+	}
+	return opts.Location
+}
+
+// inLocation returns a copy of t in loc, or nil for a nil t
+func inLocation(t *time.Time, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	in := t.In(loc)
+	return &in
+}
+
+// commentsSince drops comments posted before since
+func commentsSince(comments []github.Comment, since time.Time) []github.Comment {
+	kept := comments[:0:0]
+	for _, comment := range comments {
+		if !comment.CreatedAt.Before(since) {
+			kept = append(kept, comment)
+		}
+	}
+	return kept
+}
+
+// lastUpdateTime looks outside the reporting window for the newest structured
+// report, falling back to the newest comment of any kind; both only consider
+// opts.ReportAuthors when set. The in-window fetch can't answer this, so the
+// issue's full comment history is fetched again.
+func lastUpdateTime(ctx context.Context, fetcher IssueFetcher, ref input.IssueRef, opts CollectOptions) (time.Time, bool) {
+	comments, err := fetcher.FetchCommentsSince(ctx, ref, time.Time{})
+	if err != nil {
+		if logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger); ok {
+			logger.Debug("Failed to fetch comment history", "url", ref.URL, "error", err)
+		}
+		return time.Time{}, false
+	}
+
+	comments = report.FilterByAuthor(comments, opts.ReportAuthors)
+	if reports := report.SelectReportsWithKeys(comments, time.Time{}, nil, opts.ReportKeys); len(reports) > 0 {
+		return reports[0].CreatedAt, true
+	}
+
+	var newest time.Time
+	for _, comment := range comments {
+		if comment.CreatedAt.After(newest) {
+			newest = comment.CreatedAt
+		}
+	}
+	return newest, !newest.IsZero()
+}
+
+// collectIssueData derives status, summary inputs, and the primary note for an issue.
+func collectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.IssueRef, since time.Time, sinceDays int, opts CollectOptions) (IssueData, error) {
 	logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger)
 	if !ok {
 		logger = slog.Default()
@@ -29,24 +169,43 @@ func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.Issue
 
 	logger.Debug("Collecting issue data", "url", ref.URL)
 
-	issueData, err := fetcher.FetchIssue(ctx, ref)
-	if err != nil {
-		return IssueData{}, fmt.Errorf("failed to fetch issue: %w", err)
-	}
+	var issueData github.IssueData
+	var comments []github.Comment
+	var err error
+	if opts.ParallelFetch {
+		issueData, comments, err = fetchIssueAndComments(ctx, fetcher, ref, since, sinceDays, opts)
+		if err != nil {
+			return IssueData{}, err
+		}
+		since, sinceDays = issueWindow(issueData.State, since, sinceDays, opts)
+		comments = commentsSince(comments, since)
+	} else {
+		issueData, err = fetcher.FetchIssue(ctx, ref)
+		if err != nil {
+			return IssueData{}, fmt.Errorf("failed to fetch issue: %w", err)
+		}
 
-	comments, err := fetcher.FetchCommentsSince(ctx, ref, since)
-	if err != nil {
-		return IssueData{}, fmt.Errorf("failed to fetch comments: %w", err)
+		since, sinceDays = issueWindow(issueData.State, since, sinceDays, opts)
+
+		comments, err = fetcher.FetchCommentsSince(ctx, ref, since)
+		if err != nil {
+			return IssueData{}, fmt.Errorf("failed to fetch comments: %w", err)
+		}
 	}
 
-	reports := report.SelectReports(comments, since)
+	// Every selector below, fallbacks included, only sees allowed authors
+	hadComments := len(comments) > 0
+	comments = report.FilterByAuthor(comments, opts.ReportAuthors)
+	reports := report.SelectReportsWithKeys(comments, since, nil, opts.ReportKeys)
 
 	result := IssueData{
 		IssueURL:     ref.URL,
+		IssueNumber:  ref.Number,
 		IssueTitle:   issueData.Title,
 		IssueState:   issueData.State,
+		IssueBody:    issueData.Body,
 		CreatedAt:    issueData.CreatedAt,
-		ClosedAt:     issueData.ClosedAt,
+		ClosedAt:     inLocation(issueData.ClosedAt, location(opts)),
 		CloseReason:  issueData.CloseReason,
 		Labels:       issueData.Labels,
 		Assignees:    issueData.Assignees,
@@ -54,9 +213,22 @@ func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.Issue
 		Reports:      reports,
 	}
 
+	// The newest structured report can opt the issue out of this report. Only
+	// comments that count as reports (marker present, allowed author) are
+	// consulted, so an exclude key elsewhere in the thread is ignored.
+	if len(reports) > 0 && strings.EqualFold(reports[0].Extra(report.KeyExclude), "true") {
+		return IssueData{
+			IssueURL:    ref.URL,
+			IssueNumber: ref.Number,
+			IssueTitle:  issueData.Title,
+			IssueState:  issueData.State,
+			Excluded:    true,
+		}, nil
+	}
+
 	// Case 1: No structured reports found
 	if len(reports) == 0 {
-		semiReports := report.SelectSemiStructuredReports(comments, since)
+		semiReports := report.SelectSemiStructuredReportsWithKeys(comments, since, opts.ReportKeys)
 		if len(semiReports) > 0 {
 			reports = semiReports
 			result.Reports = reports
@@ -71,7 +243,7 @@ func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.Issue
 		if issueData.State == github.StateClosed {
 			result.Status = derive.Done
 			result.ReportedStatusCaption = derive.Done.Caption
-			result.TargetDate = issueData.ClosedAt
+			result.TargetDate = result.ClosedAt
 			result.ShouldSummarize = false
 			result.FallbackSummary = SummaryCompleted
 		} else if commentBody, ok := report.SelectMostRecentComment(comments); ok {
@@ -86,28 +258,59 @@ func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.Issue
 			}
 		} else {
 			ApplyNoCommentFallback(&result, ref.URL, since, sinceDays,
-				fmt.Sprintf("No update provided in last %d days", sinceDays))
+				renderMessage(opts.NoUpdateMessage, defaultNoUpdateTemplate, sinceDays))
+			// Separate silent issues from ones whose comments just aren't reports
+			if !hadComments && result.Note.Kind == format.NoteNoUpdatesInWindow {
+				result.Note.Kind = format.NoteNoCommentsInWindow
+			}
 		}
 
-		ApplyLabelFallback(&result, ref.URL)
+		ApplyLabelFallback(&result, ref.URL, opts.StatusLabelPrefix)
+		applyBodyFallback(&result, issueData.Body, opts)
 		return result, nil
 	}
 
-	// Case 2: Reports exist - collect update texts
+	// Case 2: Reports exist - collect update texts, skipping ones too short to be useful
 	var updateTexts []string
+	var shortUpdates int
+	seenUpdates := make(map[string]bool)
 	for _, rep := range reports {
-		if rep.UpdateRaw != "" {
-			updateTexts = append(updateTexts, rep.UpdateRaw)
+		text := summarizeText(rep, opts.SummarizeKey)
+		// An empty update has zero words, so it counts as short too
+		if opts.MinUpdateWords > 0 && len(strings.Fields(text)) < opts.MinUpdateWords {
+			shortUpdates++
+			continue
+		}
+		if text == "" {
+			continue
+		}
+		// Reports are newest first, so the newest copy of a repeated update is kept
+		if !opts.NoDedupUpdates {
+			normalized := normalizeUpdate(text)
+			if seenUpdates[normalized] {
+				continue
+			}
+			seenUpdates[normalized] = true
 		}
+		updateTexts = append(updateTexts, text)
 	}
 	result.UpdateTexts = updateTexts
 
+	// Every update was below --min-update-words: treat as no structured update
+	if len(updateTexts) == 0 && shortUpdates > 0 && issueData.State != github.StateClosed {
+		ApplyNoCommentFallback(&result, ref.URL, since, sinceDays,
+			renderMessage(opts.NoStructuredUpdateMessage, defaultNoStructuredUpdateTemplate, sinceDays))
+		applyBodyFallback(&result, issueData.Body, opts)
+		return result, nil
+	}
+
 	newestReport := reports[0]
-	result.Status = derive.MapTrending(newestReport.TrendingRaw)
+	result.Status = reportStatus(newestReport, logger)
 	result.ReportedStatusCaption = result.Status.Caption
-	result.TargetDate = derive.ParseTargetDate(newestReport.TargetDate)
+	result.TargetDate = reportTargetDate(reports, opts.TargetDatePolicy, opts.Location)
+	result.SummaryHint = newestReport.Extra(report.KeySummaryHint)
 
-	ApplyLabelFallback(&result, ref.URL)
+	ApplyLabelFallback(&result, ref.URL, opts.StatusLabelPrefix)
 
 	// Case 2a: Reports exist but no update text
 	if len(updateTexts) == 0 {
@@ -115,7 +318,7 @@ func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.Issue
 			result.Status = derive.Done
 			result.ReportedStatusCaption = derive.Done.Caption
 			if result.TargetDate == nil {
-				result.TargetDate = issueData.ClosedAt
+				result.TargetDate = result.ClosedAt
 			}
 			result.ShouldSummarize = false
 			result.FallbackSummary = SummaryCompleted
@@ -129,12 +332,14 @@ func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.Issue
 			}
 		} else {
 			ApplyNoCommentFallback(&result, ref.URL, since, sinceDays,
-				fmt.Sprintf("No structured update found in last %d days", sinceDays))
+				renderMessage(opts.NoStructuredUpdateMessage, defaultNoStructuredUpdateTemplate, sinceDays))
 		}
+		applyBodyFallback(&result, issueData.Body, opts)
 		return result, nil
 	}
 
 	// Case 2b: Reports with update text
+	result.UpdateSourceURL = newestReport.SourceURL
 	if result.Status == derive.Done || issueData.State == github.StateClosed {
 		if issueData.State == github.StateClosed {
 			result.Status = derive.Done
@@ -142,11 +347,16 @@ func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.Issue
 		}
 		result.ShouldSummarize = false
 		result.FallbackSummary = SummaryCompleted
+	} else if strings.EqualFold(newestReport.Extra(report.KeyVerbatim), "true") {
+		// Author asked for the update to be published as written
+		result.ShouldSummarize = false
+		result.FallbackSummary = updateTexts[0]
 	} else {
 		result.ShouldSummarize = true
 		result.FallbackSummary = updateTexts[0]
 	}
 
+	result.UpdateCount = len(reports)
 	if len(reports) >= 2 {
 		result.Note = &format.Note{
 			Kind:      format.NoteMultipleUpdates,
@@ -158,6 +368,41 @@ func CollectIssueData(ctx context.Context, fetcher IssueFetcher, ref input.Issue
 	return result, nil
 }
 
+// reportTargetDate picks the target date of reports (newest first) according
+// to policy, read in loc. The earliest and latest policies skip reports without
+// a parseable date, returning nil only when none has one.
+func reportTargetDate(reports []report.Report, policy string, loc *time.Location) *time.Time {
+	if policy != TargetDateEarliest && policy != TargetDateLatest {
+		return derive.ParseTargetDateIn(reports[0].TargetDate, loc)
+	}
+
+	var chosen *time.Time
+	for _, rep := range reports {
+		date := derive.ParseTargetDateIn(rep.TargetDate, loc)
+		if date == nil {
+			continue
+		}
+		if chosen == nil ||
+			(policy == TargetDateEarliest && date.Before(*chosen)) ||
+			(policy == TargetDateLatest && date.After(*chosen)) {
+			chosen = date
+		}
+	}
+	return chosen
+}
+
+// reportStatus maps a report's status_override when it names a known status,
+// otherwise its trending value
+func reportStatus(rep report.Report, logger *slog.Logger) derive.Status {
+	if override := rep.Extra(report.KeyStatusOverride); override != "" {
+		if status := derive.MapTrending(override); status != derive.Unknown {
+			return status
+		}
+		logger.Debug("Ignoring unrecognized status override", "override", override, "url", rep.SourceURL)
+	}
+	return derive.MapTrending(rep.TrendingRaw)
+}
+
 // ApplyNoCommentFallback sets the result fields for an issue with no usable comments.
 func ApplyNoCommentFallback(result *IssueData, issueURL string, since time.Time, sinceDays int, noUpdateMsg string) {
 	if !result.CreatedAt.IsZero() && result.CreatedAt.After(since) {
@@ -182,13 +427,36 @@ func ApplyNoCommentFallback(result *IssueData, issueURL string, since time.Time,
 	}
 }
 
-// ApplyLabelFallback checks whether the issue status is Unknown and attempts
-// to derive a status from the issue labels.
-func ApplyLabelFallback(result *IssueData, issueURL string) {
-	if result.Status != derive.Unknown {
+// applyBodyFallback replaces the "no update" message with an excerpt of the
+// issue body when opts.BodyFallback is set and the issue had no update in the window
+func applyBodyFallback(result *IssueData, body string, opts CollectOptions) {
+	if !opts.BodyFallback || result.Note == nil || !format.IsNoUpdateKind(result.Note.Kind) {
+		return
+	}
+	if excerpt := truncateBody(strings.Join(strings.Fields(body), " "), bodyFallbackLength); excerpt != "" {
+		result.FallbackSummary = excerpt
+	}
+}
+
+// truncateBody trims body and cuts it to at most limit characters, marking a cut with "..."
+func truncateBody(body string, limit int) string {
+	body = strings.TrimSpace(body)
+	if runes := []rune(body); len(runes) > limit {
+		return string(runes[:limit]) + "..."
+	}
+	return body
+}
+
+// ApplyLabelFallback checks whether the issue has no comment-derived status
+// and attempts to derive a status from the issue labels. An Unknown status is
+// always eligible; NeedsUpdate is only replaced when prefix is non-empty, since
+// only dedicated status labels are trusted over a missing update. When prefix
+// is non-empty only labels carrying that prefix are considered.
+func ApplyLabelFallback(result *IssueData, issueURL string, prefix string) {
+	if result.Status != derive.Unknown && (result.Status != derive.NeedsUpdate || prefix == "") {
 		return
 	}
-	labelStatus, ok := derive.MapLabelsToStatus(result.Labels)
+	labelStatus, ok := derive.MapLabelsToStatus(result.Labels, prefix)
 	if !ok {
 		return
 	}
@@ -202,6 +470,86 @@ func ApplyLabelFallback(result *IssueData, issueURL string) {
 	}
 }
 
+// ApplyStatusField replaces the derived status with the value of the named
+// project field, mapped with derive.MapTrending so option names such as
+// "🟢 On Track" work. Items without the field, or with a value that doesn't
+// map, keep the status derived from their reports.
+func ApplyStatusField(result *IssueData, field string) {
+	if field == "" {
+		return
+	}
+	status := derive.MapTrending(result.ExtraColumns[field])
+	if status == derive.Unknown {
+		return
+	}
+
+	result.Status = status
+	result.ReportedStatusCaption = status.Caption
+	if status == derive.Done && len(result.UpdateTexts) > 0 {
+		result.ShouldSummarize = false
+		result.FallbackSummary = SummaryCompleted
+	}
+	// The field decided the status, so a label fallback note no longer applies
+	if result.Note != nil && result.Note.Kind == format.NoteLabelFallback {
+		result.Note = nil
+	}
+}
+
+// ApplyTargetDateField replaces the target date with the value of the named
+// project date field, read in loc. Items without the field, or with a value
+// that doesn't parse, keep the target date from their reports.
+func ApplyTargetDateField(result *IssueData, field string, loc *time.Location) {
+	if field == "" {
+		return
+	}
+	if date := derive.ParseTargetDateIn(result.ExtraColumns[field], loc); date != nil {
+		result.TargetDate = date
+	}
+}
+
+// ApplyUnknownStatus records a note when the newest report's trending value
+// didn't map to a status and no fallback (issue state, labels) replaced it.
+func ApplyUnknownStatus(result *IssueData) {
+	if result.Status != derive.Unknown || len(result.Reports) == 0 {
+		return
+	}
+	result.UnknownStatusNote = &format.Note{
+		Kind:           format.NoteUnknownStatus,
+		IssueURL:       result.IssueURL,
+		ReportedStatus: strings.TrimSpace(result.Reports[0].TrendingRaw),
+	}
+}
+
+// ApplyOverdueTarget records an overdue note when the issue's target date is
+// before today and the issue is not done. A target date of today is not overdue.
+func ApplyOverdueTarget(result *IssueData, now time.Time) {
+	if result.TargetDate == nil || result.Status == derive.Done {
+		return
+	}
+	days := derive.DaysSince(*result.TargetDate, now)
+	if days <= 0 {
+		return
+	}
+	result.OverdueNote = &format.Note{
+		Kind:       format.NoteOverdueTarget,
+		IssueURL:   result.IssueURL,
+		TargetDate: result.TargetDate,
+		DaysAgo:    days,
+	}
+}
+
+// ApplyMissingTargetDate records a note when an issue that is not done has no
+// target date.
+func ApplyMissingTargetDate(result *IssueData) {
+	if result.TargetDate != nil || result.Status == derive.Done {
+		return
+	}
+	result.MissingTargetNote = &format.Note{
+		Kind:     format.NoteMissingTargetDate,
+		IssueURL: result.IssueURL,
+	}
+}
+
 // AssembleGenerateResults creates rows and notes from collected data and batch AI results.
 func AssembleGenerateResults(allData []IssueData, batchResults map[string]ai.BatchResult, sentiment bool, logger *slog.Logger) ([]format.Row, []format.Note) {
 	logger.Info("Creating final results...")
@@ -209,17 +557,21 @@ func AssembleGenerateResults(allData []IssueData, batchResults map[string]ai.Bat
 	var notes []format.Note
 
 	for _, data := range allData {
+		if data.Excluded {
+			continue
+		}
 		var summary string
 
 		if result, ok := batchResults[data.IssueURL]; ok {
 			summary = result.Summary
 
 			if sentiment && result.Sentiment != nil {
-				suggestedStatus, valid := derive.ParseStatusKey(result.Sentiment.SuggestedStatus)
+				suggestedStatus, valid := derive.MapSentimentStatus(result.Sentiment.SuggestedStatus)
 				if valid && suggestedStatus != data.Status {
 					notes = append(notes, format.Note{
 						Kind:            format.NoteSentimentMismatch,
 						IssueURL:        data.IssueURL,
+						IssueTitle:      data.IssueTitle,
 						ReportedStatus:  data.ReportedStatusCaption,
 						SuggestedStatus: suggestedStatus.Caption,
 						Explanation:     result.Sentiment.Explanation,
@@ -228,12 +580,21 @@ func AssembleGenerateResults(allData []IssueData, batchResults map[string]ai.Bat
 			}
 		}
 
+		// Without an AI summary, keep every update so the table can list them
+		rawFallback := summary == "" && data.ShouldSummarize
+		var updateItems []string
+		if rawFallback && len(data.UpdateTexts) > 1 {
+			updateItems = data.UpdateTexts
+		}
+
 		if summary == "" {
 			summary = data.FallbackSummary
 		}
 
 		result := CreateResultFromData(data, summary)
 		if result.Row != nil {
+			result.Row.UpdateItems = updateItems
+			result.Row.RawFallback = rawFallback
 			rows = append(rows, *result.Row)
 			logger.Debug("Added report row", "issue", result.IssueURL)
 		}
@@ -241,6 +602,18 @@ func AssembleGenerateResults(allData []IssueData, batchResults map[string]ai.Bat
 			notes = append(notes, *result.Note)
 			logger.Debug("Added note", "issue", result.IssueURL, "kind", result.Note.Kind)
 		}
+		if data.OverdueNote != nil {
+			notes = append(notes, *data.OverdueNote)
+			logger.Debug("Added note", "issue", data.IssueURL, "kind", data.OverdueNote.Kind)
+		}
+		if data.MissingTargetNote != nil {
+			notes = append(notes, *data.MissingTargetNote)
+			logger.Debug("Added note", "issue", data.IssueURL, "kind", data.MissingTargetNote.Kind)
+		}
+		if data.UnknownStatusNote != nil {
+			notes = append(notes, *data.UnknownStatusNote)
+			logger.Debug("Added note", "issue", data.IssueURL, "kind", data.UnknownStatusNote.Kind)
+		}
 	}
 
 	logger.Info("Results created successfully", "rows", len(rows), "notes", len(notes))
@@ -254,6 +627,9 @@ func CreateResultFromData(data IssueData, summary string) IssueResult {
 	}
 
 	row := format.NewRow(data.Status, data.IssueTitle, data.IssueURL, data.TargetDate, summary)
+	row.Number = data.IssueNumber
+	row.UpdateSourceURL = data.UpdateSourceURL
+	row.UpdateCount = data.UpdateCount
 	row.Assignees = data.Assignees
 	row.Labels = data.Labels
 	row.ExtraColumns = data.ExtraColumns
@@ -265,6 +641,21 @@ func CreateResultFromData(data IssueData, summary string) IssueResult {
 	}
 }
 
+// MissingBatchResults returns the URLs of issues that were eligible for AI
+// summarization but have no entry in batchResults, in collection order.
+func MissingBatchResults(allData []IssueData, batchResults map[string]ai.BatchResult) []string {
+	var missing []string
+	for _, data := range allData {
+		if !data.ShouldSummarize || len(data.UpdateTexts) == 0 {
+			continue
+		}
+		if _, ok := batchResults[data.IssueURL]; !ok {
+			missing = append(missing, data.IssueURL)
+		}
+	}
+	return missing
+}
+
 // BatchSummarize summarizes all collected issue data in a single API call.
 func BatchSummarize(ctx context.Context, summarizer ai.Summarizer, allData []IssueData, logger *slog.Logger) (map[string]ai.BatchResult, error) {
 	var batchItems []ai.BatchItem
@@ -275,6 +666,7 @@ func BatchSummarize(ctx context.Context, summarizer ai.Summarizer, allData []Iss
 				IssueTitle:     data.IssueTitle,
 				UpdateTexts:    data.UpdateTexts,
 				ReportedStatus: data.ReportedStatusCaption,
+				Hint:           data.SummaryHint,
 			})
 		}
 	}
@@ -294,3 +686,19 @@ func BatchSummarize(ctx context.Context, summarizer ai.Summarizer, allData []Iss
 	logger.Info("Batch summarization completed", "summaries", len(summaries))
 	return summaries, nil
 }
+
+// summarizeText returns the report text that feeds the summarizer: the value
+// of key when the report has it, otherwise the update
+func summarizeText(rep report.Report, key string) string {
+	if key != "" && key != SummarizeKeyUpdate {
+		if text := rep.Extra(key); text != "" {
+			return text
+		}
+	}
+	return rep.UpdateRaw
+}
+
+// normalizeUpdate folds case and whitespace so reposted boilerplate compares equal
+func normalizeUpdate(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
@@ -2,10 +2,15 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"text/template"
 	"time"
+	"unicode/utf8"
 
 	"github.com/Attamusc/weekly-report-cli/internal/ai"
 	"github.com/Attamusc/weekly-report-cli/internal/derive"
@@ -25,8 +30,17 @@ func (m *mockFetcher) FetchIssue(_ context.Context, _ input.IssueRef) (github.Is
 	return m.issue, m.err
 }
 
-func (m *mockFetcher) FetchCommentsSince(_ context.Context, _ input.IssueRef, _ time.Time) ([]github.Comment, error) {
-	return m.comments, m.err
+func (m *mockFetcher) FetchCommentsSince(_ context.Context, _ input.IssueRef, since time.Time) ([]github.Comment, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	var comments []github.Comment
+	for _, c := range m.comments {
+		if !c.CreatedAt.Before(since) {
+			comments = append(comments, c)
+		}
+	}
+	return comments, nil
 }
 
 // makeRef creates a test IssueRef.
@@ -56,7 +70,7 @@ func TestCollectIssueData_ClosedNoReports(t *testing.T) {
 			ClosedAt: &closedAt,
 		},
 	}
-	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/1"), since, sinceDays)
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/1"), since, sinceDays, CollectOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -79,7 +93,7 @@ func TestCollectIssueData_NewIssueShaping(t *testing.T) {
 			CreatedAt: now.AddDate(0, 0, -2), // created within the window
 		},
 	}
-	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/2"), since, sinceDays)
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/2"), since, sinceDays, CollectOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -99,15 +113,65 @@ func TestCollectIssueData_OldIssueNeedsUpdate(t *testing.T) {
 			CreatedAt: now.AddDate(0, 0, -30),
 		},
 	}
-	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/3"), since, sinceDays)
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/3"), since, sinceDays, CollectOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
 	if data.Status != derive.NeedsUpdate {
 		t.Errorf("expected NeedsUpdate, got %v", data.Status)
 	}
-	if data.Note == nil || data.Note.Kind != format.NoteNoUpdatesInWindow {
-		t.Error("expected NoteNoUpdatesInWindow note")
+	if data.Note == nil || data.Note.Kind != format.NoteNoCommentsInWindow {
+		t.Error("expected NoteNoCommentsInWindow note")
+	}
+}
+
+func TestCollectIssueData_NoCommentsVsCommentsWithoutReports(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments []github.Comment
+		opts     CollectOptions
+		want     format.NoteKind
+	}{
+		{name: "no comments", want: format.NoteNoCommentsInWindow},
+		{
+			name:     "comment outside the window",
+			comments: []github.Comment{{Body: "old news", CreatedAt: now.AddDate(0, 0, -20)}},
+			want:     format.NoteNoCommentsInWindow,
+		},
+		{
+			name:     "blank comment without a report",
+			comments: []github.Comment{{Body: "   ", CreatedAt: now.AddDate(0, 0, -1)}},
+			want:     format.NoteNoUpdatesInWindow,
+		},
+		{
+			name:     "report below the word threshold",
+			comments: []github.Comment{{Body: makeReport("🟢 on track", "Shipped"), CreatedAt: now.AddDate(0, 0, -1)}},
+			opts:     CollectOptions{MinUpdateWords: 3},
+			want:     format.NoteNoUpdatesInWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue: github.IssueData{
+					Title:     "Quiet Issue",
+					State:     github.StateOpen,
+					CreatedAt: now.AddDate(0, 0, -30),
+				},
+				comments: tt.comments,
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/41"), since, sinceDays, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.Status != derive.NeedsUpdate {
+				t.Errorf("expected NeedsUpdate, got %v", data.Status)
+			}
+			if data.Note == nil || data.Note.Kind != tt.want {
+				t.Errorf("expected note kind %v, got %+v", tt.want, data.Note)
+			}
+		})
 	}
 }
 
@@ -122,7 +186,7 @@ func TestCollectIssueData_ReportsWithUpdate_ActiveIssue(t *testing.T) {
 			{Body: makeReport("🟢 on track", "Made progress this week"), CreatedAt: commentTime},
 		},
 	}
-	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/4"), since, sinceDays)
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/4"), since, sinceDays, CollectOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -145,7 +209,7 @@ func TestCollectIssueData_ReportsWithUpdate_DoneIssue(t *testing.T) {
 			{Body: makeReport("🟣 done", "Completed everything"), CreatedAt: commentTime},
 		},
 	}
-	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/5"), since, sinceDays)
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/5"), since, sinceDays, CollectOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -157,6 +221,71 @@ func TestCollectIssueData_ReportsWithUpdate_DoneIssue(t *testing.T) {
 	}
 }
 
+func TestCollectIssueData_DoneSinceDays(t *testing.T) {
+	closedAt := now.AddDate(0, 0, -2)
+	fetcher := &mockFetcher{
+		issue: github.IssueData{
+			Title:    "Shipped Epic",
+			State:    github.StateClosed,
+			ClosedAt: &closedAt,
+		},
+		comments: []github.Comment{
+			{Body: makeReport("🟣 done", "Rolled out to all regions"), CreatedAt: now.AddDate(0, 0, -20)},
+		},
+	}
+	ref := makeRef("https://github.com/o/r/issues/30")
+
+	// Default window: the 20-day-old report is out of range
+	data, err := CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{Now: now})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.Reports) != 0 {
+		t.Errorf("expected no reports in a %d-day window, got %d", sinceDays, len(data.Reports))
+	}
+	if data.FallbackSummary != SummaryCompleted {
+		t.Errorf("expected %q, got %q", SummaryCompleted, data.FallbackSummary)
+	}
+
+	// Done window: the report is picked up
+	data, err = CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{Now: now, DoneSinceDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.Reports) != 1 {
+		t.Fatalf("expected the report within a 30-day done window, got %d", len(data.Reports))
+	}
+	if len(data.UpdateTexts) != 1 || data.UpdateTexts[0] != "Rolled out to all regions" {
+		t.Errorf("unexpected UpdateTexts: %v", data.UpdateTexts)
+	}
+	if data.Status != derive.Done {
+		t.Errorf("expected Done, got %v", data.Status)
+	}
+}
+
+func TestCollectIssueData_DoneSinceDaysIgnoresOpenIssues(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{
+			Title:     "Quiet Epic",
+			State:     github.StateOpen,
+			CreatedAt: now.AddDate(0, -2, 0),
+		},
+		comments: []github.Comment{
+			{Body: makeReport("🟢 on track", "Still going"), CreatedAt: now.AddDate(0, 0, -20)},
+		},
+	}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/31"), since, sinceDays, CollectOptions{Now: now, DoneSinceDays: 30})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.Reports) != 0 {
+		t.Errorf("expected open issue to keep the %d-day window, got %d reports", sinceDays, len(data.Reports))
+	}
+	if data.Status != derive.NeedsUpdate {
+		t.Errorf("expected NeedsUpdate, got %v", data.Status)
+	}
+}
+
 func TestCollectIssueData_SemiStructuredFallback(t *testing.T) {
 	commentTime := now.AddDate(0, 0, -1)
 	fetcher := &mockFetcher{
@@ -169,7 +298,7 @@ func TestCollectIssueData_SemiStructuredFallback(t *testing.T) {
 			{Body: "## Update\nDid some work this week", CreatedAt: commentTime},
 		},
 	}
-	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/6"), since, sinceDays)
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/6"), since, sinceDays, CollectOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -182,6 +311,31 @@ func TestCollectIssueData_SemiStructuredFallback(t *testing.T) {
 	}
 }
 
+func TestCollectIssueData_ReportAuthorsAppliesToFallbacks(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{
+			Title:     "Allowlisted Issue",
+			State:     github.StateOpen,
+			CreatedAt: now.AddDate(0, 0, -30),
+		},
+		comments: []github.Comment{
+			{Body: "## Update\nDrive-by status from someone else", Author: "passer-by", CreatedAt: now.AddDate(0, 0, -2)},
+			{Body: "Plain comment from someone else", Author: "passer-by", CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	opts := CollectOptions{ReportAuthors: []string{"epic-owner"}}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/6"), since, sinceDays, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.Reports) != 0 || len(data.UpdateTexts) != 0 {
+		t.Errorf("expected nothing from non-allowed authors, got reports=%d updates=%v", len(data.Reports), data.UpdateTexts)
+	}
+	if data.Note == nil || data.Note.Kind != format.NoteNoUpdatesInWindow {
+		t.Errorf("expected NoteNoUpdatesInWindow, got %+v", data.Note)
+	}
+}
+
 func TestCollectIssueData_LabelFallback(t *testing.T) {
 	fetcher := &mockFetcher{
 		issue: github.IssueData{
@@ -194,7 +348,7 @@ func TestCollectIssueData_LabelFallback(t *testing.T) {
 			{Body: "Just a plain comment, no structure", CreatedAt: now.AddDate(0, 0, -1)},
 		},
 	}
-	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/7"), since, sinceDays)
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/7"), since, sinceDays, CollectOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -206,7 +360,7 @@ func TestCollectIssueData_LabelFallback(t *testing.T) {
 
 func TestCollectIssueData_FetchError(t *testing.T) {
 	fetcher := &mockFetcher{err: fmt.Errorf("network error")}
-	_, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/8"), since, sinceDays)
+	_, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/8"), since, sinceDays, CollectOptions{})
 	if err == nil {
 		t.Error("expected error from failed fetch")
 	}
@@ -225,7 +379,7 @@ func TestCollectIssueData_MultipleReports(t *testing.T) {
 			{Body: makeReport("🟢 on track", "Earlier update"), CreatedAt: t2},
 		},
 	}
-	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/9"), since, sinceDays)
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/9"), since, sinceDays, CollectOptions{})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -237,6 +391,66 @@ func TestCollectIssueData_MultipleReports(t *testing.T) {
 	}
 }
 
+// TestCollectAndAssemble runs the generate pipeline from fetch to table row
+// through the IssueFetcher seam, the way cmd wires it, without a GitHub server.
+func TestCollectAndAssemble(t *testing.T) {
+	tests := []struct {
+		name       string
+		comments   []github.Comment
+		wantStatus derive.Status
+		wantUpdate string
+		wantNotes  []format.NoteKind
+	}{
+		{
+			name:       "no reports",
+			wantStatus: derive.NeedsUpdate,
+			wantUpdate: "No update provided in last 7 days",
+			wantNotes:  []format.NoteKind{format.NoteNoCommentsInWindow, format.NoteMissingTargetDate},
+		},
+		{
+			name: "multiple reports",
+			comments: []github.Comment{
+				{Body: makeReport("🟡 at risk", "Blocked on review"), CreatedAt: now.AddDate(0, 0, -1)},
+				{Body: makeReport("🟢 on track", "Design approved"), CreatedAt: now.AddDate(0, 0, -4)},
+			},
+			wantStatus: derive.AtRisk,
+			wantUpdate: "Blocked on review",
+			wantNotes:  []format.NoteKind{format.NoteMultipleUpdates, format.NoteMissingTargetDate},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue:    github.IssueData{Title: "Pipeline Epic", State: github.StateOpen, CreatedAt: now.AddDate(0, -1, 0)},
+				comments: tt.comments,
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/40"), since, sinceDays, CollectOptions{Now: now})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			rows, notes := AssembleGenerateResults([]IssueData{data}, map[string]ai.BatchResult{}, false, slog.Default())
+			if len(rows) != 1 {
+				t.Fatalf("expected 1 row, got %d", len(rows))
+			}
+			if rows[0].StatusCaption != tt.wantStatus.Caption {
+				t.Errorf("expected status %q, got %q", tt.wantStatus.Caption, rows[0].StatusCaption)
+			}
+			if rows[0].UpdateMD != tt.wantUpdate {
+				t.Errorf("expected update %q, got %q", tt.wantUpdate, rows[0].UpdateMD)
+			}
+			var kinds []format.NoteKind
+			for _, note := range notes {
+				kinds = append(kinds, note.Kind)
+			}
+			if fmt.Sprint(kinds) != fmt.Sprint(tt.wantNotes) {
+				t.Errorf("expected notes of kinds %v, got %+v", tt.wantNotes, notes)
+			}
+		})
+	}
+}
+
 func TestAssembleGenerateResults_WithBatchResults(t *testing.T) {
 	logger := slog.Default()
 	allData := []IssueData{
@@ -281,6 +495,38 @@ func TestAssembleGenerateResults_FallbackWhenNoAI(t *testing.T) {
 	}
 }
 
+func TestAssembleGenerateResults_UpdateItemsWithoutAI(t *testing.T) {
+	logger := slog.Default()
+	allData := []IssueData{
+		{
+			IssueURL:        "https://github.com/o/r/issues/3",
+			Status:          derive.OnTrack,
+			UpdateTexts:     []string{"Newest", "Older"},
+			ShouldSummarize: true,
+			FallbackSummary: "Newest",
+		},
+		{
+			IssueURL:        "https://github.com/o/r/issues/4",
+			Status:          derive.OnTrack,
+			UpdateTexts:     []string{"Newest", "Older"},
+			ShouldSummarize: true,
+			FallbackSummary: "Newest",
+		},
+	}
+	batch := map[string]ai.BatchResult{"https://github.com/o/r/issues/4": {Summary: "AI summary"}}
+
+	rows, _ := AssembleGenerateResults(allData, batch, false, logger)
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if len(rows[0].UpdateItems) != 2 || rows[0].UpdateItems[1] != "Older" {
+		t.Errorf("expected both updates kept without an AI summary, got %v", rows[0].UpdateItems)
+	}
+	if rows[1].UpdateItems != nil {
+		t.Errorf("expected no update items when summarized, got %v", rows[1].UpdateItems)
+	}
+}
+
 func TestAssembleGenerateResults_WithNote(t *testing.T) {
 	logger := slog.Default()
 	allData := []IssueData{
@@ -332,3 +578,1227 @@ func TestCreateResultFromData_ThreadsMetadata(t *testing.T) {
 		t.Errorf("unexpected update: %q", row.UpdateMD)
 	}
 }
+
+// captureSummarizer records the batch items it receives.
+type captureSummarizer struct {
+	ai.NoopSummarizer
+	items []ai.BatchItem
+}
+
+func (c *captureSummarizer) SummarizeBatch(ctx context.Context, items []ai.BatchItem) (map[string]ai.BatchResult, error) {
+	c.items = append(c.items, items...)
+	return c.NoopSummarizer.SummarizeBatch(ctx, items)
+}
+
+func TestCollectIssueData_SummaryHint(t *testing.T) {
+	body := makeReport("🟢 on track", "Rolled out to 10% of users") +
+		"\n<!-- data key=\"summary_hint\" start -->Mention the rollout percentage<!-- data end -->"
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Rollout", State: github.StateOpen},
+		comments: []github.Comment{
+			{Body: body, CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/11"), since, sinceDays, CollectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.SummaryHint != "Mention the rollout percentage" {
+		t.Fatalf("expected summary hint to be extracted, got %q", data.SummaryHint)
+	}
+
+	summarizer := &captureSummarizer{}
+	if _, err := BatchSummarize(context.Background(), summarizer, []IssueData{data}, slog.Default()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(summarizer.items) != 1 || summarizer.items[0].Hint != "Mention the rollout percentage" {
+		t.Errorf("expected hint to reach the summarizer, got %+v", summarizer.items)
+	}
+}
+
+func TestCollectIssueData_SummarizeKey(t *testing.T) {
+	withSummary := makeReport("🟢 on track", "Migrated the billing tables, backfilled 3 years of invoices and cut over reads") +
+		"\n<!-- data key=\"summary\" value=\"Billing migration done\" -->"
+	updateOnly := makeReport("🟢 on track", "Rolled out to all regions")
+
+	tests := []struct {
+		name string
+		body string
+		key  string
+		want string
+	}{
+		{name: "default uses update", body: withSummary, key: "", want: "Migrated the billing tables, backfilled 3 years of invoices and cut over reads"},
+		{name: "update key", body: withSummary, key: SummarizeKeyUpdate, want: "Migrated the billing tables, backfilled 3 years of invoices and cut over reads"},
+		{name: "summary key", body: withSummary, key: SummarizeKeySummary, want: "Billing migration done"},
+		{name: "missing summary falls back to update", body: updateOnly, key: SummarizeKeySummary, want: "Rolled out to all regions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue: github.IssueData{Title: "Billing", State: github.StateOpen},
+				comments: []github.Comment{
+					{Body: tt.body, CreatedAt: now.AddDate(0, 0, -1)},
+				},
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/12"), since, sinceDays, CollectOptions{SummarizeKey: tt.key})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			summarizer := &captureSummarizer{}
+			if _, err := BatchSummarize(context.Background(), summarizer, []IssueData{data}, slog.Default()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(summarizer.items) != 1 || len(summarizer.items[0].UpdateTexts) != 1 || summarizer.items[0].UpdateTexts[0] != tt.want {
+				t.Errorf("expected %q to reach the summarizer, got %+v", tt.want, summarizer.items)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_DedupUpdates(t *testing.T) {
+	comments := []github.Comment{
+		{Body: makeReport("🟢 on track", "Waiting on vendor  review"), CreatedAt: now.AddDate(0, 0, -1)},
+		{Body: makeReport("🟢 on track", "Contract signed"), CreatedAt: now.AddDate(0, 0, -2)},
+		{Body: makeReport("🟢 on track", "waiting on vendor review"), CreatedAt: now.AddDate(0, 0, -3)},
+	}
+
+	tests := []struct {
+		name    string
+		noDedup bool
+		want    []string
+	}{
+		{name: "identical updates sent once", want: []string{"Waiting on vendor  review", "Contract signed"}},
+		{name: "no dedup keeps every update", noDedup: true, want: []string{"Waiting on vendor  review", "Contract signed", "waiting on vendor review"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue:    github.IssueData{Title: "Vendor", State: github.StateOpen},
+				comments: comments,
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/14"), since, sinceDays, CollectOptions{NoDedupUpdates: tt.noDedup})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			summarizer := &captureSummarizer{}
+			if _, err := BatchSummarize(context.Background(), summarizer, []IssueData{data}, slog.Default()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(summarizer.items) != 1 {
+				t.Fatalf("expected 1 batch item, got %d", len(summarizer.items))
+			}
+			if got := summarizer.items[0].UpdateTexts; strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("got update texts %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_Exclude(t *testing.T) {
+	exclude := "\n<!-- data key=\"exclude\" value=\"true\" -->"
+	tests := []struct {
+		name         string
+		comments     []github.Comment
+		wantExcluded bool
+	}{
+		{
+			name: "newest report excludes despite a valid trending",
+			comments: []github.Comment{
+				{Body: makeReport("🔴 off track", "Blocked on legal review") + exclude, CreatedAt: now.AddDate(0, 0, -1)},
+				{Body: makeReport("🟡 at risk", "Waiting on legal"), CreatedAt: now.AddDate(0, 0, -3)},
+			},
+			wantExcluded: true,
+		},
+		{
+			name: "newer report without the key wins over an older exclude",
+			comments: []github.Comment{
+				{Body: makeReport("🟢 on track", "Back on the board"), CreatedAt: now.AddDate(0, 0, -1)},
+				{Body: makeReport("🟢 on track", "Skip this week") + exclude, CreatedAt: now.AddDate(0, 0, -3)},
+			},
+		},
+		{
+			name: "key outside a report is ignored",
+			comments: []github.Comment{
+				{Body: "Please leave this out" + exclude, CreatedAt: now.AddDate(0, 0, -1)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue:    github.IssueData{Title: "Legal", State: github.StateOpen},
+				comments: tt.comments,
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/15"), since, sinceDays, CollectOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.Excluded != tt.wantExcluded {
+				t.Fatalf("got Excluded=%v, want %v", data.Excluded, tt.wantExcluded)
+			}
+
+			rows, notes := AssembleGenerateResults([]IssueData{data}, nil, false, slog.Default())
+			if tt.wantExcluded && (len(rows) != 0 || len(notes) != 0) {
+				t.Errorf("expected no row and no notes for an excluded issue, got %d rows and notes %+v", len(rows), notes)
+			}
+			if !tt.wantExcluded && len(rows) != 1 {
+				t.Errorf("expected 1 row, got %d", len(rows))
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_NoteCarriesTitle(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "User Auth", State: github.StateOpen, CreatedAt: now.AddDate(0, -1, 0)},
+	}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/16"), since, sinceDays, CollectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Note == nil || data.Note.IssueTitle != "User Auth" {
+		t.Errorf("expected the note to carry the issue title, got %+v", data.Note)
+	}
+}
+
+func TestCollectIssueData_Verbatim(t *testing.T) {
+	update := "Shipped **v2.1** to all regions\n- Rollback plan documented"
+	body := makeReport("🟢 on track", update) + "\n<!-- data key=\"verbatim\" value=\"true\" -->"
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Release", State: github.StateOpen},
+		comments: []github.Comment{
+			{Body: body, CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/13"), since, sinceDays, CollectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.ShouldSummarize {
+		t.Error("expected ShouldSummarize=false for verbatim report")
+	}
+
+	summarizer := &captureSummarizer{}
+	batchResults, err := BatchSummarize(context.Background(), summarizer, []IssueData{data}, slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(summarizer.items) != 0 {
+		t.Errorf("expected verbatim issue to skip the summarizer, got %+v", summarizer.items)
+	}
+
+	rows, _ := AssembleGenerateResults([]IssueData{data}, batchResults, false, slog.Default())
+	if len(rows) != 1 {
+		t.Fatalf("expected 1 row, got %d", len(rows))
+	}
+	if rows[0].UpdateMD != data.Reports[0].UpdateRaw {
+		t.Errorf("expected row to carry the raw update %q, got %q", data.Reports[0].UpdateRaw, rows[0].UpdateMD)
+	}
+}
+
+func TestCollectIssueData_StatusOverride(t *testing.T) {
+	tests := []struct {
+		name       string
+		override   string
+		wantStatus derive.Status
+	}{
+		{name: "override wins over trending", override: "off track", wantStatus: derive.OffTrack},
+		{name: "override accepts emoji form", override: "🟡 at risk", wantStatus: derive.AtRisk},
+		{name: "invalid override falls back to trending", override: "vibes are great", wantStatus: derive.OnTrack},
+		{name: "empty override falls back to trending", override: "", wantStatus: derive.OnTrack},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := makeReport("🟢 on track", "Everything is fine") +
+				fmt.Sprintf("\n<!-- data key=\"status_override\" value=\"%s\" -->", tt.override)
+			fetcher := &mockFetcher{
+				issue: github.IssueData{Title: "Initiative", State: github.StateOpen},
+				comments: []github.Comment{
+					{Body: body, CreatedAt: now.AddDate(0, 0, -1)},
+				},
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/16"), since, sinceDays, CollectOptions{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.Status != tt.wantStatus {
+				t.Errorf("got status %v, want %v", data.Status, tt.wantStatus)
+			}
+			if data.ReportedStatusCaption != tt.wantStatus.Caption {
+				t.Errorf("got caption %q, want %q", data.ReportedStatusCaption, tt.wantStatus.Caption)
+			}
+			if data.UnknownStatusNote != nil {
+				t.Errorf("expected no unknown-status note, got %+v", data.UnknownStatusNote)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_UpdateSourceURL(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Search", State: github.StateOpen},
+		comments: []github.Comment{
+			{Body: makeReport("🟢 on track", "Older update"), CreatedAt: now.AddDate(0, 0, -3), URL: "https://github.com/o/r/issues/14#issuecomment-1"},
+			{Body: makeReport("🟡 at risk", "Newest update"), CreatedAt: now.AddDate(0, 0, -1), URL: "https://github.com/o/r/issues/14#issuecomment-2"},
+		},
+	}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/14"), since, sinceDays, CollectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.UpdateSourceURL != "https://github.com/o/r/issues/14#issuecomment-2" {
+		t.Errorf("expected newest report permalink, got %q", data.UpdateSourceURL)
+	}
+
+	rows, _ := AssembleGenerateResults([]IssueData{data}, nil, false, slog.Default())
+	if len(rows) != 1 || rows[0].UpdateSourceURL != data.UpdateSourceURL {
+		t.Errorf("expected row to carry the source URL, got %+v", rows)
+	}
+}
+
+func TestCollectIssueData_MinUpdateWords(t *testing.T) {
+	tests := []struct {
+		name       string
+		update     string
+		minWords   int
+		wantStatus derive.Status
+		wantNote   bool
+	}{
+		{name: "no minimum keeps one-word update", update: "Shipped", minWords: 0, wantStatus: derive.OnTrack},
+		{name: "one word below threshold", update: "Shipped", minWords: 2, wantStatus: derive.NeedsUpdate, wantNote: true},
+		{name: "one word at threshold", update: "Shipped", minWords: 1, wantStatus: derive.OnTrack},
+		{name: "two words above threshold", update: "Shipped v2", minWords: 1, wantStatus: derive.OnTrack},
+		{name: "empty update below threshold", update: "", minWords: 1, wantStatus: derive.NeedsUpdate, wantNote: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue: github.IssueData{Title: "Terse", State: github.StateOpen, CreatedAt: now.AddDate(0, 0, -30)},
+				comments: []github.Comment{
+					{Body: makeReport("🟢 on track", tt.update), CreatedAt: now.AddDate(0, 0, -1)},
+				},
+			}
+			opts := CollectOptions{MinUpdateWords: tt.minWords}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/15"), since, sinceDays, opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.Status != tt.wantStatus {
+				t.Errorf("got status %q, want %q", data.Status.Caption, tt.wantStatus.Caption)
+			}
+			if tt.wantNote {
+				if data.Note == nil || data.Note.Kind != format.NoteNoUpdatesInWindow {
+					t.Fatalf("expected no-updates note, got %+v", data.Note)
+				}
+				if data.Note.DaysAgo != 0 {
+					t.Errorf("expected no last-update age for an update inside the window, got %d", data.Note.DaysAgo)
+				}
+				if data.ShouldSummarize {
+					t.Error("expected ShouldSummarize=false for a too-short update")
+				}
+			} else if len(data.UpdateTexts) != 1 || data.UpdateTexts[0] != tt.update {
+				t.Errorf("expected update %q to be kept, got %v", tt.update, data.UpdateTexts)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_BodyFallback(t *testing.T) {
+	longBody := "## Goal\n\nShip the new billing flow.\n" + strings.Repeat("x", 300)
+
+	tests := []struct {
+		name         string
+		body         string
+		bodyFallback bool
+		wantSummary  string
+	}{
+		{name: "disabled keeps boilerplate", body: "Ship it", bodyFallback: false, wantSummary: "No update provided in last 7 days"},
+		{name: "short body collapsed", body: "## Goal\n\n  Ship the\nnew billing flow.  ", bodyFallback: true, wantSummary: "## Goal Ship the new billing flow."},
+		{name: "long body truncated", body: longBody, bodyFallback: true, wantSummary: truncateBody(strings.Join(strings.Fields(longBody), " "), 200)},
+		{name: "empty body keeps boilerplate", body: "   ", bodyFallback: true, wantSummary: "No update provided in last 7 days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue: github.IssueData{Title: "Silent Epic", State: github.StateOpen, CreatedAt: now.AddDate(0, 0, -30), Body: tt.body},
+			}
+			opts := CollectOptions{BodyFallback: tt.bodyFallback}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/16"), since, sinceDays, opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.Status != derive.NeedsUpdate {
+				t.Errorf("got status %q, want Needs Update", data.Status.Caption)
+			}
+			if data.FallbackSummary != tt.wantSummary {
+				t.Errorf("got summary %q, want %q", data.FallbackSummary, tt.wantSummary)
+			}
+		})
+	}
+
+	// The excerpt is capped at 200 characters plus the ellipsis
+	if got := truncateBody(strings.Repeat("é", 250), 200); len([]rune(got)) != 203 {
+		t.Errorf("expected 200 characters plus ellipsis, got %d", len([]rune(got)))
+	}
+}
+
+func TestCollectIssueData_BodyFallbackIgnoresReportedIssues(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Chatty Epic", State: github.StateOpen, CreatedAt: now.AddDate(0, 0, -30), Body: "Issue body"},
+		comments: []github.Comment{
+			{Body: makeReport("🟢 on track", "Shipped the beta"), CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/17"), since, sinceDays, CollectOptions{BodyFallback: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.FallbackSummary != "Shipped the beta" {
+		t.Errorf("expected the report update to be used, got %q", data.FallbackSummary)
+	}
+}
+
+func TestCollectIssueData_LabelPrefixFallback(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{
+			Title:     "Quiet Issue",
+			State:     github.StateOpen,
+			CreatedAt: now.AddDate(0, 0, -30),
+			Labels:    []string{"blocked", "status: at risk"},
+		},
+	}
+	opts := CollectOptions{StatusLabelPrefix: "status:"}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/12"), since, sinceDays, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Status != derive.AtRisk {
+		t.Errorf("expected prefixed label to map to At Risk before defaulting to Needs Update, got %v", data.Status)
+	}
+	if data.Note == nil || data.Note.Kind != format.NoteNoCommentsInWindow {
+		t.Errorf("expected the no-update note to be preserved, got %v", data.Note)
+	}
+}
+
+func TestCollectIssueData_DefaultPrefixKeepsNeedsUpdate(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{
+			Title:     "Quiet Issue",
+			State:     github.StateOpen,
+			CreatedAt: now.AddDate(0, 0, -30),
+			Labels:    []string{"blocked"},
+		},
+	}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/12"), since, sinceDays, CollectOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Status != derive.NeedsUpdate {
+		t.Errorf("expected an unprefixed label to leave Needs Update alone, got %v", data.Status)
+	}
+}
+
+func TestCollectIssueData_TrendingWinsOverLabel(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{
+			Title:  "Reported Issue",
+			State:  github.StateOpen,
+			Labels: []string{"status: blocked"},
+		},
+		comments: []github.Comment{
+			{Body: makeReport("🟢 on track", "All good"), CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	opts := CollectOptions{StatusLabelPrefix: "status:"}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/13"), since, sinceDays, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Status != derive.OnTrack {
+		t.Errorf("expected trending to win over label, got %v", data.Status)
+	}
+}
+
+func TestCollectIssueData_UnknownStatusNote(t *testing.T) {
+	tests := []struct {
+		name     string
+		trending string
+		labels   []string
+		wantNote bool
+		wantRaw  string
+	}{
+		{name: "unmapped trending", trending: "🔵 vibes", wantNote: true, wantRaw: "🔵 vibes"},
+		{name: "mapped trending", trending: "🟢 on track"},
+		{name: "label fallback resolves status", trending: "🔵 vibes", labels: []string{"at risk"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue: github.IssueData{
+					Title:  "Reported Issue",
+					State:  github.StateOpen,
+					Labels: tt.labels,
+				},
+				comments: []github.Comment{
+					{Body: makeReport(tt.trending, "Some progress"), CreatedAt: now.AddDate(0, 0, -1)},
+				},
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/32"), since, sinceDays, CollectOptions{Now: now})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.wantNote {
+				if data.UnknownStatusNote != nil {
+					t.Errorf("expected no unknown status note, got %+v", data.UnknownStatusNote)
+				}
+				return
+			}
+			if data.UnknownStatusNote == nil || data.UnknownStatusNote.Kind != format.NoteUnknownStatus {
+				t.Fatalf("expected unknown status note, got %+v", data.UnknownStatusNote)
+			}
+			if data.UnknownStatusNote.ReportedStatus != tt.wantRaw {
+				t.Errorf("got ReportedStatus=%q, want %q", data.UnknownStatusNote.ReportedStatus, tt.wantRaw)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_StatusFromField(t *testing.T) {
+	tests := []struct {
+		name       string
+		fieldValue string
+		want       derive.Status
+	}{
+		{name: "on track option", fieldValue: "🟢 On Track", want: derive.OnTrack},
+		{name: "off track option", fieldValue: "🔴 Off Track", want: derive.OffTrack},
+		{name: "at risk option", fieldValue: "🟡 At Risk", want: derive.AtRisk},
+		{name: "done option", fieldValue: "🟣 Done", want: derive.Done},
+		{name: "unmapped option keeps report status", fieldValue: "🧊 Icebox", want: derive.AtRisk},
+		{name: "missing field keeps report status", want: derive.AtRisk},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue: github.IssueData{Title: "Board Epic", State: github.StateOpen},
+				comments: []github.Comment{
+					{Body: makeReport("🟡 at risk", "Waiting on review"), CreatedAt: now.AddDate(0, 0, -1)},
+				},
+			}
+			ref := makeRef("https://github.com/o/r/issues/33")
+			if tt.fieldValue != "" {
+				ref.FieldValues = map[string]string{"Status": tt.fieldValue}
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{Now: now, StatusField: "Status"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.Status != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, data.Status)
+			}
+			if tt.want == derive.Done && (data.ShouldSummarize || data.FallbackSummary != SummaryCompleted) {
+				t.Errorf("expected done field to skip summarization, got ShouldSummarize=%v FallbackSummary=%q", data.ShouldSummarize, data.FallbackSummary)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_StatusFromFieldWithoutReports(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Quiet Epic", State: github.StateOpen, CreatedAt: now.AddDate(0, -1, 0)},
+	}
+	ref := makeRef("https://github.com/o/r/issues/34")
+	ref.FieldValues = map[string]string{"Status": "🟢 On Track"}
+
+	data, err := CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{Now: now, StatusField: "Status"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Status != derive.OnTrack {
+		t.Errorf("expected On Track from the field, got %v", data.Status)
+	}
+	if data.Note == nil || data.Note.Kind != format.NoteNoCommentsInWindow {
+		t.Errorf("expected the no-updates note to remain, got %+v", data.Note)
+	}
+}
+
+func TestCollectIssueData_TargetDateFromField(t *testing.T) {
+	body := makeReport("🟢 on track", "Rolling out") + "\n" + `<!-- data key="target_date" start -->2099-03-01<!-- data end -->`
+	tests := []struct {
+		name       string
+		fieldValue string
+		want       string
+	}{
+		{name: "field value replaces report date", fieldValue: "2099-06-15", want: "2099-06-15"},
+		{name: "missing field keeps report date", want: "2099-03-01"},
+		{name: "unparseable field keeps report date", fieldValue: "next quarter", want: "2099-03-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue:    github.IssueData{Title: "Dated Epic", State: github.StateOpen},
+				comments: []github.Comment{{Body: body, CreatedAt: now.AddDate(0, 0, -1)}},
+			}
+			ref := makeRef("https://github.com/o/r/issues/35")
+			if tt.fieldValue != "" {
+				ref.FieldValues = map[string]string{"Target Date": tt.fieldValue}
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{Now: now, TargetDateField: "Target Date"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.TargetDate == nil || data.TargetDate.Format("2006-01-02") != tt.want {
+				t.Errorf("expected target date %s, got %v", tt.want, data.TargetDate)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_TargetDateFieldOverdue(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue:    github.IssueData{Title: "Late Epic", State: github.StateOpen},
+		comments: []github.Comment{{Body: makeReport("🟢 on track", "Almost there"), CreatedAt: now.AddDate(0, 0, -1)}},
+	}
+	ref := makeRef("https://github.com/o/r/issues/36")
+	ref.FieldValues = map[string]string{"Target Date": now.AddDate(0, 0, -3).Format("2006-01-02")}
+
+	data, err := CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{Now: now, TargetDateField: "Target Date"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.OverdueNote == nil {
+		t.Error("expected an overdue note for a past field date")
+	}
+}
+
+func TestCollectIssueData_UpdateCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		comments []github.Comment
+		want     int
+		wantNote bool
+	}{
+		{
+			name:     "single update",
+			comments: []github.Comment{{Body: makeReport("🟢 on track", "Shipped"), CreatedAt: now.AddDate(0, 0, -1)}},
+			want:     1,
+		},
+		{
+			name: "multiple updates",
+			comments: []github.Comment{
+				{Body: makeReport("🟢 on track", "Started"), CreatedAt: now.AddDate(0, 0, -4)},
+				{Body: makeReport("🟢 on track", "Halfway"), CreatedAt: now.AddDate(0, 0, -2)},
+				{Body: makeReport("🟢 on track", "Shipped"), CreatedAt: now.AddDate(0, 0, -1)},
+			},
+			want:     3,
+			wantNote: true,
+		},
+		{name: "no updates", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue:    github.IssueData{Title: "Counted Epic", State: github.StateOpen},
+				comments: tt.comments,
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/37"), since, sinceDays, CollectOptions{Now: now})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.UpdateCount != tt.want {
+				t.Errorf("expected UpdateCount %d, got %d", tt.want, data.UpdateCount)
+			}
+			if gotNote := data.Note != nil && data.Note.Kind == format.NoteMultipleUpdates; gotNote != tt.wantNote {
+				t.Errorf("expected multiple-updates note %v, got %+v", tt.wantNote, data.Note)
+			}
+			if row := CreateResultFromData(data, "").Row; row.UpdateCount != tt.want {
+				t.Errorf("expected row UpdateCount %d, got %d", tt.want, row.UpdateCount)
+			}
+		})
+	}
+}
+
+// subsetSummarizer returns batch results for only the first n items.
+type subsetSummarizer struct {
+	ai.NoopSummarizer
+	n int
+}
+
+func (s *subsetSummarizer) SummarizeBatch(_ context.Context, items []ai.BatchItem) (map[string]ai.BatchResult, error) {
+	results := make(map[string]ai.BatchResult)
+	for i, item := range items {
+		if i >= s.n {
+			break
+		}
+		results[item.IssueURL] = ai.BatchResult{Summary: "AI summary"}
+	}
+	return results, nil
+}
+
+func TestMissingBatchResults_PartialResults(t *testing.T) {
+	allData := []IssueData{
+		{IssueURL: "https://github.com/o/r/issues/1", ShouldSummarize: true, UpdateTexts: []string{"a"}, FallbackSummary: "raw a"},
+		{IssueURL: "https://github.com/o/r/issues/2", ShouldSummarize: true, UpdateTexts: []string{"b"}, FallbackSummary: "raw b"},
+		{IssueURL: "https://github.com/o/r/issues/3", ShouldSummarize: false, FallbackSummary: SummaryCompleted},
+	}
+
+	batchResults, err := BatchSummarize(context.Background(), &subsetSummarizer{n: 1}, allData, slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Strict mode: the unsummarized issue is reported as missing
+	missing := MissingBatchResults(allData, batchResults)
+	if len(missing) != 1 || missing[0] != "https://github.com/o/r/issues/2" {
+		t.Errorf("expected issue 2 to be missing, got %v", missing)
+	}
+
+	// Non-strict mode: assembly proceeds using the fallback
+	rows, _ := AssembleGenerateResults(allData, batchResults, false, slog.Default())
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+	if rows[1].UpdateMD != "raw b" {
+		t.Errorf("expected fallback summary for issue 2, got %q", rows[1].UpdateMD)
+	}
+}
+
+func TestMissingDescriptions(t *testing.T) {
+	allData := []DescribeIssueData{
+		{IssueURL: "https://github.com/o/r/issues/1", IssueBody: "body"},
+		{IssueURL: "https://github.com/o/r/issues/2", IssueBody: "body"},
+		{IssueURL: "https://github.com/o/r/issues/3"},
+	}
+	descriptions := map[string]string{"https://github.com/o/r/issues/1": "desc"}
+
+	missing := MissingDescriptions(allData, descriptions)
+	if len(missing) != 1 || missing[0] != "https://github.com/o/r/issues/2" {
+		t.Errorf("expected issue 2 to be missing, got %v", missing)
+	}
+}
+
+func TestApplyMissingTargetDate(t *testing.T) {
+	target := now.AddDate(0, 0, 14)
+	tests := []struct {
+		name     string
+		status   derive.Status
+		target   *time.Time
+		wantNote bool
+	}{
+		{name: "open without target", status: derive.OnTrack, wantNote: true},
+		{name: "needs update without target", status: derive.NeedsUpdate, wantNote: true},
+		{name: "open with target", status: derive.OnTrack, target: &target},
+		{name: "done without target", status: derive.Done},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := IssueData{IssueURL: "https://github.com/o/r/issues/41", Status: tt.status, TargetDate: tt.target}
+			ApplyMissingTargetDate(&data)
+			if !tt.wantNote {
+				if data.MissingTargetNote != nil {
+					t.Errorf("expected no missing target note, got %+v", data.MissingTargetNote)
+				}
+				return
+			}
+			if data.MissingTargetNote == nil || data.MissingTargetNote.Kind != format.NoteMissingTargetDate {
+				t.Fatalf("expected missing target note, got %+v", data.MissingTargetNote)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_MissingTargetNote(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Undated Epic", State: github.StateOpen, CreatedAt: now.AddDate(0, -1, 0)},
+		comments: []github.Comment{
+			{Body: makeReport("🟢 on track", "Moving along"), CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/42"), since, sinceDays, CollectOptions{Now: now})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.MissingTargetNote == nil || data.MissingTargetNote.Kind != format.NoteMissingTargetDate {
+		t.Fatalf("expected missing target note, got %+v", data.MissingTargetNote)
+	}
+	if data.MissingTargetNote.IssueTitle != "Undated Epic" {
+		t.Errorf("expected note to carry the issue title, got %q", data.MissingTargetNote.IssueTitle)
+	}
+}
+
+func TestApplyOverdueTarget(t *testing.T) {
+	today := time.Date(2025, 8, 13, 15, 0, 0, 0, time.UTC)
+	date := func(y int, m time.Month, d int) *time.Time {
+		v := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	tests := []struct {
+		name     string
+		target   *time.Time
+		status   derive.Status
+		wantDays int // 0 means no note
+	}{
+		{"no target date", nil, derive.OnTrack, 0},
+		{"target is today", date(2025, 8, 13), derive.OnTrack, 0},
+		{"target in future", date(2025, 8, 20), derive.AtRisk, 0},
+		{"target yesterday", date(2025, 8, 12), derive.OnTrack, 1},
+		{"target passed", date(2025, 8, 1), derive.AtRisk, 12},
+		{"done issue", date(2025, 8, 1), derive.Done, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := IssueData{IssueURL: "https://github.com/o/r/issues/1", Status: tt.status, TargetDate: tt.target}
+			ApplyOverdueTarget(&data, today)
+
+			if tt.wantDays == 0 {
+				if data.OverdueNote != nil {
+					t.Errorf("expected no overdue note, got %+v", data.OverdueNote)
+				}
+				return
+			}
+			if data.OverdueNote == nil {
+				t.Fatal("expected an overdue note")
+			}
+			if data.OverdueNote.Kind != format.NoteOverdueTarget || data.OverdueNote.DaysAgo != tt.wantDays {
+				t.Errorf("expected overdue note with %d days, got %+v", tt.wantDays, data.OverdueNote)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_OverdueTargetNote(t *testing.T) {
+	body := makeReport("🟡 at risk", "Still waiting on review") +
+		"\n<!-- data key=\"target_date\" start -->2025-08-01<!-- data end -->"
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Late Epic", State: github.StateOpen},
+		comments: []github.Comment{
+			{Body: body, CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	opts := CollectOptions{Now: time.Date(2025, 8, 13, 12, 0, 0, 0, time.UTC)}
+	data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/14"), since, sinceDays, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, notes := AssembleGenerateResults([]IssueData{data}, map[string]ai.BatchResult{}, false, slog.Default())
+	if !format.HasNotesOfKind(notes, format.NoteOverdueTarget) {
+		t.Fatalf("expected an overdue target note, got %+v", notes)
+	}
+	if got := format.FilterNotesByKind(notes, format.NoteOverdueTarget)[0].DaysAgo; got != 12 {
+		t.Errorf("expected 12 days overdue, got %d", got)
+	}
+}
+
+func TestCollectIssueData_NoUpdatesInWindowLastUpdateAge(t *testing.T) {
+	ref := time.Date(2025, 8, 13, 12, 0, 0, 0, time.UTC)
+	windowStart := ref.AddDate(0, 0, -7)
+
+	tests := []struct {
+		name     string
+		comments []github.Comment
+		authors  []string
+		wantDays int
+	}{
+		{
+			name:     "no comment history",
+			wantDays: 0,
+		},
+		{
+			name: "newest report wins over later chatter",
+			comments: []github.Comment{
+				{Body: makeReport("🟢 on track", "Old update"), CreatedAt: ref.AddDate(0, 0, -23)},
+				{Body: "any news?", CreatedAt: ref.AddDate(0, 0, -10)},
+			},
+			wantDays: 23,
+		},
+		{
+			name: "falls back to newest comment",
+			comments: []github.Comment{
+				{Body: "first", CreatedAt: ref.AddDate(0, 0, -40)},
+				{Body: "second", CreatedAt: ref.AddDate(0, 0, -15)},
+			},
+			wantDays: 15,
+		},
+		{
+			name: "fallback only counts allowed authors",
+			comments: []github.Comment{
+				{Body: "status?", Author: "alice", CreatedAt: ref.AddDate(0, 0, -30)},
+				{Body: "stale reminder", Author: "stale-bot", CreatedAt: ref.AddDate(0, 0, -9)},
+			},
+			authors:  []string{"alice"},
+			wantDays: 30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue: github.IssueData{
+					Title:     "Quiet Issue",
+					State:     github.StateOpen,
+					CreatedAt: ref.AddDate(0, -3, 0),
+				},
+				comments: tt.comments,
+			}
+			opts := CollectOptions{Now: ref, ReportAuthors: tt.authors}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/15"), windowStart, 7, opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.Note == nil || data.Note.Kind != format.NoteNoCommentsInWindow {
+				t.Fatalf("expected no-comments note, got %+v", data.Note)
+			}
+			if data.Note.DaysAgo != tt.wantDays {
+				t.Errorf("expected last update %d days ago, got %d", tt.wantDays, data.Note.DaysAgo)
+			}
+		})
+	}
+}
+
+// Runs the generate and describe halves of a --with-goals report over one set
+// of mocked issues and checks the combined document
+func TestWithGoals_CombinedDocument(t *testing.T) {
+	logger := slog.New(slog.DiscardHandler)
+	fetcher := &mockFetcher{
+		issue: github.IssueData{
+			Title:  "Search Revamp",
+			Body:   "Rebuild search on the new index so results load in under 200ms.",
+			State:  github.StateOpen,
+			Labels: []string{"epic"},
+		},
+		comments: []github.Comment{
+			{Body: makeReport("🟢 on track", "Indexer is live in staging"), CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	refs := []input.IssueRef{makeRef("https://github.com/o/r/issues/1"), makeRef("https://github.com/o/r/issues/2")}
+
+	var allData []IssueData
+	for _, ref := range refs {
+		data, err := CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		allData = append(allData, data)
+	}
+
+	rows, _ := AssembleGenerateResults(allData, map[string]ai.BatchResult{}, false, logger)
+	describeData := DescribeDataFromIssues(allData)
+	descriptions, err := BatchDescribe(context.Background(), ai.NewNoopSummarizer(), describeData, logger)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	goals := AssembleDescribeResults(describeData, descriptions, logger)
+
+	document := format.RenderStatusAndGoals(format.RenderTableWithOptions(rows, format.TableOptions{}), goals)
+
+	statusAt := strings.Index(document, format.StatusSectionHeading)
+	goalsAt := strings.Index(document, format.GoalsSectionHeading)
+	if statusAt != 0 || goalsAt <= statusAt {
+		t.Fatalf("expected status section before goals section, got:\n%s", document)
+	}
+	status, goalsSection := document[:goalsAt], document[goalsAt:]
+	for _, ref := range refs {
+		if !strings.Contains(status, ref.URL) || !strings.Contains(goalsSection, ref.URL) {
+			t.Errorf("expected %s in both sections, got:\n%s", ref.URL, document)
+		}
+	}
+	if !strings.Contains(status, "Indexer is live in staging") {
+		t.Errorf("expected the status update in the status section, got:\n%s", status)
+	}
+	if !strings.Contains(goalsSection, "Rebuild search on the new index") {
+		t.Errorf("expected the issue body in the goals section, got:\n%s", goalsSection)
+	}
+}
+
+func TestCollectDescribeIssueData_MultibyteBoundary(t *testing.T) {
+	// Each "日" is 3 bytes, so a byte cut at the limit would land mid-rune
+	body := strings.Repeat("日", DefaultDescribeExcerptChars+10)
+	fetcher := &mockFetcher{issue: github.IssueData{Title: "検索刷新", Body: body}}
+
+	data, err := CollectDescribeIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/17"), DefaultDescribeExcerptChars)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !utf8.ValidString(data.FallbackDescription) {
+		t.Fatal("expected fallback description to be valid UTF-8")
+	}
+	want := strings.Repeat("日", DefaultDescribeExcerptChars) + "..."
+	if data.FallbackDescription != want {
+		t.Errorf("got %d runes, want %d", utf8.RuneCountInString(data.FallbackDescription), utf8.RuneCountInString(want))
+	}
+	if data.IssueBody != body {
+		t.Error("expected the full body to be kept for the AI prompt")
+	}
+}
+
+func TestCollectDescribeIssueData_ExcerptChars(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		excerptChars int
+		want         string
+	}{
+		{name: "ascii at the limit is kept whole", body: "abcde", excerptChars: 5, want: "abcde"},
+		{name: "ascii one past the limit", body: "abcdef", excerptChars: 5, want: "abcde..."},
+		{name: "multibyte at the limit is kept whole", body: "日本語です", excerptChars: 5, want: "日本語です"},
+		{name: "multibyte one past the limit", body: "日本語ですね", excerptChars: 5, want: "日本語です..."},
+		{name: "zero keeps the full body", body: strings.Repeat("日", DefaultDescribeExcerptChars+10), excerptChars: 0, want: strings.Repeat("日", DefaultDescribeExcerptChars+10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{issue: github.IssueData{Title: "Search", Body: tt.body}}
+			data, err := CollectDescribeIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/18"), tt.excerptChars)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.FallbackDescription != tt.want {
+				t.Errorf("got %q, want %q", data.FallbackDescription, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectIssueData_FallbackMessages(t *testing.T) {
+	quiet := mustParseMessage(t, "Waiting on an update ({{.Days}}d window)")
+	unstructured := mustParseMessage(t, "Report missing its update block for {{.Days}} days")
+
+	tests := []struct {
+		name     string
+		comments []github.Comment
+		opts     CollectOptions
+		want     string
+	}{
+		{name: "no update default", want: "No update provided in last 7 days"},
+		{name: "no update custom", opts: CollectOptions{NoUpdateMessage: quiet}, want: "Waiting on an update (7d window)"},
+		{
+			name:     "no structured update default",
+			comments: []github.Comment{{Body: makeReport("🟢 on track", "Shipped"), CreatedAt: now.AddDate(0, 0, -1)}},
+			opts:     CollectOptions{MinUpdateWords: 3},
+			want:     "No structured update found in last 7 days",
+		},
+		{
+			name:     "no structured update custom",
+			comments: []github.Comment{{Body: makeReport("🟢 on track", "Shipped"), CreatedAt: now.AddDate(0, 0, -1)}},
+			opts:     CollectOptions{MinUpdateWords: 3, NoUpdateMessage: quiet, NoStructuredUpdateMessage: unstructured},
+			want:     "Report missing its update block for 7 days",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetcher := &mockFetcher{
+				issue: github.IssueData{
+					Title:     "Quiet Issue",
+					State:     github.StateOpen,
+					CreatedAt: now.AddDate(0, 0, -30),
+				},
+				comments: tt.comments,
+			}
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/42"), since, sinceDays, tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.FallbackSummary != tt.want {
+				t.Errorf("got %q, want %q", data.FallbackSummary, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMessageTemplate_Invalid(t *testing.T) {
+	for _, text := range []string{"No update in {{.Days", "No update in {{.Dayz}} days"} {
+		if _, err := ParseMessageTemplate("no-update-message", text); err == nil {
+			t.Errorf("expected an error for %q", text)
+		}
+	}
+}
+
+// mustParseMessage parses a fallback message template or fails the test
+func mustParseMessage(t *testing.T, text string) *template.Template {
+	t.Helper()
+	tmpl, err := ParseMessageTemplate("test", text)
+	if err != nil {
+		t.Fatalf("failed to parse %q: %v", text, err)
+	}
+	return tmpl
+}
+
+// slowFetcher delays both calls and records how many were in flight at once
+type slowFetcher struct {
+	mockFetcher
+	delay       time.Duration
+	commentsErr error
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (s *slowFetcher) enter() func() {
+	n := s.inFlight.Add(1)
+	for {
+		peak := s.maxInFlight.Load()
+		if n <= peak || s.maxInFlight.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	return func() { s.inFlight.Add(-1) }
+}
+
+func (s *slowFetcher) FetchIssue(ctx context.Context, ref input.IssueRef) (github.IssueData, error) {
+	defer s.enter()()
+	time.Sleep(s.delay)
+	return s.mockFetcher.FetchIssue(ctx, ref)
+}
+
+func (s *slowFetcher) FetchCommentsSince(ctx context.Context, ref input.IssueRef, since time.Time) ([]github.Comment, error) {
+	defer s.enter()()
+	time.Sleep(s.delay)
+	if s.commentsErr != nil {
+		return nil, s.commentsErr
+	}
+	return s.mockFetcher.FetchCommentsSince(ctx, ref, since)
+}
+
+func TestCollectIssueData_ParallelFetch(t *testing.T) {
+	closedAt := now.AddDate(0, 0, -1)
+	fetcher := &slowFetcher{
+		mockFetcher: mockFetcher{
+			issue: github.IssueData{Title: "Launch", State: github.StateClosed, ClosedAt: &closedAt},
+			comments: []github.Comment{
+				{Body: makeReport("🟣 done", "Shipped"), CreatedAt: now.AddDate(0, 0, -20)},
+				{Body: makeReport("🟢 on track", "Too old"), CreatedAt: now.AddDate(0, 0, -40)},
+			},
+		},
+		delay: 50 * time.Millisecond,
+	}
+	ref := makeRef("https://github.com/o/r/issues/1")
+
+	data, err := CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{Now: now, DoneSinceDays: 30, ParallelFetch: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := fetcher.maxInFlight.Load(); got != 2 {
+		t.Errorf("got %d calls in flight at once, want the issue and comment fetches to overlap", got)
+	}
+	// The closed window still applies once the state is known
+	if len(data.Reports) != 1 || data.Reports[0].UpdateRaw != "Shipped" {
+		t.Errorf("got reports %+v, want only the report inside the 30-day window", data.Reports)
+	}
+
+	fetcher = &slowFetcher{
+		mockFetcher: mockFetcher{issue: github.IssueData{Title: "Launch", State: github.StateOpen}},
+		delay:       10 * time.Millisecond,
+		commentsErr: errors.New("boom"),
+	}
+	_, err = CollectIssueData(context.Background(), fetcher, ref, since, sinceDays, CollectOptions{Now: now, ParallelFetch: true})
+	if err == nil || err.Error() != "failed to fetch comments: boom" {
+		t.Errorf("got %v, want the comment fetch error", err)
+	}
+}
+
+func TestCollectIssueData_Location(t *testing.T) {
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+
+	// 03:00 UTC on the 6th is still the evening of the 5th in Los Angeles
+	closedAt := time.Date(2025, 8, 6, 3, 0, 0, 0, time.UTC)
+	closed := &mockFetcher{issue: github.IssueData{Title: "Launch", State: github.StateClosed, ClosedAt: &closedAt}}
+	data, err := CollectIssueData(context.Background(), closed, makeRef("https://github.com/o/r/issues/1"), since, sinceDays, CollectOptions{Location: la})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := derive.RenderTargetDate(data.TargetDate); got != "2025-08-05" {
+		t.Errorf("closed date in America/Los_Angeles: got %q, want 2025-08-05", got)
+	}
+
+	body := makeReport("🟢 on track", "Rolling out") + "\n<!-- data key=\"target_date\" start -->2099-03-01<!-- data end -->"
+	open := &mockFetcher{
+		issue:    github.IssueData{Title: "Rollout", State: github.StateOpen},
+		comments: []github.Comment{{Body: body, CreatedAt: now.AddDate(0, 0, -1)}},
+	}
+	data, err = CollectIssueData(context.Background(), open, makeRef("https://github.com/o/r/issues/2"), since, sinceDays, CollectOptions{Now: now, Location: la})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.TargetDate == nil || data.TargetDate.Location() != la || derive.RenderTargetDate(data.TargetDate) != "2099-03-01" {
+		t.Errorf("expected the report date read as 2099-03-01 in America/Los_Angeles, got %v", data.TargetDate)
+	}
+}
+
+func TestCollectIssueData_TargetDatePolicy(t *testing.T) {
+	withDate := func(update, date string) string {
+		return makeReport("🟢 on track", update) + "\n<!-- data key=\"target_date\" start -->" + date + "<!-- data end -->"
+	}
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Rollout", State: github.StateOpen},
+		comments: []github.Comment{
+			{Body: withDate("Newest", "2099-06-15"), CreatedAt: now.AddDate(0, 0, -1)},
+			{Body: makeReport("🟢 on track", "No date"), CreatedAt: now.AddDate(0, 0, -2)},
+			{Body: withDate("Middle", "2099-09-01"), CreatedAt: now.AddDate(0, 0, -3)},
+			{Body: withDate("Oldest", "2099-03-01"), CreatedAt: now.AddDate(0, 0, -4)},
+		},
+	}
+
+	tests := []struct {
+		policy string
+		want   string
+	}{
+		{policy: "", want: "2099-06-15"},
+		{policy: TargetDateNewestReport, want: "2099-06-15"},
+		{policy: TargetDateEarliest, want: "2099-03-01"},
+		{policy: TargetDateLatest, want: "2099-09-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/1"), since, sinceDays, CollectOptions{Now: now, TargetDatePolicy: tt.policy})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if data.TargetDate == nil || data.TargetDate.Format("2006-01-02") != tt.want {
+				t.Errorf("got target date %v, want %s", data.TargetDate, tt.want)
+			}
+		})
+	}
+
+	// The newest report without a date leaves the row undated under the default
+	// policy, while earliest and latest use the reports that have one
+	fetcher.comments = fetcher.comments[1:]
+	for _, tt := range []struct {
+		policy string
+		want   string
+	}{
+		{policy: TargetDateNewestReport, want: ""},
+		{policy: TargetDateEarliest, want: "2099-03-01"},
+		{policy: TargetDateLatest, want: "2099-09-01"},
+	} {
+		data, err := CollectIssueData(context.Background(), fetcher, makeRef("https://github.com/o/r/issues/1"), since, sinceDays, CollectOptions{Now: now, TargetDatePolicy: tt.policy})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := ""
+		if data.TargetDate != nil {
+			got = data.TargetDate.Format("2006-01-02")
+		}
+		if got != tt.want {
+			t.Errorf("%s: got target date %q, want %q", tt.policy, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package pipeline
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Default wording for rows without a usable update. Templates see the
+// window length as {{.Days}}.
+const (
+	DefaultNoUpdateMessage           = "No update provided in last {{.Days}} days"
+	DefaultNoStructuredUpdateMessage = "No structured update found in last {{.Days}} days"
+)
+
+var (
+	defaultNoUpdateTemplate           = template.Must(ParseMessageTemplate("no-update", DefaultNoUpdateMessage))
+	defaultNoStructuredUpdateTemplate = template.Must(ParseMessageTemplate("no-structured-update", DefaultNoStructuredUpdateMessage))
+)
+
+// messageData is what fallback message templates are executed with
+type messageData struct {
+	Days int
+}
+
+// ParseMessageTemplate parses a fallback message template, checking it
+// renders so a misspelled field such as {{.Dayz}} fails up front
+func ParseMessageTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if err := tmpl.Execute(&strings.Builder{}, messageData{Days: 7}); err != nil {
+		return nil, fmt.Errorf("template does not render: %w", err)
+	}
+	return tmpl, nil
+}
+
+// renderMessage executes tmpl for a window of days, using fallback when tmpl
+// is nil or fails
+func renderMessage(tmpl, fallback *template.Template, days int) string {
+	if tmpl != nil {
+		var builder strings.Builder
+		if err := tmpl.Execute(&builder, messageData{Days: days}); err == nil {
+			return builder.String()
+		}
+	}
+	var builder strings.Builder
+	_ = fallback.Execute(&builder, messageData{Days: days})
+	return builder.String()
+}
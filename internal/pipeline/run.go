@@ -0,0 +1,174 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/ai"
+	"github.com/Attamusc/weekly-report-cli/internal/format"
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+)
+
+// IssueError is the failure to collect a single issue
+type IssueError struct {
+	URL string
+	Err error
+}
+
+func (e IssueError) Error() string {
+	if e.URL == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %v", e.URL, e.Err)
+}
+
+func (e IssueError) Unwrap() error {
+	return e.Err
+}
+
+// CollectionErrors aggregates the issues that failed during collection. It is
+// safe for concurrent use, and errors.Is/As see through to each failure.
+type CollectionErrors struct {
+	mu       sync.Mutex
+	failures []IssueError
+}
+
+// Add records the failure of the issue at url
+func (c *CollectionErrors) Add(url string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.failures = append(c.failures, IssueError{URL: url, Err: err})
+}
+
+// Failures returns a copy of the recorded failures in the order they were added
+func (c *CollectionErrors) Failures() []IssueError {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]IssueError(nil), c.failures...)
+}
+
+// Len returns the number of recorded failures
+func (c *CollectionErrors) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.failures)
+}
+
+// Err returns c as an error, or nil when nothing failed
+func (c *CollectionErrors) Err() error {
+	if c.Len() == 0 {
+		return nil
+	}
+	return c
+}
+
+func (c *CollectionErrors) Error() string {
+	failures := c.Failures()
+	messages := make([]string, len(failures))
+	for i, failure := range failures {
+		messages[i] = failure.Error()
+	}
+	return fmt.Sprintf("%d issues failed: %s", len(failures), strings.Join(messages, "; "))
+}
+
+func (c *CollectionErrors) Unwrap() []error {
+	failures := c.Failures()
+	errs := make([]error, len(failures))
+	for i, failure := range failures {
+		errs[i] = failure
+	}
+	return errs
+}
+
+// RunConfig holds everything Run needs to build a report
+type RunConfig struct {
+	Fetcher    IssueFetcher
+	Summarizer ai.Summarizer // nil skips AI summarization
+	Refs       []input.IssueRef
+	Permits    Permits
+	Since      time.Time
+	SinceDays  int
+	Options    CollectOptions
+	Sentiment  bool           // Emit sentiment mismatch notes from the AI results
+	OnProgress func(done int) // Called after each issue is collected (nil = no progress)
+	Logger     *slog.Logger   // nil = slog.Default()
+}
+
+// Result is the outcome of Run
+type Result struct {
+	Data         []IssueData               // Collected issues, in completion order (excluded issues are dropped)
+	BatchResults map[string]ai.BatchResult // AI results by issue URL (empty without a summarizer)
+	SummarizeErr error                     // Batch summarization failure; rows fell back to raw text
+	Rows         []format.Row
+	Notes        []format.Note
+	Failures     *CollectionErrors // Issues that couldn't be collected
+}
+
+// Run collects every ref, summarizes the collected issues in one batch and
+// assembles the report rows and notes. Issues that fail to collect are
+// recorded in Result.Failures rather than failing the run; the returned error
+// is non-nil only when ctx's deadline passes, in which case the partial
+// result is returned with it.
+func Run(ctx context.Context, cfg RunConfig) (Result, error) {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	result := Result{
+		BatchResults: make(map[string]ai.BatchResult),
+		Failures:     &CollectionErrors{},
+	}
+
+	var completed int
+	collect := func(ctx context.Context, ref input.IssueRef) (IssueData, error) {
+		return CollectIssueData(ctx, cfg.Fetcher, ref, cfg.Since, cfg.SinceDays, cfg.Options)
+	}
+	CollectEach(ctx, cfg.Refs, cfg.Permits, collect, func(collected IssueDataResult) {
+		completed++
+		if cfg.OnProgress != nil {
+			cfg.OnProgress(completed)
+		}
+		if collected.Err != nil {
+			result.Failures.Add(collected.URL, collected.Err)
+			return
+		}
+		if collected.Data.Excluded {
+			logger.Debug("Issue excluded by its report", "url", collected.URL)
+			return
+		}
+		result.Data = append(result.Data, collected.Data)
+	})
+
+	if errorCount := result.Failures.Len(); errorCount > 0 {
+		logger.Info("Data collection completed with errors", "errors", errorCount, "successful", len(result.Data))
+	} else {
+		logger.Info("Data collection completed successfully", "issues", len(result.Data))
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return result, ctx.Err()
+	}
+
+	if cfg.Summarizer != nil {
+		batchResults, err := BatchSummarize(ctx, cfg.Summarizer, result.Data, logger)
+		if err != nil {
+			logger.Warn("Batch summarization failed, using fallbacks", "error", err)
+			result.SummarizeErr = err
+		} else {
+			result.BatchResults = batchResults
+		}
+	} else {
+		logger.Debug("AI summarization disabled, using fallbacks")
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return result, ctx.Err()
+	}
+
+	result.Rows, result.Notes = AssembleGenerateResults(result.Data, result.BatchResults, cfg.Sentiment, logger)
+	return result, nil
+}
@@ -0,0 +1,197 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/ai"
+	"github.com/Attamusc/weekly-report-cli/internal/github"
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+	"github.com/Attamusc/weekly-report-cli/internal/throttle"
+)
+
+// failingFetcher fails FetchIssue for the URLs in fail and serves a quiet open
+// issue for everything else
+type failingFetcher struct {
+	fail map[string]error
+}
+
+func (f *failingFetcher) FetchIssue(_ context.Context, ref input.IssueRef) (github.IssueData, error) {
+	if err, ok := f.fail[ref.URL]; ok {
+		return github.IssueData{}, err
+	}
+	return github.IssueData{Title: "Issue " + ref.URL, State: github.StateOpen, CreatedAt: now.AddDate(0, -1, 0)}, nil
+}
+
+func (f *failingFetcher) FetchCommentsSince(_ context.Context, _ input.IssueRef, _ time.Time) ([]github.Comment, error) {
+	return nil, nil
+}
+
+func TestRun_AggregatesFailedURLs(t *testing.T) {
+	fetcher := &failingFetcher{fail: map[string]error{
+		"https://github.com/o/r/issues/2": errors.New("boom"),
+		"https://github.com/o/r/issues/4": fmt.Errorf("wrapped: %w", github.ErrInaccessible),
+	}}
+	refs := []input.IssueRef{
+		makeRef("https://github.com/o/r/issues/1"),
+		makeRef("https://github.com/o/r/issues/2"),
+		makeRef("https://github.com/o/r/issues/3"),
+		makeRef("https://github.com/o/r/issues/4"),
+	}
+
+	var progress []int
+	result, err := Run(context.Background(), RunConfig{
+		Fetcher:    fetcher,
+		Refs:       refs,
+		Permits:    throttle.New(2, nil),
+		Since:      since,
+		SinceDays:  sinceDays,
+		Options:    CollectOptions{Now: now},
+		OnProgress: func(done int) { progress = append(progress, done) },
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(result.Rows) != 2 || len(result.Data) != 2 {
+		t.Errorf("expected 2 rows from the collected issues, got %d rows and %d issues", len(result.Rows), len(result.Data))
+	}
+	if len(progress) != len(refs) || progress[len(progress)-1] != len(refs) {
+		t.Errorf("expected progress for every ref, got %v", progress)
+	}
+
+	var failed []string
+	for _, failure := range result.Failures.Failures() {
+		failed = append(failed, failure.URL)
+	}
+	sort.Strings(failed)
+	if got := strings.Join(failed, " "); got != "https://github.com/o/r/issues/2 https://github.com/o/r/issues/4" {
+		t.Errorf("got failed URLs %q", got)
+	}
+
+	aggregate := result.Failures.Err()
+	if aggregate == nil {
+		t.Fatal("expected an aggregate error")
+	}
+	if !errors.Is(aggregate, github.ErrInaccessible) {
+		t.Error("expected errors.Is to see the inaccessible failure through the aggregate")
+	}
+	if !strings.Contains(aggregate.Error(), "https://github.com/o/r/issues/2: failed to fetch issue: boom") {
+		t.Errorf("expected the aggregate message to name each URL, got %q", aggregate)
+	}
+}
+
+func TestRun_NoFailures(t *testing.T) {
+	result, err := Run(context.Background(), RunConfig{
+		Fetcher:   &failingFetcher{},
+		Refs:      []input.IssueRef{makeRef("https://github.com/o/r/issues/1")},
+		Permits:   throttle.New(1, nil),
+		Since:     since,
+		SinceDays: sinceDays,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Failures.Len() != 0 || result.Failures.Err() != nil {
+		t.Errorf("expected no failures, got %v", result.Failures.Failures())
+	}
+}
+
+func TestRun_DropsExcludedIssues(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "Legal", State: github.StateOpen},
+		comments: []github.Comment{
+			{Body: makeReport("🟢 on track", "Skip this week") + "\n<!-- data key=\"exclude\" value=\"true\" -->", CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	result, err := Run(context.Background(), RunConfig{
+		Fetcher:   fetcher,
+		Refs:      []input.IssueRef{makeRef("https://github.com/o/r/issues/1")},
+		Permits:   throttle.New(1, nil),
+		Since:     since,
+		SinceDays: sinceDays,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Data) != 0 || len(result.Rows) != 0 || len(result.Notes) != 0 || result.Failures.Len() != 0 {
+		t.Errorf("expected the excluded issue to leave no trace, got %d issues, %d rows, notes %+v, %d failures",
+			len(result.Data), len(result.Rows), result.Notes, result.Failures.Len())
+	}
+}
+
+func TestCollectionErrors_ConcurrentAdd(t *testing.T) {
+	var errs CollectionErrors
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs.Add(fmt.Sprintf("https://github.com/o/r/issues/%d", i), errors.New("boom"))
+		}(i)
+	}
+	wg.Wait()
+
+	if got := errs.Len(); got != 50 {
+		t.Errorf("got %d failures, want 50", got)
+	}
+	if got := len(errs.Unwrap()); got != 50 {
+		t.Errorf("got %d unwrapped errors, want 50", got)
+	}
+}
+
+// failingSummarizer fails every batch request
+type failingSummarizer struct {
+	ai.NoopSummarizer
+}
+
+func (*failingSummarizer) SummarizeBatch(_ context.Context, _ []ai.BatchItem) (map[string]ai.BatchResult, error) {
+	return nil, errors.New("models unavailable")
+}
+
+func TestRun_MarksRawFallbackRows(t *testing.T) {
+	fetcher := &mockFetcher{
+		issue: github.IssueData{Title: "API", State: github.StateOpen},
+		comments: []github.Comment{
+			{Body: makeReport("🟢 on track", "wip on the api"), CreatedAt: now.AddDate(0, 0, -1)},
+		},
+	}
+	run := func(summarizer ai.Summarizer) Result {
+		t.Helper()
+		result, err := Run(context.Background(), RunConfig{
+			Fetcher:    fetcher,
+			Summarizer: summarizer,
+			Refs:       []input.IssueRef{makeRef("https://github.com/o/r/issues/1")},
+			Permits:    throttle.New(1, nil),
+			Since:      since,
+			SinceDays:  sinceDays,
+			Options:    CollectOptions{Now: now},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result.Rows) != 1 {
+			t.Fatalf("expected 1 row, got %d", len(result.Rows))
+		}
+		return result
+	}
+
+	result := run(&failingSummarizer{})
+	if result.SummarizeErr == nil {
+		t.Error("expected the summarization failure to be reported")
+	}
+	if row := result.Rows[0]; !row.RawFallback || row.UpdateMD != "wip on the api" {
+		t.Errorf("expected a raw fallback row, got %+v", row)
+	}
+
+	result = run(&captureSummarizer{})
+	if row := result.Rows[0]; row.RawFallback {
+		t.Errorf("expected a summarized row not to be marked, got %+v", row)
+	}
+}
@@ -0,0 +1,51 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+)
+
+// CollectFunc gathers the data for a single issue
+type CollectFunc func(ctx context.Context, ref input.IssueRef) (IssueData, error)
+
+// Permits bounds how many issues are collected at once
+type Permits interface {
+	Acquire(ctx context.Context) error
+	Release()
+}
+
+// CollectEach runs collect for every ref, holding one of permits per issue, and
+// hands each result to emit as soon as it completes, in completion order.
+// emit runs on the calling goroutine one result at a time, so it can write
+// output directly; CollectEach returns after the last result is emitted.
+// Refs still waiting for a permit when ctx is done yield ctx.Err().
+func CollectEach(ctx context.Context, refs []input.IssueRef, permits Permits, collect CollectFunc, emit func(IssueDataResult)) {
+	results := make(chan IssueDataResult, len(refs))
+	var wg sync.WaitGroup
+
+	for _, ref := range refs {
+		wg.Add(1)
+		go func(ref input.IssueRef) {
+			defer wg.Done()
+			if err := permits.Acquire(ctx); err != nil {
+				results <- IssueDataResult{URL: ref.URL, Err: err}
+				return
+			}
+			defer permits.Release()
+
+			data, err := collect(ctx, ref)
+			results <- IssueDataResult{URL: ref.URL, Data: data, Err: err}
+		}(ref)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	for result := range results {
+		emit(result)
+	}
+}
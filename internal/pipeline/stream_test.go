@@ -0,0 +1,69 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+	"github.com/Attamusc/weekly-report-cli/internal/throttle"
+)
+
+// The second issue can't finish until the first has been emitted, so this
+// only passes if results are emitted while other workers are still running.
+func TestCollectEach_EmitsBeforeAllComplete(t *testing.T) {
+	refs := []input.IssueRef{{Owner: "owner", Repo: "repo", Number: 1}, {Owner: "owner", Repo: "repo", Number: 2}}
+	firstEmitted := make(chan struct{})
+
+	collect := func(ctx context.Context, ref input.IssueRef) (IssueData, error) {
+		if ref.Number == 2 {
+			select {
+			case <-firstEmitted:
+			case <-time.After(5 * time.Second):
+				return IssueData{}, errors.New("first result was not emitted while collection was running")
+			}
+		}
+		return IssueData{IssueNumber: ref.Number}, nil
+	}
+
+	var order []int
+	CollectEach(context.Background(), refs, throttle.New(2, nil), collect, func(result IssueDataResult) {
+		if result.Err != nil {
+			t.Fatalf("unexpected error: %v", result.Err)
+		}
+		order = append(order, result.Data.IssueNumber)
+		if result.Data.IssueNumber == 1 {
+			close(firstEmitted)
+		}
+	})
+
+	if len(order) != 2 || order[0] != 1 || order[1] != 2 {
+		t.Errorf("got emit order %v, want [1 2]", order)
+	}
+}
+
+func TestCollectEach_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	refs := []input.IssueRef{makeRef("a"), makeRef("b"), makeRef("c")}
+	collect := func(ctx context.Context, ref input.IssueRef) (IssueData, error) {
+		return IssueData{}, ctx.Err()
+	}
+
+	var errCount, emitted int
+	CollectEach(ctx, refs, throttle.New(1, nil), collect, func(result IssueDataResult) {
+		emitted++
+		if errors.Is(result.Err, context.Canceled) {
+			errCount++
+		}
+	})
+
+	if emitted != len(refs) {
+		t.Errorf("got %d results, want %d", emitted, len(refs))
+	}
+	if errCount != len(refs) {
+		t.Errorf("got %d context.Canceled results, want %d", errCount, len(refs))
+	}
+}
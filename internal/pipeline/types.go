@@ -1,6 +1,7 @@
 package pipeline
 
 import (
+	"text/template"
 	"time"
 
 	"github.com/Attamusc/weekly-report-cli/internal/derive"
@@ -11,11 +12,57 @@ import (
 // SummaryCompleted is the default summary for done/closed issues that don't need AI summarization.
 const SummaryCompleted = "Completed"
 
+// DefaultDescribeExcerptChars is how many characters of the issue body describe
+// rows without an AI description show, unless --body-excerpt-chars overrides it
+const DefaultDescribeExcerptChars = 500
+
+// bodyFallbackLength is how many characters of the issue body generate rows
+// with CollectOptions.BodyFallback show
+const bodyFallbackLength = 200
+
+// Report keys that can feed the summarizer via CollectOptions.SummarizeKey
+const (
+	SummarizeKeyUpdate  = "update"
+	SummarizeKeySummary = "summary"
+)
+
+// How a row's target date is chosen when several in-window reports carry one,
+// via CollectOptions.TargetDatePolicy
+const (
+	TargetDateNewestReport = "newest-report" // The newest report's date, even when it has none
+	TargetDateEarliest     = "earliest"      // The earliest date any report gives
+	TargetDateLatest       = "latest"        // The latest date any report gives
+)
+
+// CollectOptions holds optional settings that adjust how issue data is collected.
+// The zero value reproduces the default behavior.
+type CollectOptions struct {
+	StatusLabelPrefix string          // Only labels with this prefix are used for status fallback (empty = all labels)
+	Now               time.Time       // Reference time for date checks such as overdue targets (zero = time.Now())
+	ReportAuthors     []string        // Only structured reports by these logins count (empty = all authors)
+	MinUpdateWords    int             // Updates with fewer words are ignored (0 = no minimum)
+	BodyFallback      bool            // Use an issue body excerpt instead of "No update provided"
+	DoneSinceDays     int             // Look-back window in days for closed issues (0 = same as sinceDays)
+	StatusField       string          // Project field whose value sets the row status instead of the report's trending (empty = reports)
+	TargetDateField   string          // Project date field whose value sets the row target date instead of the report's (empty = reports)
+	SummarizeKey      string          // Report key whose text is summarized, falling back to the update when absent (empty = SummarizeKeyUpdate)
+	NoDedupUpdates    bool            // Keep repeated identical updates instead of summarizing each text once
+	ParallelFetch     bool            // Fetch each issue's metadata and comments concurrently instead of one after the other
+	TargetDatePolicy  string          // Which report's target date the row uses (empty = TargetDateNewestReport)
+	Location          *time.Location  // Timezone target dates are read, rendered, and counted in (nil = UTC)
+	ReportKeys        report.KeyNames // Data key names reports are parsed with (empty names = defaults)
+
+	NoUpdateMessage           *template.Template // Update text for issues without updates (nil = DefaultNoUpdateMessage)
+	NoStructuredUpdateMessage *template.Template // Update text when reports had no usable update (nil = DefaultNoStructuredUpdateMessage)
+}
+
 // IssueData represents collected data from an issue before AI summarization.
 type IssueData struct {
 	IssueURL              string
+	IssueNumber           int
 	IssueTitle            string
 	IssueState            string
+	IssueBody             string // Issue description, kept for the --with-goals section
 	CreatedAt             time.Time
 	ClosedAt              *time.Time
 	CloseReason           string
@@ -24,16 +71,24 @@ type IssueData struct {
 	ExtraColumns          map[string]string // Project field values for custom columns
 	Reports               []report.Report
 	UpdateTexts           []string
+	UpdateCount           int // Reports in the window behind the update (0 when the row fell back to other text)
 	Status                derive.Status
 	ReportedStatusCaption string
 	TargetDate            *time.Time
 	ShouldSummarize       bool
+	SummaryHint           string // Optional summarization guidance from the report's summary_hint key
+	UpdateSourceURL       string // Permalink of the newest report comment (empty when the update isn't from a report)
 	FallbackSummary       string
 	Note                  *format.Note
+	OverdueNote           *format.Note // Emitted alongside Note when the target date has passed
+	UnknownStatusNote     *format.Note // Emitted alongside Note when the report's trending value didn't map to a status
+	MissingTargetNote     *format.Note // Emitted alongside Note when an issue that isn't done has no target date
+	Excluded              bool         // The newest report asked for the issue to be left out: no row and no notes
 }
 
 // IssueDataResult represents the result of collecting issue data.
 type IssueDataResult struct {
+	URL  string // URL of the issue the result is for
 	Data IssueData
 	Err  error
 }
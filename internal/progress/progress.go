@@ -0,0 +1,89 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// barWidth is the number of characters used for the progress bar body
+const barWidth = 30
+
+// Reporter reports progress of a fixed-size unit of work. In bar mode it
+// redraws a single carriage-return based line on the writer; otherwise it
+// emits one structured log line per update.
+type Reporter struct {
+	mu     sync.Mutex
+	w      io.Writer
+	logger *slog.Logger
+	label  string
+	total  int
+	bar    bool
+	drawn  bool
+}
+
+// New creates a Reporter. When useBar is true, progress is drawn as a bar on w;
+// otherwise updates are logged via logger at info level.
+func New(w io.Writer, logger *slog.Logger, label string, total int, useBar bool) *Reporter {
+	return &Reporter{
+		w:      w,
+		logger: logger,
+		label:  label,
+		total:  total,
+		bar:    useBar,
+	}
+}
+
+// Update reports that completed units of work are finished. Safe for concurrent use.
+func (r *Reporter) Update(completed int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.bar {
+		r.logger.Info(r.label, "completed", completed, "total", r.total)
+		return
+	}
+
+	_, _ = fmt.Fprintf(r.w, "\r%s %s %d/%d", r.label, renderBar(completed, r.total), completed, r.total)
+	r.drawn = true
+}
+
+// Done terminates the progress line in bar mode so subsequent output starts on
+// a fresh line. It is a no-op in log mode.
+func (r *Reporter) Done() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.bar && r.drawn {
+		_, _ = fmt.Fprintln(r.w)
+		r.drawn = false
+	}
+}
+
+// renderBar returns a fixed-width bar such as "[=========>          ]"
+func renderBar(completed, total int) string {
+	if total <= 0 {
+		return "[" + strings.Repeat("=", barWidth) + "]"
+	}
+	if completed > total {
+		completed = total
+	}
+
+	filled := completed * barWidth / total
+	if filled >= barWidth {
+		return "[" + strings.Repeat("=", barWidth) + "]"
+	}
+	return "[" + strings.Repeat("=", filled) + ">" + strings.Repeat(" ", barWidth-filled-1) + "]"
+}
+
+// IsTerminal reports whether f refers to a character device (a TTY).
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,82 @@
+package progress
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestReporter_BarMode(t *testing.T) {
+	var buf bytes.Buffer
+	r := New(&buf, slog.Default(), "Collecting issue data", 4, true)
+
+	r.Update(1)
+	r.Update(4)
+	r.Done()
+
+	out := buf.String()
+	if !strings.HasPrefix(out, "\rCollecting issue data [") {
+		t.Errorf("expected carriage-return prefixed bar, got %q", out)
+	}
+	if !strings.Contains(out, " 1/4") || !strings.Contains(out, " 4/4") {
+		t.Errorf("expected counts in output, got %q", out)
+	}
+	if !strings.HasSuffix(out, "\n") {
+		t.Errorf("expected Done to terminate the line, got %q", out)
+	}
+	if strings.Count(out, "\n") != 1 {
+		t.Errorf("expected a single newline, got %q", out)
+	}
+}
+
+func TestReporter_LogMode(t *testing.T) {
+	var bar, logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	r := New(&bar, logger, "Collecting issue data", 2, false)
+
+	r.Update(1)
+	r.Update(2)
+	r.Done()
+
+	if bar.Len() != 0 {
+		t.Errorf("expected no bar output in log mode, got %q", bar.String())
+	}
+	if got := strings.Count(logs.String(), "Collecting issue data"); got != 2 {
+		t.Errorf("expected 2 log lines, got %d: %s", got, logs.String())
+	}
+	if !strings.Contains(logs.String(), "completed=2 total=2") {
+		t.Errorf("expected completed/total attributes, got %s", logs.String())
+	}
+}
+
+func TestReporter_DoneWithoutUpdates(t *testing.T) {
+	var buf bytes.Buffer
+	r := New(&buf, slog.Default(), "Working", 3, true)
+	r.Done()
+	if buf.Len() != 0 {
+		t.Errorf("expected no output when nothing was drawn, got %q", buf.String())
+	}
+}
+
+func TestRenderBar(t *testing.T) {
+	tests := []struct {
+		name      string
+		completed int
+		total     int
+		want      string
+	}{
+		{"empty", 0, 10, "[>" + strings.Repeat(" ", barWidth-1) + "]"},
+		{"half", 5, 10, "[" + strings.Repeat("=", barWidth/2) + ">" + strings.Repeat(" ", barWidth/2-1) + "]"},
+		{"full", 10, 10, "[" + strings.Repeat("=", barWidth) + "]"},
+		{"overflow", 12, 10, "[" + strings.Repeat("=", barWidth) + "]"},
+		{"zero total", 0, 0, "[" + strings.Repeat("=", barWidth) + "]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderBar(tt.completed, tt.total); got != tt.want {
+				t.Errorf("renderBar(%d, %d) = %q, want %q", tt.completed, tt.total, got, tt.want)
+			}
+		})
+	}
+}
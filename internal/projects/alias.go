@@ -0,0 +1,83 @@
+package projects
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldAliases maps alternate field values to their canonical spelling, so
+// historical variants such as "In-Progress" can be matched as "In Progress".
+// Keys and values are compared case-insensitively.
+type FieldAliases map[string]string
+
+// ParseFieldAliases parses "alias=canonical" entries into FieldAliases
+func ParseFieldAliases(specs []string) (FieldAliases, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	aliases := make(FieldAliases, len(specs))
+	for _, spec := range specs {
+		alias, canonical, ok := strings.Cut(spec, "=")
+		alias = strings.TrimSpace(alias)
+		canonical = strings.TrimSpace(canonical)
+		if !ok || alias == "" || canonical == "" {
+			return nil, fmt.Errorf("invalid field alias %q: expected 'alias=canonical'", spec)
+		}
+		aliases[alias] = canonical
+	}
+	return aliases, nil
+}
+
+// canonical returns the canonical spelling for value, or value itself when it has no alias
+func (a FieldAliases) canonical(value string) string {
+	value = strings.TrimSpace(value)
+	for alias, canonical := range a {
+		if strings.EqualFold(alias, value) {
+			return canonical
+		}
+	}
+	return value
+}
+
+// ExpandFilterAliases returns filters with each value widened to every spelling
+// that shares its canonical form. With no aliases the filters are returned as-is.
+func ExpandFilterAliases(filters []FieldFilter, aliases FieldAliases) []FieldFilter {
+	if len(aliases) == 0 || len(filters) == 0 {
+		return filters
+	}
+
+	// Group every known spelling under its lowercased canonical value
+	spellings := make(map[string][]string)
+	for alias, canonical := range aliases {
+		key := strings.ToLower(canonical)
+		spellings[key] = append(spellings[key], alias)
+	}
+	for key := range spellings {
+		sort.Strings(spellings[key])
+	}
+
+	expanded := make([]FieldFilter, len(filters))
+	for i, filter := range filters {
+		seen := make(map[string]bool)
+		var values []string
+		add := func(v string) {
+			if !seen[strings.ToLower(v)] {
+				seen[strings.ToLower(v)] = true
+				values = append(values, v)
+			}
+		}
+
+		for _, value := range filter.Values {
+			add(value)
+			canonical := aliases.canonical(value)
+			add(canonical)
+			for _, alias := range spellings[strings.ToLower(canonical)] {
+				add(alias)
+			}
+		}
+		expanded[i] = FieldFilter{FieldName: filter.FieldName, Values: values}
+	}
+	return expanded
+}
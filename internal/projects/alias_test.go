@@ -0,0 +1,122 @@
+package projects
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+)
+
+func TestParseFieldAliases(t *testing.T) {
+	aliases, err := ParseFieldAliases([]string{"In-Progress=In Progress", " WIP = In Progress "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := FieldAliases{"In-Progress": "In Progress", "WIP": "In Progress"}
+	if !reflect.DeepEqual(aliases, want) {
+		t.Errorf("got %v, want %v", aliases, want)
+	}
+
+	for _, spec := range []string{"In-Progress", "=In Progress", "In-Progress="} {
+		if _, err := ParseFieldAliases([]string{spec}); err == nil {
+			t.Errorf("expected error for %q", spec)
+		}
+	}
+}
+
+func TestExpandFilterAliases(t *testing.T) {
+	aliases := FieldAliases{"In-Progress": "In Progress", "WIP": "In Progress"}
+	filters := []FieldFilter{{FieldName: "Status", Values: []string{"In Progress", "Done"}}}
+
+	got := ExpandFilterAliases(filters, aliases)
+	want := []FieldFilter{{FieldName: "Status", Values: []string{"In Progress", "In-Progress", "WIP", "Done"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// Filtering by an alias also matches the canonical spelling and its siblings
+	got = ExpandFilterAliases([]FieldFilter{{FieldName: "Status", Values: []string{"wip"}}}, aliases)
+	want = []FieldFilter{{FieldName: "Status", Values: []string{"wip", "In Progress", "In-Progress"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// No aliases leaves filters untouched
+	if got := ExpandFilterAliases(filters, nil); !reflect.DeepEqual(got, filters) {
+		t.Errorf("expected filters unchanged without aliases, got %v", got)
+	}
+}
+
+func TestFilterProjectItems_FieldAliases(t *testing.T) {
+	item := func(number int, status string) ProjectItem {
+		return ProjectItem{
+			ContentType: ContentTypeIssue,
+			IssueRef:    &input.IssueRef{Owner: "org", Repo: "repo", Number: number},
+			FieldValues: map[string]FieldValue{
+				"Status": {Type: FieldTypeSingleSelect, Text: status},
+			},
+		}
+	}
+	items := []ProjectItem{item(1, "In Progress"), item(2, "In-Progress"), item(3, "Done"), item(4, "Todo")}
+
+	config := ProjectConfig{
+		FieldFilters: []FieldFilter{{FieldName: "Status", Values: []string{"In Progress", "Done"}}},
+		FieldAliases: FieldAliases{"In-Progress": "In Progress"},
+	}
+	refs := FilterProjectItems(context.Background(), items, config)
+	if len(refs) != 3 {
+		t.Fatalf("expected aliased and non-aliased values to match (3 refs), got %d", len(refs))
+	}
+	for i, number := range []int{1, 2, 3} {
+		if refs[i].Number != number {
+			t.Errorf("ref %d: expected issue %d, got %d", i, number, refs[i].Number)
+		}
+	}
+
+	// Without aliases only the exact spelling matches, as before
+	config.FieldAliases = nil
+	if refs := FilterProjectItems(context.Background(), items, config); len(refs) != 2 {
+		t.Errorf("expected 2 refs without aliases, got %d", len(refs))
+	}
+}
+
+func TestClient_FetchProjectItems_FieldAliasesInQuery(t *testing.T) {
+	var itemsQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if itemsQuery == "" {
+			// Later requests sample the board for empty-result hints
+			itemsQuery, _ = req.Variables["query"].(string)
+		}
+		_ = json.NewEncoder(w).Encode(graphQLResponse{
+			Data: &projectData{Organization: &projectV2Wrapper{ProjectV2: &projectV2{ID: "PVT_123"}}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+
+	ref, _ := ParseProjectURL("org:test-org/5")
+	config := ProjectConfig{
+		Ref:          ref,
+		FieldFilters: []FieldFilter{{FieldName: "Status", Values: []string{"In Progress"}}},
+		FieldAliases: FieldAliases{"In-Progress": "In Progress"},
+		MaxItems:     100,
+	}
+	if _, err := client.FetchProjectItems(context.Background(), config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `Status:"In Progress",In-Progress is:issue -is:draft`
+	if itemsQuery != expected {
+		t.Errorf("expected items query %q, got %q", expected, itemsQuery)
+	}
+}
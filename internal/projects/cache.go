@@ -0,0 +1,135 @@
+package projects
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Cache stores fetched project items on disk so repeated runs against the
+// same board can skip the GraphQL API until the entry expires
+type Cache struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+// ProjectSnapshot is the result of a project fetch as held in the cache
+type ProjectSnapshot struct {
+	Title string        `json:"title,omitempty"`
+	Items []ProjectItem `json:"items"`
+}
+
+// cacheEntry is the on-disk representation of a cached fetch
+type cacheEntry struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	ProjectSnapshot
+}
+
+// NewCache creates a cache rooted at dir whose entries are valid for ttl
+func NewCache(dir string, ttl time.Duration) *Cache {
+	return &Cache{
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// DefaultCacheDir returns the per-user cache directory for project items
+func DefaultCacheDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate user cache directory: %w", err)
+	}
+	return filepath.Join(base, "weekly-report-cli", "projects"), nil
+}
+
+// Load returns the cached snapshot for config if a fresh entry exists
+func (c *Cache) Load(config ProjectConfig) (ProjectSnapshot, bool) {
+	data, err := os.ReadFile(c.path(config))
+	if err != nil {
+		return ProjectSnapshot{}, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return ProjectSnapshot{}, false
+	}
+
+	if c.now().Sub(entry.FetchedAt) > c.ttl {
+		return ProjectSnapshot{}, false
+	}
+
+	return entry.ProjectSnapshot, true
+}
+
+// Store writes snapshot for config to the cache
+func (c *Cache) Store(config ProjectConfig, snapshot ProjectSnapshot) error {
+	data, err := json.Marshal(cacheEntry{FetchedAt: c.now(), ProjectSnapshot: snapshot})
+	if err != nil {
+		return fmt.Errorf("failed to encode project cache entry: %w", err)
+	}
+
+	if err := os.MkdirAll(c.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create project cache directory: %w", err)
+	}
+
+	// Write to a temp file first so concurrent runs never read a partial entry
+	tmp, err := os.CreateTemp(c.dir, "items-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write project cache entry: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write project cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write project cache entry: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), c.path(config)); err != nil {
+		return fmt.Errorf("failed to write project cache entry: %w", err)
+	}
+
+	return nil
+}
+
+// path returns the cache file for config
+func (c *Cache) path(config ProjectConfig) string {
+	return filepath.Join(c.dir, cacheKey(config)+".json")
+}
+
+// cacheKey derives a stable key from everything that affects the fetched items:
+// the project ref and max-items, plus the view and filters that shape the query
+func cacheKey(config ProjectConfig) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s|%d|%s|%s|%t", config.Ref.String(), config.MaxItems, config.ViewID, strings.ToLower(config.ViewName), config.IncludePRs)
+	if config.UpdatedSince != "" {
+		fmt.Fprintf(&b, "|updated:%s", config.UpdatedSince)
+	}
+	if config.StrictFields {
+		b.WriteString("|strict")
+	}
+	for _, f := range config.FieldFilters {
+		fmt.Fprintf(&b, "|%s=%s", f.FieldName, strings.Join(f.Values, ","))
+	}
+	aliases := make([]string, 0, len(config.FieldAliases))
+	for alias, canonical := range config.FieldAliases {
+		aliases = append(aliases, alias+"="+canonical)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		fmt.Fprintf(&b, "|alias:%s", alias)
+	}
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
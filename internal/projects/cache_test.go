@@ -0,0 +1,166 @@
+package projects
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/input"
+)
+
+func testCacheConfig(t *testing.T) ProjectConfig {
+	t.Helper()
+	ref, err := ParseProjectURL("org:test-org/5")
+	if err != nil {
+		t.Fatalf("failed to parse project ref: %v", err)
+	}
+	return ProjectConfig{Ref: ref, MaxItems: 100}
+}
+
+func testCacheItems() []ProjectItem {
+	due := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	return []ProjectItem{
+		{
+			ContentType: ContentTypeIssue,
+			IssueRef:    &input.IssueRef{Owner: "org", Repo: "repo", Number: 1, URL: "https://github.com/org/repo/issues/1"},
+			FieldValues: map[string]FieldValue{
+				"Status": {Type: FieldTypeSingleSelect, Text: "In Progress"},
+				"Due":    {Type: FieldTypeDate, Date: &due},
+			},
+		},
+	}
+}
+
+func TestCache_Miss(t *testing.T) {
+	cache := NewCache(t.TempDir(), 10*time.Minute)
+
+	if _, ok := cache.Load(testCacheConfig(t)); ok {
+		t.Error("expected cache miss for empty cache")
+	}
+}
+
+func TestCache_Hit(t *testing.T) {
+	cache := NewCache(t.TempDir(), 10*time.Minute)
+	config := testCacheConfig(t)
+
+	if err := cache.Store(config, ProjectSnapshot{Title: "Roadmap", Items: testCacheItems()}); err != nil {
+		t.Fatalf("unexpected store error: %v", err)
+	}
+
+	snapshot, ok := cache.Load(config)
+	if !ok {
+		t.Fatal("expected cache hit")
+	}
+	if snapshot.Title != "Roadmap" {
+		t.Errorf("got Title=%q, want Roadmap", snapshot.Title)
+	}
+	items := snapshot.Items
+	if len(items) != 1 || items[0].IssueRef == nil || items[0].IssueRef.Number != 1 {
+		t.Fatalf("unexpected cached items: %+v", items)
+	}
+	if got := items[0].FieldValues["Status"].Text; got != "In Progress" {
+		t.Errorf("got Status=%q, want In Progress", got)
+	}
+	if got := items[0].FieldValues["Due"].String(); got != "2025-08-01" {
+		t.Errorf("got Due=%q, want 2025-08-01", got)
+	}
+}
+
+func TestCache_KeyIncludesMaxItems(t *testing.T) {
+	cache := NewCache(t.TempDir(), 10*time.Minute)
+	config := testCacheConfig(t)
+
+	if err := cache.Store(config, ProjectSnapshot{Title: "Roadmap", Items: testCacheItems()}); err != nil {
+		t.Fatalf("unexpected store error: %v", err)
+	}
+
+	config.MaxItems = 50
+	if _, ok := cache.Load(config); ok {
+		t.Error("expected cache miss for different max-items")
+	}
+}
+
+func TestCache_KeyIncludesUpdatedSince(t *testing.T) {
+	cache := NewCache(t.TempDir(), 10*time.Minute)
+	config := testCacheConfig(t)
+
+	if err := cache.Store(config, ProjectSnapshot{Title: "Roadmap", Items: testCacheItems()}); err != nil {
+		t.Fatalf("unexpected store error: %v", err)
+	}
+
+	config.UpdatedSince = "2025-08-01"
+	if _, ok := cache.Load(config); ok {
+		t.Error("expected cache miss for a different updated-since date")
+	}
+}
+
+func TestCache_KeyIncludesStrictFields(t *testing.T) {
+	cache := NewCache(t.TempDir(), 10*time.Minute)
+	config := testCacheConfig(t)
+
+	if err := cache.Store(config, ProjectSnapshot{Title: "Roadmap", Items: testCacheItems()}); err != nil {
+		t.Fatalf("unexpected store error: %v", err)
+	}
+
+	config.StrictFields = true
+	if _, ok := cache.Load(config); ok {
+		t.Error("expected cache miss when strict field checking is enabled")
+	}
+}
+
+func TestCache_Expiry(t *testing.T) {
+	cache := NewCache(t.TempDir(), 10*time.Minute)
+	config := testCacheConfig(t)
+
+	now := time.Date(2025, 8, 1, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	if err := cache.Store(config, ProjectSnapshot{Title: "Roadmap", Items: testCacheItems()}); err != nil {
+		t.Fatalf("unexpected store error: %v", err)
+	}
+
+	now = now.Add(5 * time.Minute)
+	if _, ok := cache.Load(config); !ok {
+		t.Error("expected cache hit within TTL")
+	}
+
+	now = now.Add(10 * time.Minute)
+	if _, ok := cache.Load(config); ok {
+		t.Error("expected cache miss after TTL expired")
+	}
+}
+
+func TestClient_FetchProjectItems_UsesCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.Error(w, "should not be called", http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+
+	cache := NewCache(t.TempDir(), 10*time.Minute)
+	config := testCacheConfig(t)
+	if err := cache.Store(config, ProjectSnapshot{Title: "Roadmap", Items: testCacheItems()}); err != nil {
+		t.Fatalf("unexpected store error: %v", err)
+	}
+	client.SetCache(cache)
+
+	items, err := client.FetchProjectItems(context.Background(), config)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Errorf("expected 1 cached item, got %d", len(items))
+	}
+	if requests != 0 {
+		t.Errorf("expected no API requests on cache hit, got %d", requests)
+	}
+	if got := client.ProjectTitle(); got != "Roadmap" {
+		t.Errorf("expected cached project title Roadmap, got %q", got)
+	}
+}
@@ -4,11 +4,14 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/Attamusc/weekly-report-cli/internal/input"
@@ -21,6 +24,7 @@ const (
 	maxRetries        = 3
 	baseBackoffMs     = 1000 // 1 second
 	requestTimeoutSec = 30   // 30 seconds
+	viewsPageSize     = 20   // Views fetched per request
 )
 
 // Client is a GraphQL client for GitHub Projects API
@@ -28,6 +32,8 @@ type Client struct {
 	httpClient *http.Client
 	baseURL    string
 	token      string
+	cache      *Cache // Optional on-disk cache of fetched items (nil = disabled)
+	title      string // Title of the most recently fetched project
 }
 
 // NewClient creates a new GitHub Projects GraphQL client
@@ -41,6 +47,22 @@ func NewClient(token string) *Client {
 	}
 }
 
+// SetTransport sends the client's requests through transport (e.g. one with a proxy)
+func (c *Client) SetTransport(transport http.RoundTripper) {
+	c.httpClient.Transport = transport
+}
+
+// ProjectTitle returns the title of the project from the most recent
+// FetchProjectItems call, or "" before any fetch
+func (c *Client) ProjectTitle() string {
+	return c.title
+}
+
+// SetCache enables on-disk caching of FetchProjectItems results
+func (c *Client) SetCache(cache *Cache) {
+	c.cache = cache
+}
+
 // FetchProjectItems fetches all items from a project with field values
 // Handles pagination automatically and returns all items up to maxItems limit
 func (c *Client) FetchProjectItems(ctx context.Context, config ProjectConfig) ([]ProjectItem, error) {
@@ -52,10 +74,20 @@ func (c *Client) FetchProjectItems(ctx context.Context, config ProjectConfig) ([
 
 	logger.Debug("Fetching project items", "project", config.Ref.String(), "maxItems", config.MaxItems)
 
+	if c.cache != nil {
+		if snapshot, ok := c.cache.Load(config); ok {
+			logger.Info("Project items loaded from cache", "project", config.Ref.String(), "total", len(snapshot.Items))
+			c.title = snapshot.Title
+			return snapshot.Items, nil
+		}
+		logger.Debug("Project cache miss", "project", config.Ref.String())
+	}
+
 	// Build query string for server-side filtering
 	var queryParts []string
 
-	// 1. Add view filter if specified
+	// 1. Resolve the view (if specified) and parse its filter into field filters
+	var viewFilters []FieldFilter
 	if config.ViewName != "" || config.ViewID != "" {
 		logger.Debug("View specified, resolving view", "viewName", config.ViewName, "viewID", config.ViewID)
 
@@ -66,17 +98,25 @@ func (c *Client) FetchProjectItems(ctx context.Context, config ProjectConfig) ([
 
 		logger.Debug("View resolved", "viewName", view.Name, "viewID", view.ID, "filter", view.Filter)
 
-		// Add view filter string directly (no parsing needed)
 		if view.Filter != "" && view.Filter != "null" {
-			queryParts = append(queryParts, view.Filter)
+			var viewTerms []string
+			viewFilters, viewTerms = parseViewFilter(view.Filter)
+			// Terms that aren't field filters (is:, -field:, free text) pass through unchanged
+			queryParts = append(queryParts, viewTerms...)
 		}
 	}
 
-	// 2. Convert manual filters to query string format
-	if len(config.FieldFilters) > 0 {
-		manualQuery := ConvertFieldFiltersToQueryString(config.FieldFilters)
-		if manualQuery != "" {
-			queryParts = append(queryParts, manualQuery)
+	// 2. Merge view and manual field filters (manual wins on the same field),
+	// then widen values to their aliased spellings
+	merged := ExpandFilterAliases(MergeFilters(viewFilters, config.FieldFilters), config.FieldAliases)
+	// GitHub's query syntax has no regex or pseudo-fields, so those filters are applied after fetching
+	serverFilters, clientFilters := splitClientFilters(merged)
+	fieldQueryAt := -1
+	if len(merged) > 0 {
+		logger.Debug("Field filters", "filters", FormatFilterSummary(merged))
+		if fieldQuery := ConvertFieldFiltersToQueryString(serverFilters); fieldQuery != "" {
+			fieldQueryAt = len(queryParts)
+			queryParts = append(queryParts, fieldQuery)
 		}
 	}
 
@@ -88,7 +128,13 @@ func (c *Client) FetchProjectItems(ctx context.Context, config ProjectConfig) ([
 	// 4. Always exclude drafts
 	queryParts = append(queryParts, "-is:draft")
 
-	// 5. Combine all query parts with spaces (AND logic)
+	// 5. Limit to recently updated items; "updated" is the item's own last-updated
+	// timestamp, which the board tracks without needing a date field
+	if config.UpdatedSince != "" {
+		queryParts = append(queryParts, "updated:>="+config.UpdatedSince)
+	}
+
+	// 6. Combine all query parts with spaces (AND logic)
 	queryString := strings.Join(queryParts, " ")
 
 	logger.Info("Using server-side filtering", "query", queryString)
@@ -119,9 +165,20 @@ func (c *Client) FetchProjectItems(ctx context.Context, config ProjectConfig) ([
 			return nil, fmt.Errorf("project not found: %s", config.Ref.String())
 		}
 
+		// A mistyped field name silently matches nothing, so check it against the board once
+		if cursor == nil {
+			c.title = project.Title
+			if err := validateFilterFields(config.FieldFilters, project.Fields.Nodes, config.Ref); err != nil {
+				return nil, err
+			}
+		}
+
 		// Convert items to ProjectItem structs
 		// Items are already filtered by GitHub based on the query
-		pageItems := c.convertProjectItems(project.Items.Nodes)
+		pageItems, err := c.convertProjectItems(ctx, project.Items.Nodes, config.StrictFields)
+		if err != nil {
+			return nil, err
+		}
 
 		allItems = append(allItems, pageItems...)
 		totalFetched += len(pageItems)
@@ -135,10 +192,65 @@ func (c *Client) FetchProjectItems(ctx context.Context, config ProjectConfig) ([
 
 	logger.Info("Project items fetched (server-filtered)", "project", config.Ref.String(), "total", len(allItems), "query", queryString)
 
-	// Items are already filtered by GitHub - no client-side filtering needed
+	if len(allItems) == 0 && fieldQueryAt >= 0 {
+		// Sample the board with every term except the field filters
+		unfiltered := append(append([]string(nil), queryParts[:fieldQueryAt]...), queryParts[fieldQueryAt+1:]...)
+		c.logServerFilterHints(ctx, query, config, serverFilters, strings.Join(unfiltered, " "))
+	}
+
+	if len(clientFilters) > 0 {
+		var matched []ProjectItem
+		tally := newValueTally(clientFilters)
+		for _, item := range allItems {
+			tally.add(item)
+			if MatchesFilters(item, clientFilters) {
+				matched = append(matched, item)
+			}
+		}
+		logger.Info("Applied client-side field filters", "filters", FormatFilterSummary(clientFilters), "matched", len(matched), "fetched", len(allItems))
+		if len(matched) == 0 {
+			tally.logHints(ctx, "No project items matched the client-side field filters")
+		}
+		allItems = matched
+	}
+
+	if c.cache != nil {
+		if err := c.cache.Store(config, ProjectSnapshot{Title: c.title, Items: allItems}); err != nil {
+			logger.Warn("Failed to write project cache", "error", err)
+		}
+	}
+
 	return allItems, nil
 }
 
+// logServerFilterHints samples one page of the board without the field
+// filters and logs the values it finds for each filtered field, so a server-side
+// filter that matched nothing says what it could have matched. Failures only
+// cost the hint.
+func (c *Client) logServerFilterHints(ctx context.Context, query string, config ProjectConfig, filters []FieldFilter, unfilteredQuery string) {
+	response, err := c.fetchProjectPage(ctx, query, config.Ref, 100, nil, unfilteredQuery)
+	if err != nil {
+		if logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger); ok {
+			logger.Debug("Could not sample project items for filter hints", "error", err)
+		}
+		return
+	}
+	project := response.Data.GetProject()
+	if project == nil {
+		return
+	}
+	items, err := c.convertProjectItems(ctx, project.Items.Nodes, false)
+	if err != nil {
+		return
+	}
+
+	tally := newValueTally(filters)
+	for _, item := range items {
+		tally.add(item)
+	}
+	tally.logHints(ctx, "No project items matched the field filters")
+}
+
 // FetchProjectViews fetches all views from a project
 func (c *Client) FetchProjectViews(ctx context.Context, ref ProjectRef) ([]ProjectView, error) {
 	// Get logger from context if available
@@ -149,48 +261,55 @@ func (c *Client) FetchProjectViews(ctx context.Context, ref ProjectRef) ([]Proje
 
 	logger.Debug("Fetching project views", "project", ref.String())
 
-	// Build the query
+	// Build the query once
 	query := buildProjectViewsQuery(ref.Type)
 
-	// Build variables (no pagination needed - fetching first 20 views is sufficient)
-	variables := map[string]interface{}{
-		"owner":  ref.Owner,
-		"number": ref.Number,
-	}
+	var views []ProjectView
+	var cursor *string
+	for {
+		variables := map[string]interface{}{
+			"owner":  ref.Owner,
+			"number": ref.Number,
+			"first":  viewsPageSize,
+		}
+		if cursor != nil {
+			variables["cursor"] = *cursor
+		}
 
-	// Build request
-	request := graphQLRequest{
-		Query:     query,
-		Variables: variables,
-	}
+		// Execute with retries
+		response, err := c.executeGraphQLWithRetry(ctx, graphQLRequest{Query: query, Variables: variables}, ref)
+		if err != nil {
+			return nil, err
+		}
 
-	// Execute with retries
-	response, err := c.executeGraphQLWithRetry(ctx, request, ref)
-	if err != nil {
-		return nil, err
-	}
+		// Extract project data
+		project := response.Data.GetProject()
+		if project == nil {
+			return nil, fmt.Errorf("project not found: %s", ref.String())
+		}
 
-	// Extract project data
-	project := response.Data.GetProject()
-	if project == nil {
-		return nil, fmt.Errorf("project not found: %s", ref.String())
-	}
+		// Convert view nodes to ProjectView structs
+		for _, node := range project.Views.Nodes {
+			view := ProjectView{
+				ID:     node.ID,
+				Name:   node.Name,
+				Layout: node.Layout,
+			}
 
-	// Convert view nodes to ProjectView structs
-	views := make([]ProjectView, 0, len(project.Views.Nodes))
-	for _, node := range project.Views.Nodes {
-		view := ProjectView{
-			ID:     node.ID,
-			Name:   node.Name,
-			Layout: node.Layout,
-		}
+			// Filter may be null/nil in GraphQL response
+			if node.Filter != nil {
+				view.Filter = *node.Filter
+			}
 
-		// Filter may be null/nil in GraphQL response
-		if node.Filter != nil {
-			view.Filter = *node.Filter
+			views = append(views, view)
 		}
 
-		views = append(views, view)
+		// Stop on the last page, or when a page has no cursor to continue from
+		if !project.Views.PageInfo.HasNextPage || project.Views.PageInfo.EndCursor == nil {
+			break
+		}
+		cursor = project.Views.PageInfo.EndCursor
+		logger.Debug("Fetching next page of project views", "project", ref.String(), "fetched", len(views))
 	}
 
 	logger.Info("Project views fetched", "project", ref.String(), "total", len(views))
@@ -198,6 +317,31 @@ func (c *Client) FetchProjectViews(ctx context.Context, ref ProjectRef) ([]Proje
 	return views, nil
 }
 
+// CheckAccess makes a single lightweight query for the project's title, without
+// retries, so a missing scope or access problem is reported right away
+func (c *Client) CheckAccess(ctx context.Context, ref ProjectRef) (string, error) {
+	request := graphQLRequest{
+		Query: buildProjectTitleQuery(ref.Type),
+		Variables: map[string]interface{}{
+			"owner":  ref.Owner,
+			"number": ref.Number,
+		},
+	}
+
+	response, err := c.executeGraphQL(ctx, request)
+	if err != nil {
+		return "", enhanceGraphQLError(err, ref)
+	}
+	if len(response.Errors) > 0 {
+		return "", formatGraphQLErrors(response.Errors, ref)
+	}
+
+	if response.Data == nil || response.Data.GetProject() == nil {
+		return "", fmt.Errorf("project not found: %s", ref.String())
+	}
+	return response.Data.GetProject().Title, nil
+}
+
 // resolveView resolves a view by ID or name
 func (c *Client) resolveView(ctx context.Context, config ProjectConfig) (*ProjectView, error) {
 	// Get logger from context
@@ -214,6 +358,9 @@ func (c *Client) resolveView(ctx context.Context, config ProjectConfig) (*Projec
 
 	// If ViewID is specified, use it (takes precedence)
 	if config.ViewID != "" {
+		if config.ViewName != "" {
+			logger.Debug("Both view ID and name given, ignoring name", "viewID", config.ViewID, "viewName", config.ViewName)
+		}
 		logger.Debug("Looking up view by ID", "viewID", config.ViewID)
 		view, err := findViewByID(views, config.ViewID)
 		if err != nil {
@@ -350,6 +497,11 @@ func (c *Client) executeGraphQLWithRetry(ctx context.Context, request graphQLReq
 		if err != nil {
 			lastErr = err
 
+			// Don't retry once the caller has given up (e.g. the run deadline passed)
+			if ctx.Err() != nil {
+				return nil, err
+			}
+
 			// Check if it's a rate limit error
 			if isRateLimitError(err) {
 				logger.Debug("GraphQL rate limit hit", "attempt", attempt)
@@ -435,8 +587,15 @@ func (c *Client) executeGraphQL(ctx context.Context, request graphQLRequest) (*g
 	return &response, nil
 }
 
-// convertProjectItems converts GraphQL response items to ProjectItem structs
-func (c *Client) convertProjectItems(nodes []projectItemNode) []ProjectItem {
+// convertProjectItems converts GraphQL response items to ProjectItem structs.
+// When an item has two values for the same field the last one wins and the
+// collision is logged; with strict set it is an error instead.
+func (c *Client) convertProjectItems(ctx context.Context, nodes []projectItemNode, strict bool) ([]ProjectItem, error) {
+	logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger)
+	if !ok {
+		logger = slog.Default()
+	}
+
 	var items []ProjectItem
 
 	for _, node := range nodes {
@@ -507,18 +666,47 @@ func (c *Client) convertProjectItems(nodes []projectItemNode) []ProjectItem {
 					Type:   FieldTypeNumber,
 					Number: *fv.Number,
 				}
+			} else if values := multiSelectValues(fv); len(values) > 0 {
+				fieldValue = FieldValue{
+					Type:   FieldTypeMultiSelect,
+					Values: values,
+				}
 			} else {
 				// Unknown field type, skip
 				continue
 			}
 
+			if previous, exists := item.FieldValues[fieldName]; exists {
+				itemName := node.ID
+				if item.IssueRef != nil {
+					itemName = item.IssueRef.URL
+				}
+				if strict {
+					return nil, fmt.Errorf("project item %s has multiple values for field %q (%q and %q)",
+						itemName, fieldName, previous.String(), fieldValue.String())
+				}
+				logger.Debug("Duplicate project field value, keeping the last", "item", itemName,
+					"field", fieldName, "dropped", previous.String(), "kept", fieldValue.String())
+			}
 			item.FieldValues[fieldName] = fieldValue
 		}
 
 		items = append(items, item)
 	}
 
-	return items
+	return items, nil
+}
+
+// multiSelectValues returns the selected values of a multi-value field node
+func multiSelectValues(fv projectFieldValueNode) []string {
+	if fv.Labels == nil {
+		return nil
+	}
+	values := make([]string, 0, len(fv.Labels.Nodes))
+	for _, label := range fv.Labels.Nodes {
+		values = append(values, label.Name)
+	}
+	return values
 }
 
 // httpError represents an HTTP error response
@@ -540,13 +728,31 @@ func isRateLimitError(err error) bool {
 	return false
 }
 
-// isRetryableError checks if an error is retryable
+// isRetryableError checks if an error is retryable: a 5xx response or a
+// transient network failure
 func isRetryableError(err error) bool {
 	if httpErr, ok := err.(*httpError); ok {
 		// Retry on 5xx errors
 		return httpErr.StatusCode >= 500
 	}
-	return false
+	return isTransientNetError(err)
+}
+
+// isTransientNetError reports whether err is a network failure worth retrying:
+// a timeout, a connection closed or reset mid-request, or a temporary DNS error
+func isTransientNetError(err error) bool {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
 }
 
 // enhanceGraphQLError enhances a GraphQL error with helpful context
@@ -587,3 +793,36 @@ func formatGraphQLErrors(errors []graphQLError, ref ProjectRef) error {
 
 	return fmt.Errorf("GraphQL errors for project '%s':\n  - %s", ref.String(), strings.Join(messages, "\n  - "))
 }
+
+// validateFilterFields returns an error naming the available fields when a
+// filter refers to a field the project does not define and that is not a
+// pseudo-field. Matching is case-insensitive, as GitHub's filter syntax is.
+// With no field definitions in the response there is nothing to check against.
+func validateFilterFields(filters []FieldFilter, fields []projectField, ref ProjectRef) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	known := make(map[string]bool, len(fields))
+	var names []string
+	for _, field := range fields {
+		if field.Name == "" {
+			continue
+		}
+		known[strings.ToLower(field.Name)] = true
+		names = append(names, field.Name)
+	}
+
+	for _, filter := range filters {
+		if !known[strings.ToLower(filter.FieldName)] && !IsPseudoField(filter.FieldName) {
+			return fmt.Errorf(
+				"field '%s' not found in project %s\n\n"+
+					"Available fields:\n  - %s",
+				filter.FieldName,
+				ref.String(),
+				strings.Join(names, "\n  - "),
+			)
+		}
+	}
+	return nil
+}
@@ -1,13 +1,22 @@
 package projects
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
+
+	"github.com/Attamusc/weekly-report-cli/internal/input"
 )
 
 func TestClient_FetchProjectItems_OrgProject(t *testing.T) {
@@ -103,6 +112,9 @@ func TestClient_FetchProjectItems_OrgProject(t *testing.T) {
 	if len(items) != 1 {
 		t.Fatalf("expected 1 item, got %d", len(items))
 	}
+	if got := client.ProjectTitle(); got != "Test Project" {
+		t.Errorf("expected project title %q, got %q", "Test Project", got)
+	}
 
 	item := items[0]
 	if item.ContentType != ContentTypeIssue {
@@ -782,6 +794,55 @@ func TestClient_FetchProjectViews_OrgProject(t *testing.T) {
 	}
 }
 
+// TestClient_resolveView_SecondPage tests that a view past the first page of
+// views is found by following the views cursor
+func TestClient_resolveView_SecondPage(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		requests++
+
+		views := projectViews{
+			Nodes:    []projectViewNode{{ID: "VIEW1", Name: "Backlog", Layout: "TABLE_LAYOUT"}},
+			PageInfo: pageInfo{HasNextPage: true, EndCursor: stringPtr("cursor-1")},
+		}
+		if cursor, _ := req.Variables["cursor"].(string); cursor == "cursor-1" {
+			views = projectViews{
+				Nodes: []projectViewNode{{ID: "VIEW21", Name: "Launch Week", Filter: stringPtr(`{"Status":["Blocked"]}`), Layout: "BOARD_LAYOUT"}},
+			}
+		} else if _, ok := req.Variables["cursor"]; ok {
+			t.Errorf("unexpected cursor on the first request: %v", req.Variables["cursor"])
+		}
+
+		json.NewEncoder(w).Encode(graphQLResponse{
+			Data: &projectData{
+				Organization: &projectV2Wrapper{
+					ProjectV2: &projectV2{ID: "PVT_123", Title: "Test Project", Views: views},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+	ref, _ := ParseProjectURL("org:test-org/5")
+
+	view, err := client.resolveView(context.Background(), ProjectConfig{Ref: ref, ViewName: "Launch Week"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if view.ID != "VIEW21" {
+		t.Errorf("expected VIEW21 from the second page, got %s", view.ID)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 view requests, got %d", requests)
+	}
+}
+
 // TestClient_FetchProjectViews_UserProject tests fetching views from a user project
 func TestClient_FetchProjectViews_UserProject(t *testing.T) {
 	// Create mock GraphQL server
@@ -1321,3 +1382,498 @@ func stringPtr(s string) *string {
 func floatPtr(f float64) *float64 {
 	return &f
 }
+
+// TestClient_FetchProjectItems_ViewFilterMergedWithManualFilters tests that a named view's
+// filter is resolved, parsed, and merged with manual filters (manual wins on the same field)
+func TestClient_FetchProjectItems_ViewFilterMergedWithManualFilters(t *testing.T) {
+	var itemsQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		project := &projectV2{ID: "PVT_123", Title: "Test Project"}
+		if strings.Contains(req.Query, "views") {
+			project.Views = projectViews{
+				Nodes: []projectViewNode{
+					{ID: "VIEW1", Name: "Blocked Items", Filter: stringPtr("status:Blocked"), Layout: "TABLE_LAYOUT"},
+					{ID: "VIEW2", Name: "Current Sprint", Filter: stringPtr(`iteration:"Sprint 12" status:Todo is:open`), Layout: "BOARD_LAYOUT"},
+				},
+			}
+		} else if itemsQuery == "" {
+			// Later requests sample the board for empty-result hints
+			itemsQuery, _ = req.Variables["query"].(string)
+		}
+
+		_ = json.NewEncoder(w).Encode(graphQLResponse{
+			Data: &projectData{Organization: &projectV2Wrapper{ProjectV2: project}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+
+	ref, _ := ParseProjectURL("org:test-org/5")
+	config := ProjectConfig{
+		Ref:      ref,
+		ViewName: "current sprint",
+		FieldFilters: []FieldFilter{
+			{FieldName: "status", Values: []string{"In Progress"}},
+			{FieldName: "Priority", Values: []string{"High"}},
+		},
+		MaxItems: 100,
+	}
+
+	if _, err := client.FetchProjectItems(context.Background(), config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `is:open iteration:"Sprint 12" status:"In Progress" Priority:High is:issue -is:draft`
+	if itemsQuery != expected {
+		t.Errorf("expected items query %q, got %q", expected, itemsQuery)
+	}
+
+	// Unknown view names fail with the list of available views
+	config.ViewName = "Nonexistent"
+	_, err := client.FetchProjectItems(context.Background(), config)
+	if err == nil {
+		t.Fatal("expected error for unknown view")
+	}
+	if !strings.Contains(err.Error(), "Blocked Items") || !strings.Contains(err.Error(), "Current Sprint") {
+		t.Errorf("expected error to list available views, got: %v", err)
+	}
+}
+
+// TestClient_resolveView_IDPrecedence tests view selection by ID, including
+// precedence over a view name and the error listing valid IDs
+func TestClient_resolveView_IDPrecedence(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(graphQLResponse{
+			Data: &projectData{
+				Organization: &projectV2Wrapper{
+					ProjectV2: &projectV2{
+						ID: "PVT_123",
+						Views: projectViews{
+							Nodes: []projectViewNode{
+								{ID: "VIEW1", Name: "Blocked Items", Layout: "TABLE_LAYOUT"},
+								{ID: "VIEW2", Name: "Current Sprint", Layout: "BOARD_LAYOUT"},
+							},
+						},
+					},
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+	ref, _ := ParseProjectURL("org:test-org/5")
+
+	tests := []struct {
+		name       string
+		viewID     string
+		viewName   string
+		wantViewID string
+		wantErr    []string
+	}{
+		{name: "ID match", viewID: "VIEW2", wantViewID: "VIEW2"},
+		{name: "ID overrides name", viewID: "VIEW2", viewName: "Blocked Items", wantViewID: "VIEW2"},
+		{name: "ID not found", viewID: "VIEW_MISSING", viewName: "Blocked Items", wantErr: []string{"VIEW_MISSING", "VIEW1", "VIEW2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			view, err := client.resolveView(context.Background(), ProjectConfig{Ref: ref, ViewID: tt.viewID, ViewName: tt.viewName})
+			if len(tt.wantErr) > 0 {
+				if err == nil {
+					t.Fatalf("expected error, got view %+v", view)
+				}
+				for _, want := range tt.wantErr {
+					if !strings.Contains(err.Error(), want) {
+						t.Errorf("expected error to mention %q, got: %v", want, err)
+					}
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if view.ID != tt.wantViewID {
+				t.Errorf("expected view %s, got %s", tt.wantViewID, view.ID)
+			}
+		})
+	}
+}
+
+// TestClient_convertProjectItems_MultiSelect tests that label-style multi-value fields are kept
+func TestClient_convertProjectItems_MultiSelect(t *testing.T) {
+	client := NewClient("test-token")
+	nodes := []projectItemNode{
+		{
+			ID:   "ITEM1",
+			Type: "ISSUE",
+			Content: &projectItemContent{
+				Number:     intPtr(7),
+				URL:        "https://github.com/test/repo/issues/7",
+				Repository: &contentRepository{Owner: repositoryOwner{Login: "test"}, Name: "repo"},
+			},
+			FieldValues: projectFieldValues{
+				Nodes: []projectFieldValueNode{
+					{
+						Field:  &projectFieldRef{Name: "Teams"},
+						Labels: &projectFieldLabels{Nodes: []projectFieldOption{{Name: "Platform"}, {Name: "Payments"}}},
+					},
+				},
+			},
+		},
+	}
+
+	items, err := client.convertProjectItems(context.Background(), nodes, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(items))
+	}
+
+	teams, ok := items[0].FieldValues["Teams"]
+	if !ok {
+		t.Fatal("expected Teams field to be present")
+	}
+	if teams.Type != FieldTypeMultiSelect || len(teams.Values) != 2 {
+		t.Errorf("expected multi-select with 2 values, got %+v", teams)
+	}
+
+	refs := FilterProjectItems(context.Background(), items, ProjectConfig{
+		FieldFilters: []FieldFilter{{FieldName: "Teams", Values: []string{"Payments"}}},
+	})
+	if len(refs) != 1 {
+		t.Errorf("expected item to pass a filter on one of its teams, got %d refs", len(refs))
+	}
+}
+
+// TestClient_convertProjectItems_DuplicateField tests last-wins with a debug log, and the strict error
+func TestClient_convertProjectItems_DuplicateField(t *testing.T) {
+	client := NewClient("test-token")
+	todo, blocked := "Todo", "Blocked"
+	nodes := []projectItemNode{
+		{
+			ID:   "ITEM1",
+			Type: "ISSUE",
+			Content: &projectItemContent{
+				Number:     intPtr(8),
+				URL:        "https://github.com/test/repo/issues/8",
+				Repository: &contentRepository{Owner: repositoryOwner{Login: "test"}, Name: "repo"},
+			},
+			FieldValues: projectFieldValues{
+				Nodes: []projectFieldValueNode{
+					{Field: &projectFieldRef{Name: "Status"}, Name: &todo},
+					{Field: &projectFieldRef{Name: "Status"}, Name: &blocked},
+				},
+			},
+		},
+	}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	ctx := context.WithValue(context.Background(), input.LoggerContextKey{}, logger)
+
+	items, err := client.convertProjectItems(ctx, nodes, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %d", len(items))
+	}
+	if got := items[0].FieldValues["Status"].Text; got != "Blocked" {
+		t.Errorf("expected the last Status value to win, got %q", got)
+	}
+	if !strings.Contains(logs.String(), "Duplicate project field value") || !strings.Contains(logs.String(), "field=Status") {
+		t.Errorf("expected a debug log for the collision, got %q", logs.String())
+	}
+
+	_, err = client.convertProjectItems(ctx, nodes, true)
+	if err == nil {
+		t.Fatal("expected error for duplicate field in strict mode")
+	}
+	if !strings.Contains(err.Error(), `"Status"`) || !strings.Contains(err.Error(), "issues/8") {
+		t.Errorf("expected error naming the item and field, got %v", err)
+	}
+}
+
+func TestClient_FetchProjectItems_UnknownFieldName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		project := &projectV2{
+			ID:    "PVT_123",
+			Title: "Test Project",
+			Fields: projectFields{Nodes: []projectField{
+				{Name: "Title"},
+				{Name: "Status"},
+				{Name: "Priority"},
+			}},
+		}
+		_ = json.NewEncoder(w).Encode(graphQLResponse{
+			Data: &projectData{Organization: &projectV2Wrapper{ProjectV2: project}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+
+	ref, _ := ParseProjectURL("org:test-org/5")
+	config := ProjectConfig{
+		Ref:          ref,
+		FieldFilters: []FieldFilter{{FieldName: "Staus", Values: []string{"In Progress"}}},
+		MaxItems:     100,
+	}
+
+	_, err := client.FetchProjectItems(context.Background(), config)
+	if err == nil {
+		t.Fatal("expected error for unknown field name")
+	}
+	for _, want := range []string{"'Staus' not found", "Status", "Priority"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected error to contain %q, got: %v", want, err)
+		}
+	}
+
+	// Field names are matched case-insensitively
+	config.FieldFilters = []FieldFilter{{FieldName: "status", Values: []string{"In Progress"}}}
+	if _, err := client.FetchProjectItems(context.Background(), config); err != nil {
+		t.Errorf("unexpected error for known field: %v", err)
+	}
+}
+
+func TestClient_ExecuteGraphQLWithRetry_ConnectionClosedOnce(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			// Drop the connection without a response, like a reset mid-request
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Fatalf("hijack failed: %v", err)
+			}
+			_ = conn.Close()
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+
+	ref, _ := ParseProjectURL("org:test-org/5")
+	response, err := client.executeGraphQLWithRetry(context.Background(), graphQLRequest{Query: "query { viewer { login } }"}, ref)
+	if err != nil {
+		t.Fatalf("expected retry to succeed, got %v", err)
+	}
+	if response == nil || response.Data == nil {
+		t.Errorf("expected response data, got %+v", response)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests (one dropped, one retried), got %d", requests)
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"server error", &httpError{StatusCode: 502}, true},
+		{"client error", &httpError{StatusCode: 400}, false},
+		{"connection closed", fmt.Errorf("HTTP request failed: %w", io.EOF), true},
+		{"connection reset", fmt.Errorf("HTTP request failed: %w", &net.OpError{Op: "read", Err: syscall.ECONNRESET}), true},
+		{"temporary DNS failure", fmt.Errorf("HTTP request failed: %w", &net.DNSError{Err: "server misbehaving", IsTemporary: true}), true},
+		{"unknown host", fmt.Errorf("HTTP request failed: %w", &net.DNSError{Err: "no such host", IsNotFound: true}), false},
+		{"bad response body", errors.New("failed to unmarshal GraphQL response"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.err); got != tt.want {
+				t.Errorf("isRetryableError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CheckAccess(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		wantTitle string
+		wantErr   string
+	}{
+		{
+			name:      "success",
+			status:    http.StatusOK,
+			body:      `{"data": {"organization": {"projectV2": {"id": "PVT_1", "title": "Roadmap"}}}}`,
+			wantTitle: "Roadmap",
+		},
+		{
+			name:    "missing read:project scope",
+			status:  http.StatusForbidden,
+			body:    `{"message": "Your token has not been granted the required scopes"}`,
+			wantErr: "'read:project' scope",
+		},
+		{
+			name:    "project not found",
+			status:  http.StatusOK,
+			body:    `{"data": {"organization": {"projectV2": null}}}`,
+			wantErr: "project not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := NewClient("test-token")
+			client.baseURL = server.URL
+
+			ref, _ := ParseProjectURL("org:test-org/5")
+			title, err := client.CheckAccess(context.Background(), ref)
+			if requests != 1 {
+				t.Errorf("expected a single request, got %d", requests)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want it to mention %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if title != tt.wantTitle {
+				t.Errorf("got title %q, want %q", title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestClient_FetchProjectItems_UpdatedSinceQuery(t *testing.T) {
+	var itemsQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if itemsQuery == "" {
+			// Later requests sample the board for empty-result hints
+			itemsQuery, _ = req.Variables["query"].(string)
+		}
+
+		_ = json.NewEncoder(w).Encode(graphQLResponse{
+			Data: &projectData{Organization: &projectV2Wrapper{ProjectV2: &projectV2{ID: "PVT_123", Title: "Test Project"}}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+
+	ref, _ := ParseProjectURL("org:test-org/5")
+	config := ProjectConfig{
+		Ref:          ref,
+		FieldFilters: []FieldFilter{{FieldName: "Status", Values: []string{"In Progress"}}},
+		MaxItems:     100,
+		UpdatedSince: "2025-08-01",
+	}
+
+	if _, err := client.FetchProjectItems(context.Background(), config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `Status:"In Progress" is:issue -is:draft updated:>=2025-08-01`
+	if itemsQuery != expected {
+		t.Errorf("expected items query %q, got %q", expected, itemsQuery)
+	}
+}
+
+// hintItem builds a board issue whose Status is status
+func hintItem(number int, status string) projectItemNode {
+	return projectItemNode{
+		ID:   fmt.Sprintf("ITEM%d", number),
+		Type: "ISSUE",
+		Content: &projectItemContent{
+			ID:         fmt.Sprintf("I%d", number),
+			Number:     intPtr(number),
+			URL:        fmt.Sprintf("https://github.com/test/repo/issues/%d", number),
+			Repository: &contentRepository{Owner: repositoryOwner{Login: "test"}, Name: "repo"},
+		},
+		FieldValues: projectFieldValues{Nodes: []projectFieldValueNode{
+			{Field: &projectFieldRef{Name: "Status"}, Name: stringPtr(status)},
+		}},
+	}
+}
+
+func TestClient_FetchProjectItems_ServerFilterHints(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		query, _ := req.Variables["query"].(string)
+		queries = append(queries, query)
+
+		// The filtered query matches nothing; the unfiltered sample has items
+		var nodes []projectItemNode
+		if !strings.Contains(query, "Status:") {
+			nodes = []projectItemNode{hintItem(1, "In Review"), hintItem(2, "In Review"), hintItem(3, "Shipped")}
+		}
+		_ = json.NewEncoder(w).Encode(graphQLResponse{
+			Data: &projectData{Organization: &projectV2Wrapper{ProjectV2: &projectV2{
+				ID:     "PVT_123",
+				Title:  "Test Project",
+				Fields: projectFields{Nodes: []projectField{{ID: "F1", Name: "Status"}}},
+				Items:  projectItems{Nodes: nodes},
+			}}},
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient("test-token")
+	client.baseURL = server.URL
+
+	var logs bytes.Buffer
+	ctx := context.WithValue(context.Background(), input.LoggerContextKey{}, slog.New(slog.NewTextHandler(&logs, nil)))
+
+	ref, _ := ParseProjectURL("org:test-org/5")
+	items, err := client.FetchProjectItems(ctx, ProjectConfig{
+		Ref:          ref,
+		FieldFilters: []FieldFilter{{FieldName: "Status", Values: []string{"Done"}}},
+		MaxItems:     100,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 0 {
+		t.Fatalf("expected no items, got %d", len(items))
+	}
+
+	want := []string{`Status:Done is:issue -is:draft`, `is:issue -is:draft`}
+	if strings.Join(queries, "|") != strings.Join(want, "|") {
+		t.Errorf("got queries %q, want %q", queries, want)
+	}
+	if !strings.Contains(logs.String(), "Status present values: In Review (2), Shipped (1); you filtered for: Done") {
+		t.Errorf("expected a hint naming the board's values, got logs:\n%s", logs.String())
+	}
+}
@@ -1,16 +1,24 @@
 package projects
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/Attamusc/weekly-report-cli/internal/input"
 )
 
 // FilterProjectItems filters project items based on the provided configuration
-// Returns only items that match all filter criteria
-func FilterProjectItems(items []ProjectItem, config ProjectConfig) []input.IssueRef {
+// Returns only items that match all filter criteria. When nothing matches, the
+// values actually present for each filtered field are logged as a hint.
+func FilterProjectItems(ctx context.Context, items []ProjectItem, config ProjectConfig) []input.IssueRef {
 	var issueRefs []input.IssueRef
+	filters := compileRegexFilters(ExpandFilterAliases(config.FieldFilters, config.FieldAliases))
+	tally := newValueTally(filters)
 
 	for _, item := range items {
 		// Skip draft issues (they don't have issue refs)
@@ -29,17 +37,97 @@ func FilterProjectItems(items []ProjectItem, config ProjectConfig) []input.Issue
 		}
 
 		// Apply field filters
-		if !MatchesFilters(item, config.FieldFilters) {
+		tally.add(item)
+		if !MatchesFilters(item, filters) {
 			continue
 		}
 
-		// Item passes all filters, add to results
-		issueRefs = append(issueRefs, *item.IssueRef)
+		// Item passes all filters, add to results with its field values
+		issueRefs = append(issueRefs, *item.IssueRefWithFields())
 	}
 
+	if len(issueRefs) == 0 {
+		tally.logHints(ctx, "No project items matched the field filters")
+	}
 	return issueRefs
 }
 
+// valueTally counts how often each value of the filtered fields occurs, so a
+// filter that matches nothing can show what it could have matched
+type valueTally struct {
+	filters []FieldFilter
+	counts  map[string]map[string]int // Field name -> value -> items
+}
+
+// newValueTally returns an empty tally for filters' fields
+func newValueTally(filters []FieldFilter) *valueTally {
+	return &valueTally{filters: filters, counts: make(map[string]map[string]int)}
+}
+
+// add counts item's values for the filtered fields; each multi-select option counts separately
+func (t *valueTally) add(item ProjectItem) {
+	for _, filter := range t.filters {
+		value, ok := lookupFieldValue(item, filter.FieldName)
+		if !ok {
+			continue
+		}
+		values := value.Values
+		if value.Type != FieldTypeMultiSelect {
+			values = []string{value.String()}
+		}
+		for _, v := range values {
+			if v = strings.TrimSpace(v); v == "" {
+				continue
+			}
+			if t.counts[filter.FieldName] == nil {
+				t.counts[filter.FieldName] = make(map[string]int)
+			}
+			t.counts[filter.FieldName][v]++
+		}
+	}
+}
+
+// hints describes, for every filtered field seen on at least one item, the
+// values present (most common first) next to the values filtered for, e.g.
+// "Status present values: In Review (3), Shipped (1); you filtered for: Done"
+func (t *valueTally) hints() []string {
+	var hints []string
+	for _, filter := range t.filters {
+		counts := t.counts[filter.FieldName]
+		if len(counts) == 0 {
+			continue
+		}
+		values := make([]string, 0, len(counts))
+		for value := range counts {
+			values = append(values, value)
+		}
+		sort.Slice(values, func(i, j int) bool {
+			if counts[values[i]] != counts[values[j]] {
+				return counts[values[i]] > counts[values[j]]
+			}
+			return values[i] < values[j]
+		})
+		present := make([]string, len(values))
+		for i, value := range values {
+			present[i] = fmt.Sprintf("%s (%d)", value, counts[value])
+		}
+		hints = append(hints, fmt.Sprintf("%s present values: %s; you filtered for: %s",
+			filter.FieldName, strings.Join(present, ", "), strings.Join(filter.Values, ", ")))
+	}
+	return hints
+}
+
+// logHints warns with msg and one hint per filtered field found on the items
+func (t *valueTally) logHints(ctx context.Context, msg string) {
+	logger, ok := ctx.Value(input.LoggerContextKey{}).(*slog.Logger)
+	if !ok {
+		logger = slog.Default()
+	}
+	for _, hint := range t.hints() {
+		logger.Warn(msg, "hint", hint)
+	}
+}
+
 // MatchesFilters checks if a ProjectItem matches all field filters
 // Uses AND logic between filters (all must match)
 // Uses OR logic within a filter (any value can match)
@@ -58,15 +146,22 @@ func MatchesFiltersWithDetails(item ProjectItem, filters []FieldFilter) (bool, s
 
 	// Check each filter (AND logic)
 	for _, filter := range filters {
-		// Get the field value for this filter
-		fieldValue, exists := item.FieldValues[filter.FieldName]
+		// Get the field value for this filter, falling back to a pseudo-field
+		fieldValue, exists := lookupFieldValue(item, filter.FieldName)
+		if !exists {
+			fieldValue, exists = pseudoFieldValue(item, filter.FieldName)
+		}
 		if !exists {
 			// Field doesn't exist on this item, filter fails
 			return false, fmt.Sprintf("field '%s' not found (available: %v)", filter.FieldName, getFieldKeys(item.FieldValues))
 		}
 
 		// Check if field value matches any of the filter values (OR logic)
-		if !matchFieldValue(fieldValue, filter.Values) {
+		regexes := filter.regexes
+		if regexes == nil {
+			regexes = compileRegexValues(filter.Values)
+		}
+		if !matchFilterValues(fieldValue, filter.Values, regexes) {
 			actualValue := fieldValue.String()
 			return false, fmt.Sprintf("field '%s' value '%s' (type: %s) doesn't match any of %v",
 				filter.FieldName, actualValue, fieldValue.Type, filter.Values)
@@ -77,6 +172,68 @@ func MatchesFiltersWithDetails(item ProjectItem, filters []FieldFilter) (bool, s
 	return true, ""
 }
 
+// lookupFieldValue returns item's value for the board field name, matched
+// case-insensitively as GitHub's filter syntax is
+func lookupFieldValue(item ProjectItem, name string) (FieldValue, bool) {
+	if value, ok := item.FieldValues[name]; ok {
+		return value, true
+	}
+	for fieldName, value := range item.FieldValues {
+		if strings.EqualFold(fieldName, name) {
+			return value, true
+		}
+	}
+	return FieldValue{}, false
+}
+
+// Pseudo-fields can be filtered on like board fields but come from the item
+// itself. A board field with the same name takes precedence.
+const (
+	PseudoFieldRepo   = "repo"   // Repository name or owner/name
+	PseudoFieldOwner  = "owner"  // Repository owner login
+	PseudoFieldNumber = "number" // Issue or pull request number
+	PseudoFieldType   = "type"   // issue, pullrequest (pr), or draftissue (draft)
+)
+
+// IsPseudoField reports whether name (case-insensitive) is a pseudo-field
+func IsPseudoField(name string) bool {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case PseudoFieldRepo, PseudoFieldOwner, PseudoFieldNumber, PseudoFieldType:
+		return true
+	}
+	return false
+}
+
+// pseudoFieldValue returns item's value for the pseudo-field name. Values
+// that can be spelled several ways are multi-select so any spelling matches.
+func pseudoFieldValue(item ProjectItem, name string) (FieldValue, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == PseudoFieldType {
+		values := []string{strings.ToLower(item.ContentType.String())}
+		switch item.ContentType {
+		case ContentTypePullRequest:
+			values = append(values, "pr")
+		case ContentTypeDraftIssue:
+			values = append(values, "draft")
+		}
+		return FieldValue{Type: FieldTypeMultiSelect, Values: values}, true
+	}
+
+	ref := item.IssueRef
+	if ref == nil {
+		return FieldValue{}, false
+	}
+	switch name {
+	case PseudoFieldRepo:
+		return FieldValue{Type: FieldTypeMultiSelect, Values: []string{ref.Repo, ref.Owner + "/" + ref.Repo}}, true
+	case PseudoFieldOwner:
+		return FieldValue{Type: FieldTypeSingleSelect, Text: ref.Owner}, true
+	case PseudoFieldNumber:
+		return FieldValue{Type: FieldTypeSingleSelect, Text: strconv.Itoa(ref.Number)}, true
+	}
+	return FieldValue{}, false
+}
+
 // getFieldKeys returns the keys from a FieldValues map
 func getFieldKeys(fieldValues map[string]FieldValue) []string {
 	keys := make([]string, 0, len(fieldValues))
@@ -89,6 +246,11 @@ func getFieldKeys(fieldValues map[string]FieldValue) []string {
 // matchFieldValue checks if a field value matches any of the filter values
 // Handles different field types with appropriate matching logic
 func matchFieldValue(value FieldValue, filterValues []string) bool {
+	return matchFilterValues(value, filterValues, compileRegexValues(filterValues))
+}
+
+// matchFilterValues is matchFieldValue with the "re:" values already compiled
+func matchFilterValues(value FieldValue, filterValues []string, regexes regexValues) bool {
 	// If no filter values, nothing can match
 	if len(filterValues) == 0 {
 		return false
@@ -96,34 +258,139 @@ func matchFieldValue(value FieldValue, filterValues []string) bool {
 
 	switch value.Type {
 	case FieldTypeText:
-		return matchTextValue(value.Text, filterValues)
+		return matchTextValue(value.Text, filterValues, regexes)
 
 	case FieldTypeSingleSelect:
-		return matchSingleSelectValue(value.Text, filterValues)
+		return matchSingleSelectValue(value.Text, filterValues, regexes)
 
 	case FieldTypeDate:
 		// For dates, convert to string and do text matching
 		if value.Date != nil {
 			dateStr := value.Date.Format("2006-01-02")
-			return matchTextValue(dateStr, filterValues)
+			return matchTextValue(dateStr, filterValues, regexes)
 		}
 		return false
 
 	case FieldTypeNumber:
 		// For numbers, convert to string and do text matching
 		numberStr := value.String()
-		return matchTextValue(numberStr, filterValues)
+		return matchTextValue(numberStr, filterValues, regexes)
+
+	case FieldTypeMultiSelect:
+		// Matches if any selected value equals any filter value
+		for _, selected := range value.Values {
+			if matchSingleSelectValue(selected, filterValues, regexes) {
+				return true
+			}
+		}
+		return false
 
 	default:
 		return false
 	}
 }
 
+// RegexValuePrefix marks a filter value as a regular expression matched
+// against the field's string value (e.g. "re:^Blocked")
+const RegexValuePrefix = "re:"
+
+// ValidateFilterValues returns an error for the first "re:" value that is
+// not a valid regular expression
+func ValidateFilterValues(values []string) error {
+	for _, value := range values {
+		pattern, ok := strings.CutPrefix(strings.TrimSpace(value), RegexValuePrefix)
+		if !ok {
+			continue
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid field value regex %q: %w", value, err)
+		}
+	}
+	return nil
+}
+
+// hasRegexValue reports whether any of values uses the "re:" prefix
+func hasRegexValue(values []string) bool {
+	for _, value := range values {
+		if strings.HasPrefix(strings.TrimSpace(value), RegexValuePrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitClientFilters separates filters GitHub's query syntax can express from
+// those with a "re:" value or on a pseudo-field, which have to be matched
+// client-side; client filters come back with their "re:" values compiled
+func splitClientFilters(filters []FieldFilter) (server, client []FieldFilter) {
+	for _, filter := range filters {
+		if hasRegexValue(filter.Values) || IsPseudoField(filter.FieldName) {
+			client = append(client, filter)
+		} else {
+			server = append(server, filter)
+		}
+	}
+	return server, compileRegexFilters(client)
+}
+
+// regexValues maps each "re:" filter value to its compiled pattern
+type regexValues map[string]*regexp.Regexp
+
+// compileRegexValues compiles the "re:" values among values. Invalid patterns
+// (rejected up front by ValidateFilterValues) are left out and never match.
+func compileRegexValues(values []string) regexValues {
+	regexes := regexValues{}
+	for _, value := range values {
+		pattern, ok := strings.CutPrefix(strings.TrimSpace(value), RegexValuePrefix)
+		if !ok {
+			continue
+		}
+		if re, err := regexp.Compile(pattern); err == nil {
+			regexes[value] = re
+		}
+	}
+	return regexes
+}
+
+// compileRegexFilters returns a copy of filters with their "re:" values
+// compiled, so matching many items doesn't recompile them
+func compileRegexFilters(filters []FieldFilter) []FieldFilter {
+	if len(filters) == 0 {
+		return filters
+	}
+	compiled := make([]FieldFilter, len(filters))
+	for i, filter := range filters {
+		filter.regexes = compileRegexValues(filter.Values)
+		compiled[i] = filter
+	}
+	return compiled
+}
+
+// matchRegexValue reports whether filterValue is a "re:" value and, if so,
+// whether its compiled pattern in regexes matches text
+func matchRegexValue(text, filterValue string, regexes regexValues) (isRegex, matched bool) {
+	if !strings.HasPrefix(strings.TrimSpace(filterValue), RegexValuePrefix) {
+		return false, false
+	}
+	re, ok := regexes[filterValue]
+	if !ok {
+		return true, false
+	}
+	return true, re.MatchString(strings.TrimSpace(text))
+}
+
 // matchTextValue checks if text matches any filter value (case-insensitive, contains)
-func matchTextValue(text string, filterValues []string) bool {
+func matchTextValue(text string, filterValues []string, regexes regexValues) bool {
 	textLower := strings.ToLower(strings.TrimSpace(text))
 
 	for _, filterValue := range filterValues {
+		if isRegex, matched := matchRegexValue(text, filterValue, regexes); isRegex {
+			if matched {
+				return true
+			}
+			continue
+		}
+
 		filterLower := strings.ToLower(strings.TrimSpace(filterValue))
 
 		// Check for exact match first
@@ -141,10 +408,17 @@ func matchTextValue(text string, filterValues []string) bool {
 }
 
 // matchSingleSelectValue checks if single-select value matches any filter value (case-insensitive, exact)
-func matchSingleSelectValue(value string, filterValues []string) bool {
+func matchSingleSelectValue(value string, filterValues []string, regexes regexValues) bool {
 	valueLower := strings.ToLower(strings.TrimSpace(value))
 
 	for _, filterValue := range filterValues {
+		if isRegex, matched := matchRegexValue(value, filterValue, regexes); isRegex {
+			if matched {
+				return true
+			}
+			continue
+		}
+
 		filterLower := strings.ToLower(strings.TrimSpace(filterValue))
 
 		// Single-select uses exact match only
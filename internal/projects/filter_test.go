@@ -1,6 +1,10 @@
 package projects
 
 import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
@@ -213,6 +217,158 @@ func TestMatchFieldValue_EmptyFilterValues(t *testing.T) {
 	}
 }
 
+func TestMatchFieldValue_Regex(t *testing.T) {
+	filterValues := []string{"re:^Blocked"}
+
+	for _, text := range []string{"Blocked (v2)", "Blocked on infra"} {
+		value := FieldValue{Type: FieldTypeSingleSelect, Text: text}
+		if !matchFieldValue(value, filterValues) {
+			t.Errorf("expected %q to match %v", text, filterValues)
+		}
+	}
+
+	for _, text := range []string{"Unblocked", "In Progress"} {
+		value := FieldValue{Type: FieldTypeSingleSelect, Text: text}
+		if matchFieldValue(value, filterValues) {
+			t.Errorf("expected %q not to match %v", text, filterValues)
+		}
+	}
+
+	// Plain values keep literal matching alongside regex values
+	value := FieldValue{Type: FieldTypeSingleSelect, Text: "Done"}
+	if !matchFieldValue(value, []string{"re:^Blocked", "done"}) {
+		t.Error("expected plain value to match literally next to a regex value")
+	}
+
+	multi := FieldValue{Type: FieldTypeMultiSelect, Values: []string{"Frontend", "Blocked (v3)"}}
+	if !matchFieldValue(multi, filterValues) {
+		t.Error("expected multi-select regex match on any selected value")
+	}
+}
+
+func TestValidateFilterValues(t *testing.T) {
+	if err := ValidateFilterValues([]string{"In Progress", "re:^Blocked", "re:(?i)done$"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ValidateFilterValues([]string{"re:^Blocked (v2"}); err == nil {
+		t.Error("expected error for malformed regex")
+	}
+	// Without the prefix, regex metacharacters are literal text
+	if err := ValidateFilterValues([]string{"Blocked (v2"}); err != nil {
+		t.Errorf("unexpected error for plain value: %v", err)
+	}
+}
+
+func TestSplitClientFilters(t *testing.T) {
+	filters := []FieldFilter{
+		{FieldName: "Status", Values: []string{"re:^Blocked", "Done"}},
+		{FieldName: "Priority", Values: []string{"High"}},
+		{FieldName: "Repo", Values: []string{"api"}},
+	}
+	server, client := splitClientFilters(filters)
+	if len(server) != 1 || server[0].FieldName != "Priority" {
+		t.Errorf("expected only Priority server-side, got %+v", server)
+	}
+	if len(client) != 2 || client[0].FieldName != "Status" || client[1].FieldName != "Repo" {
+		t.Errorf("expected Status and Repo client-side, got %+v", client)
+	}
+	if client[0].regexes["re:^Blocked"] == nil {
+		t.Errorf("expected client-side regex values to be compiled, got %+v", client[0].regexes)
+	}
+
+	item := ProjectItem{FieldValues: map[string]FieldValue{
+		"Status": {Type: FieldTypeSingleSelect, Text: "Blocked on design"},
+	}}
+	if !MatchesFilters(item, client[:1]) {
+		t.Error("expected the compiled regex to match")
+	}
+}
+
+func TestMatchesFilters_RepoPseudoField(t *testing.T) {
+	item := ProjectItem{
+		ContentType: ContentTypeIssue,
+		IssueRef:    &input.IssueRef{Owner: "my-org", Repo: "api", Number: 7},
+		FieldValues: map[string]FieldValue{},
+	}
+
+	testCases := []struct {
+		name   string
+		filter FieldFilter
+		want   bool
+	}{
+		{name: "repo name", filter: FieldFilter{FieldName: "repo", Values: []string{"api"}}, want: true},
+		{name: "owner/name", filter: FieldFilter{FieldName: "repo", Values: []string{"my-org/api"}}, want: true},
+		{name: "case-insensitive field and value", filter: FieldFilter{FieldName: "Repo", Values: []string{"API"}}, want: true},
+		{name: "other repo", filter: FieldFilter{FieldName: "repo", Values: []string{"web"}}, want: false},
+		{name: "no substring match", filter: FieldFilter{FieldName: "repo", Values: []string{"ap"}}, want: false},
+		{name: "regex", filter: FieldFilter{FieldName: "repo", Values: []string{"re:^my-org/"}}, want: true},
+		{name: "owner", filter: FieldFilter{FieldName: "owner", Values: []string{"my-org"}}, want: true},
+		{name: "number", filter: FieldFilter{FieldName: "number", Values: []string{"7"}}, want: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := MatchesFilters(item, []FieldFilter{tc.filter}); got != tc.want {
+				t.Errorf("MatchesFilters(%+v) = %v, want %v", tc.filter, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchesFilters_TypePseudoField(t *testing.T) {
+	ref := &input.IssueRef{Owner: "o", Repo: "r", Number: 1}
+	issue := ProjectItem{ContentType: ContentTypeIssue, IssueRef: ref}
+	pr := ProjectItem{ContentType: ContentTypePullRequest, IssueRef: ref}
+	draft := ProjectItem{ContentType: ContentTypeDraftIssue}
+
+	testCases := []struct {
+		name  string
+		item  ProjectItem
+		value string
+		want  bool
+	}{
+		{name: "issue", item: issue, value: "issue", want: true},
+		{name: "issue is not a pr", item: issue, value: "pr", want: false},
+		{name: "pull request", item: pr, value: "PullRequest", want: true},
+		{name: "pr shorthand", item: pr, value: "pr", want: true},
+		{name: "draft without issue ref", item: draft, value: "draft", want: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filters := []FieldFilter{{FieldName: "type", Values: []string{tc.value}}}
+			if got := MatchesFilters(tc.item, filters); got != tc.want {
+				t.Errorf("MatchesFilters(type=%s) = %v, want %v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchesFilters_BoardFieldShadowsPseudoField(t *testing.T) {
+	item := ProjectItem{
+		ContentType: ContentTypeIssue,
+		IssueRef:    &input.IssueRef{Owner: "o", Repo: "r", Number: 1},
+		FieldValues: map[string]FieldValue{
+			"type": {Type: FieldTypeSingleSelect, Text: "Bug"},
+		},
+	}
+	if !MatchesFilters(item, []FieldFilter{{FieldName: "type", Values: []string{"Bug"}}}) {
+		t.Error("expected the board's type field to be used")
+	}
+	if MatchesFilters(item, []FieldFilter{{FieldName: "type", Values: []string{"issue"}}}) {
+		t.Error("expected the pseudo-field to be shadowed by the board field")
+	}
+
+	// The board names the field "Type"; a lowercase filter still finds it
+	item.FieldValues = map[string]FieldValue{"Type": {Type: FieldTypeSingleSelect, Text: "Bug"}}
+	if !MatchesFilters(item, []FieldFilter{{FieldName: "type", Values: []string{"Bug"}}}) {
+		t.Error("expected the board's Type field to be matched case-insensitively")
+	}
+	if MatchesFilters(item, []FieldFilter{{FieldName: "type", Values: []string{"issue"}}}) {
+		t.Error("expected the pseudo-field to be shadowed by the differently cased board field")
+	}
+}
+
 func TestFilterProjectItems_IssuesOnly(t *testing.T) {
 	items := []ProjectItem{
 		{
@@ -245,7 +401,7 @@ func TestFilterProjectItems_IssuesOnly(t *testing.T) {
 		IncludePRs: false,
 	}
 
-	results := FilterProjectItems(items, config)
+	results := FilterProjectItems(context.Background(), items, config)
 
 	// Should only include the issue, not PR or draft
 	if len(results) != 1 {
@@ -257,6 +413,93 @@ func TestFilterProjectItems_IssuesOnly(t *testing.T) {
 	}
 }
 
+func TestFilterProjectItems_KeepsFieldValues(t *testing.T) {
+	target := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+	ref := &input.IssueRef{Owner: "test", Repo: "repo", Number: 1, URL: "https://github.com/test/repo/issues/1"}
+	items := []ProjectItem{
+		{
+			ContentType: ContentTypeIssue,
+			IssueRef:    ref,
+			FieldValues: map[string]FieldValue{
+				"Status":      {Type: FieldTypeSingleSelect, Text: "In Progress"},
+				"Target Date": {Type: FieldTypeDate, Date: &target},
+				"Labels":      {Type: FieldTypeMultiSelect, Values: []string{"api", "infra"}},
+			},
+		},
+	}
+
+	results := FilterProjectItems(context.Background(), items, ProjectConfig{
+		FieldFilters: []FieldFilter{{FieldName: "Status", Values: []string{"In Progress"}}},
+	})
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	want := map[string]string{"Status": "In Progress", "Target Date": "2025-09-30", "Labels": "api, infra"}
+	for name, value := range want {
+		if got := results[0].FieldValues[name]; got != value {
+			t.Errorf("field %q: got %q, want %q", name, got, value)
+		}
+	}
+	if ref.FieldValues != nil {
+		t.Error("expected the item's own issue ref to be left unchanged")
+	}
+}
+
+func TestFilterProjectItems_ZeroMatchesLogsPresentValues(t *testing.T) {
+	issue := func(number int, status string) ProjectItem {
+		return ProjectItem{
+			ContentType: ContentTypeIssue,
+			IssueRef:    &input.IssueRef{Owner: "test", Repo: "repo", Number: number},
+			FieldValues: map[string]FieldValue{
+				"Status": {Type: FieldTypeSingleSelect, Text: status},
+			},
+		}
+	}
+	items := []ProjectItem{issue(1, "Shipped"), issue(2, "In Review"), issue(3, "In Review"), issue(4, "In Review")}
+	config := ProjectConfig{FieldFilters: []FieldFilter{{FieldName: "Status", Values: []string{"Done"}}}}
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&logs, nil))
+	ctx := context.WithValue(context.Background(), input.LoggerContextKey{}, logger)
+
+	if refs := FilterProjectItems(ctx, items, config); len(refs) != 0 {
+		t.Fatalf("expected no matches, got %d", len(refs))
+	}
+	want := "Status present values: In Review (3), Shipped (1); you filtered for: Done"
+	if !strings.Contains(logs.String(), want) {
+		t.Errorf("expected diagnostic %q, got %q", want, logs.String())
+	}
+
+	logs.Reset()
+	config.FieldFilters[0].Values = []string{"Shipped"}
+	if refs := FilterProjectItems(ctx, items, config); len(refs) != 1 {
+		t.Fatalf("expected 1 match, got %d", len(refs))
+	}
+	if logs.Len() != 0 {
+		t.Errorf("expected no diagnostic when items match, got %q", logs.String())
+	}
+}
+
+func TestValueTally_SkipsMissingFieldsAndSplitsMultiSelect(t *testing.T) {
+	tally := newValueTally([]FieldFilter{
+		{FieldName: "Labels", Values: []string{"backend"}},
+		{FieldName: "Team", Values: []string{"Core"}},
+	})
+	tally.add(ProjectItem{FieldValues: map[string]FieldValue{
+		"Labels": {Type: FieldTypeMultiSelect, Values: []string{"frontend", "design"}},
+	}})
+	tally.add(ProjectItem{FieldValues: map[string]FieldValue{
+		"Labels": {Type: FieldTypeMultiSelect, Values: []string{"frontend"}},
+	}})
+
+	hints := tally.hints()
+	want := []string{"Labels present values: frontend (2), design (1); you filtered for: backend"}
+	if strings.Join(hints, "|") != strings.Join(want, "|") {
+		t.Errorf("got hints %q, want %q", hints, want)
+	}
+}
+
 func TestFilterProjectItems_IncludePRs(t *testing.T) {
 	items := []ProjectItem{
 		{
@@ -282,7 +525,7 @@ func TestFilterProjectItems_IncludePRs(t *testing.T) {
 		IncludePRs: true,
 	}
 
-	results := FilterProjectItems(items, config)
+	results := FilterProjectItems(context.Background(), items, config)
 
 	// Should include both issue and PR
 	if len(results) != 2 {
@@ -307,7 +550,7 @@ func TestFilterProjectItems_FiltersDraftIssues(t *testing.T) {
 		},
 	}
 
-	results := FilterProjectItems(items, config)
+	results := FilterProjectItems(context.Background(), items, config)
 
 	// Draft issues should always be filtered out
 	if len(results) != 0 {
@@ -339,7 +582,7 @@ func TestFilterProjectItems_NoFieldMatch(t *testing.T) {
 		},
 	}
 
-	results := FilterProjectItems(items, config)
+	results := FilterProjectItems(context.Background(), items, config)
 
 	// No items match the filter
 	if len(results) != 0 {
@@ -366,10 +609,29 @@ func TestFilterProjectItems_NoFilters(t *testing.T) {
 		IncludePRs:   false,
 	}
 
-	results := FilterProjectItems(items, config)
+	results := FilterProjectItems(context.Background(), items, config)
 
 	// No filters, so should include all issues (but not PRs)
 	if len(results) != 1 {
 		t.Fatalf("expected 1 result (issue only), got %d", len(results))
 	}
 }
+
+func TestMatchesFilters_MultiSelect_AnySelectedValue(t *testing.T) {
+	item := ProjectItem{
+		ContentType: ContentTypeIssue,
+		FieldValues: map[string]FieldValue{
+			"Teams": {Type: FieldTypeMultiSelect, Values: []string{"Platform", "Payments"}},
+		},
+	}
+
+	if !MatchesFilters(item, []FieldFilter{{FieldName: "Teams", Values: []string{"payments"}}}) {
+		t.Error("expected item selected in two teams to match a filter on one of them")
+	}
+	if MatchesFilters(item, []FieldFilter{{FieldName: "Teams", Values: []string{"Search"}}}) {
+		t.Error("expected no match for an unselected team")
+	}
+	if got := item.FieldValues["Teams"].String(); got != "Platform, Payments" {
+		t.Errorf("expected joined values, got %q", got)
+	}
+}
@@ -17,6 +17,11 @@ query($owner: String!, $number: Int!, $first: Int!, $cursor: String, $query: Str
     projectV2(number: $number) {
       id
       title
+      fields(first: 50) {
+        nodes {
+          ... on ProjectV2FieldCommon { name }
+        }
+      }
       items(first: $first, after: $cursor, query: $query) {
         nodes {
           id
@@ -49,6 +54,30 @@ query($owner: String!, $number: Int!, $first: Int!, $cursor: String, $query: Str
               title
             }
           }
+          fieldValues(first: 20) {
+            nodes {
+              ... on ProjectV2ItemFieldTextValue {
+                text
+                field { ... on ProjectV2FieldCommon { name } }
+              }
+              ... on ProjectV2ItemFieldSingleSelectValue {
+                name
+                field { ... on ProjectV2FieldCommon { name } }
+              }
+              ... on ProjectV2ItemFieldDateValue {
+                date
+                field { ... on ProjectV2FieldCommon { name } }
+              }
+              ... on ProjectV2ItemFieldNumberValue {
+                number
+                field { ... on ProjectV2FieldCommon { name } }
+              }
+              ... on ProjectV2ItemFieldLabelValue {
+                labels(first: 20) { nodes { name } }
+                field { ... on ProjectV2FieldCommon { name } }
+              }
+            }
+          }
         }
         pageInfo {
           hasNextPage
@@ -77,18 +106,22 @@ func buildProjectQuery(projectType ProjectType) string {
 // GraphQL query template for fetching project views
 // The %s placeholder will be replaced with either "organization" or "user"
 const projectViewsQueryTemplate = `
-query($owner: String!, $number: Int!) {
+query($owner: String!, $number: Int!, $first: Int!, $cursor: String) {
   %s(login: $owner) {
     projectV2(number: $number) {
       id
       title
-      views(first: 20) {
+      views(first: $first, after: $cursor) {
         nodes {
           id
           name
           filter
           layout
         }
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
       }
     }
   }
@@ -109,6 +142,33 @@ func buildProjectViewsQuery(projectType ProjectType) string {
 	return fmt.Sprintf(projectViewsQueryTemplate, ownerType)
 }
 
+// GraphQL query template for checking access to a project
+// The %s placeholder will be replaced with either "organization" or "user"
+const projectTitleQueryTemplate = `
+query($owner: String!, $number: Int!) {
+  %s(login: $owner) {
+    projectV2(number: $number) {
+      id
+      title
+    }
+  }
+}
+`
+
+// buildProjectTitleQuery builds a GraphQL query string for fetching only a project's title
+func buildProjectTitleQuery(projectType ProjectType) string {
+	var ownerType string
+	switch projectType {
+	case ProjectTypeOrg:
+		ownerType = ownerTypeOrganization
+	case ProjectTypeUser:
+		ownerType = ownerTypeUser
+	default:
+		ownerType = ownerTypeOrganization
+	}
+	return fmt.Sprintf(projectTitleQueryTemplate, ownerType)
+}
+
 // graphQLRequest represents a GraphQL request payload
 type graphQLRequest struct {
 	Query     string                 `json:"query"`
@@ -189,9 +249,10 @@ type pageInfo struct {
 	EndCursor   *string `json:"endCursor"`
 }
 
-// projectViews represents the views collection
+// projectViews represents the views collection with pagination
 type projectViews struct {
-	Nodes []projectViewNode `json:"nodes"`
+	Nodes    []projectViewNode `json:"nodes"`
+	PageInfo pageInfo          `json:"pageInfo"`
 }
 
 // projectViewNode represents a single project view from GraphQL response
@@ -249,6 +310,13 @@ type projectFieldValueNode struct {
 	Name   *string  `json:"name,omitempty"`   // For single-select fields
 	Date   *string  `json:"date,omitempty"`   // For date fields (ISO 8601)
 	Number *float64 `json:"number,omitempty"` // For number fields
+
+	Labels *projectFieldLabels `json:"labels,omitempty"` // For multi-value label fields
+}
+
+// projectFieldLabels represents the labels selected in a multi-value field
+type projectFieldLabels struct {
+	Nodes []projectFieldOption `json:"nodes"`
 }
 
 // projectFieldRef represents a reference to a field definition
@@ -2,6 +2,7 @@ package projects
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Attamusc/weekly-report-cli/internal/input"
@@ -80,6 +81,8 @@ const (
 	FieldTypeDate
 	// FieldTypeNumber represents a number field
 	FieldTypeNumber
+	// FieldTypeMultiSelect represents a field holding several selected values (e.g., labels)
+	FieldTypeMultiSelect
 )
 
 // String returns the string representation of FieldType
@@ -93,6 +96,8 @@ func (ft FieldType) String() string {
 		return "Date"
 	case FieldTypeNumber:
 		return "Number"
+	case FieldTypeMultiSelect:
+		return "MultiSelect"
 	default:
 		return "Unknown"
 	}
@@ -104,6 +109,7 @@ type FieldValue struct {
 	Text   string     // For text/single-select fields
 	Date   *time.Time // For date fields
 	Number float64    // For number fields
+	Values []string   // For multi-select fields
 }
 
 // String returns a string representation of the FieldValue
@@ -118,6 +124,8 @@ func (fv FieldValue) String() string {
 		return ""
 	case FieldTypeNumber:
 		return fmt.Sprintf("%f", fv.Number)
+	case FieldTypeMultiSelect:
+		return strings.Join(fv.Values, ", ")
 	default:
 		return ""
 	}
@@ -130,6 +138,24 @@ type ProjectItem struct {
 	FieldValues map[string]FieldValue // Field name -> Field value
 }
 
+// IssueRefWithFields returns a copy of the item's issue ref carrying its field
+// values as strings (see FieldValue.String), so they survive the conversion to
+// input.IssueRef for status, target date and extra columns. Returns nil for
+// items without an issue ref.
+func (item ProjectItem) IssueRefWithFields() *input.IssueRef {
+	if item.IssueRef == nil {
+		return nil
+	}
+	ref := *item.IssueRef
+	if len(item.FieldValues) > 0 {
+		ref.FieldValues = make(map[string]string, len(item.FieldValues))
+		for name, value := range item.FieldValues {
+			ref.FieldValues[name] = value.String()
+		}
+	}
+	return &ref
+}
+
 // ProjectView represents a GitHub Projects V2 view
 type ProjectView struct {
 	ID     string // Global node ID (e.g., "PVT_kwDOABCDEF")
@@ -142,6 +168,8 @@ type ProjectView struct {
 type FieldFilter struct {
 	FieldName string   // Name of the field to filter by
 	Values    []string // Values to match (OR logic within this filter)
+
+	regexes regexValues // "re:" values compiled by compileRegexFilters (nil = compiled per match)
 }
 
 // ProjectConfig holds project query configuration
@@ -152,4 +180,7 @@ type ProjectConfig struct {
 	FieldFilters []FieldFilter // Field filters to apply (AND logic between filters)
 	IncludePRs   bool          // Whether to include pull requests
 	MaxItems     int           // Maximum number of items to fetch
+	FieldAliases FieldAliases  // Alternate spellings matched alongside filter values
+	StrictFields bool          // Fail when an item has two values for one field (default: the last one wins)
+	UpdatedSince string        // Only items updated on or after this YYYY-MM-DD date, filtered server-side (empty = all)
 }
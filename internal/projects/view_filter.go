@@ -1,7 +1,9 @@
 package projects
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -55,6 +57,123 @@ func ConvertFieldFiltersToQueryString(filters []FieldFilter) string {
 	return strings.Join(parts, " ")
 }
 
+// reservedQualifiers are query qualifiers that describe item state rather than
+// a project field, so they are never treated as overridable field filters
+var reservedQualifiers = map[string]bool{
+	"is":  true,
+	"no":  true,
+	"has": true,
+}
+
+// parseViewFilter parses a view's filter, which GitHub returns as a query
+// string; a JSON object of field → values is also accepted
+func parseViewFilter(filter string) (filters []FieldFilter, terms []string) {
+	trimmed := strings.TrimSpace(filter)
+	if strings.HasPrefix(trimmed, "{") {
+		var fields map[string][]string
+		if err := json.Unmarshal([]byte(trimmed), &fields); err == nil {
+			names := make([]string, 0, len(fields))
+			for name := range fields {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				if len(fields[name]) > 0 {
+					filters = append(filters, FieldFilter{FieldName: name, Values: fields[name]})
+				}
+			}
+			return filters, nil
+		}
+	}
+
+	return parseQueryStringFilter(trimmed)
+}
+
+// parseQueryStringFilter parses a view's query string filter into field filters
+//
+// Tokens of the form field:value[,value...] become FieldFilters (quoted values
+// may contain spaces). Anything else — negations such as "-status:Done", state
+// qualifiers such as "is:open", and free text — is returned unchanged in terms
+// so it can still be passed to the server.
+//
+// Example:
+//
+//	Input: `status:"In Progress",Blocked is:open -label:wontfix`
+//	Filters: [{FieldName:"status", Values:["In Progress", "Blocked"]}]
+//	Terms: ["is:open", "-label:wontfix"]
+func parseQueryStringFilter(filter string) (filters []FieldFilter, terms []string) {
+	for _, token := range splitQueryTokens(filter) {
+		name, rawValues, ok := strings.Cut(token, ":")
+		if !ok || name == "" || rawValues == "" || strings.HasPrefix(name, "-") || reservedQualifiers[strings.ToLower(name)] {
+			terms = append(terms, token)
+			continue
+		}
+
+		var values []string
+		for _, value := range splitOutsideQuotes(rawValues, ',') {
+			value = unquoteQueryValue(value)
+			if value != "" {
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			terms = append(terms, token)
+			continue
+		}
+
+		filters = append(filters, FieldFilter{FieldName: name, Values: values})
+	}
+
+	return filters, terms
+}
+
+// splitQueryTokens splits a query string on whitespace outside double quotes
+func splitQueryTokens(query string) []string {
+	var tokens []string
+	for _, token := range splitOutsideQuotes(query, ' ') {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens
+}
+
+// splitOutsideQuotes splits s on sep, ignoring separators inside double quotes
+func splitOutsideQuotes(s string, sep rune) []string {
+	var parts []string
+	var current strings.Builder
+	inQuotes := false
+	escaped := false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == sep && !inQuotes:
+			parts = append(parts, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	parts = append(parts, current.String())
+
+	return parts
+}
+
+// unquoteQueryValue strips surrounding quotes and unescapes inner quotes
+func unquoteQueryValue(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = strings.ReplaceAll(value[1:len(value)-1], `\"`, `"`)
+	}
+	return value
+}
+
 // MergeFilters combines view-based filters with additional user filters
 //
 // Strategy: View filters act as the base, user filters are added on top
@@ -83,10 +202,11 @@ func MergeFilters(viewFilters, userFilters []FieldFilter) []FieldFilter {
 		return viewFilters
 	}
 
-	// Build a map of user filters by field name for quick lookup
+	// Build a map of user filters by field name for quick lookup; field names
+	// match case-insensitively, as they do everywhere else
 	userFilterMap := make(map[string]FieldFilter)
 	for _, filter := range userFilters {
-		userFilterMap[filter.FieldName] = filter
+		userFilterMap[strings.ToLower(filter.FieldName)] = filter
 	}
 
 	// Start with view filters, but replace with user filter if same field
@@ -94,20 +214,20 @@ func MergeFilters(viewFilters, userFilters []FieldFilter) []FieldFilter {
 	addedFields := make(map[string]bool)
 
 	for _, viewFilter := range viewFilters {
-		if userFilter, exists := userFilterMap[viewFilter.FieldName]; exists {
+		key := strings.ToLower(viewFilter.FieldName)
+		if userFilter, exists := userFilterMap[key]; exists {
 			// User specified filter for same field - use user's version
 			merged = append(merged, userFilter)
-			addedFields[viewFilter.FieldName] = true
 		} else {
 			// No user override - use view filter
 			merged = append(merged, viewFilter)
-			addedFields[viewFilter.FieldName] = true
 		}
+		addedFields[key] = true
 	}
 
 	// Add any user filters that weren't in view filters
 	for _, userFilter := range userFilters {
-		if !addedFields[userFilter.FieldName] {
+		if !addedFields[strings.ToLower(userFilter.FieldName)] {
 			merged = append(merged, userFilter)
 		}
 	}
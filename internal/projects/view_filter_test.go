@@ -167,3 +167,54 @@ func findSubstring(s, substr string) bool {
 	}
 	return false
 }
+
+// TestParseQueryStringFilter tests parsing a view's query string into filters and pass-through terms
+func TestParseQueryStringFilter(t *testing.T) {
+	filters, terms := parseQueryStringFilter(`status:"In Progress",Blocked is:open -label:wontfix priority:High urgent`)
+
+	if len(filters) != 2 {
+		t.Fatalf("expected 2 filters, got %d: %+v", len(filters), filters)
+	}
+	if filters[0].FieldName != "status" || len(filters[0].Values) != 2 ||
+		filters[0].Values[0] != "In Progress" || filters[0].Values[1] != "Blocked" {
+		t.Errorf("unexpected status filter: %+v", filters[0])
+	}
+	if filters[1].FieldName != "priority" || len(filters[1].Values) != 1 || filters[1].Values[0] != "High" {
+		t.Errorf("unexpected priority filter: %+v", filters[1])
+	}
+
+	expectedTerms := []string{"is:open", "-label:wontfix", "urgent"}
+	if strings.Join(terms, " ") != strings.Join(expectedTerms, " ") {
+		t.Errorf("expected terms %v, got %v", expectedTerms, terms)
+	}
+}
+
+// TestParseViewFilter_JSON tests the JSON filter form
+func TestParseViewFilter_JSON(t *testing.T) {
+	filters, terms := parseViewFilter(`{"Status":["Blocked"],"Iteration":["Sprint 12"]}`)
+
+	if len(terms) != 0 {
+		t.Errorf("expected no terms, got %v", terms)
+	}
+	if got := ConvertFieldFiltersToQueryString(filters); got != `Iteration:"Sprint 12" Status:Blocked` {
+		t.Errorf("unexpected filters: %q", got)
+	}
+}
+
+// TestMergeFilters_CaseInsensitiveFieldNames tests that a user filter overrides
+// a view filter whose field name differs only in case
+func TestMergeFilters_CaseInsensitiveFieldNames(t *testing.T) {
+	viewFilters := []FieldFilter{
+		{FieldName: "Status", Values: []string{"Blocked"}},
+		{FieldName: "Priority", Values: []string{"P1"}},
+	}
+	userFilters := []FieldFilter{
+		{FieldName: "status", Values: []string{"In Progress"}},
+	}
+
+	merged := MergeFilters(viewFilters, userFilters)
+
+	if got := ConvertFieldFiltersToQueryString(merged); got != `status:"In Progress" Priority:P1` {
+		t.Errorf("expected user status filter to replace the view's, got %q", got)
+	}
+}
@@ -1,6 +1,7 @@
 package report
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"time"
@@ -9,8 +10,100 @@ import (
 )
 
 // MarkerIsReport is the HTML comment marker that identifies a status report
+// under DefaultKeyNames
 const MarkerIsReport = `<!-- data key="isReport" value="true" -->`
 
+// KeyNames are the data keys that mark a comment as a report and carry its
+// fields, so teams with an established comment format can keep their own names
+type KeyNames struct {
+	Marker     string // Inline key whose value "true" marks a report
+	Trending   string
+	TargetDate string
+	Update     string
+
+	marker *regexp.Regexp // Compiled marker regex, set by Compile
+}
+
+// DefaultKeyNames are the key names reports use unless a caller passes its own
+var DefaultKeyNames = KeyNames{Marker: "isReport", Trending: "trending", TargetDate: "target_date", Update: "update"}
+
+// withDefaults fills empty names from DefaultKeyNames
+func (k KeyNames) withDefaults() KeyNames {
+	if k.Marker == "" {
+		k.Marker = DefaultKeyNames.Marker
+	}
+	if k.Trending == "" {
+		k.Trending = DefaultKeyNames.Trending
+	}
+	if k.TargetDate == "" {
+		k.TargetDate = DefaultKeyNames.TargetDate
+	}
+	if k.Update == "" {
+		k.Update = DefaultKeyNames.Update
+	}
+	return k
+}
+
+// Validate checks that every name can appear in a data marker and that no two
+// names collide once defaults are filled in (names match case-insensitively)
+func (k KeyNames) Validate() error {
+	k = k.withDefaults()
+	seen := make(map[string]string)
+	for _, entry := range []struct{ role, name string }{
+		{"report marker", k.Marker},
+		{"trending", k.Trending},
+		{"target date", k.TargetDate},
+		{"update", k.Update},
+	} {
+		if strings.ContainsAny(entry.name, "\" \t\n") {
+			return fmt.Errorf("%s key %q must not contain quotes or whitespace", entry.role, entry.name)
+		}
+		if other, ok := seen[strings.ToLower(entry.name)]; ok {
+			return fmt.Errorf("%s key %q is already used as the %s key", entry.role, entry.name, other)
+		}
+		seen[strings.ToLower(entry.name)] = entry.role
+	}
+	return nil
+}
+
+// markerText returns the report marker for k
+func (k KeyNames) markerText() string {
+	return fmt.Sprintf(`<!-- data key="%s" value="true" -->`, k.Marker)
+}
+
+// Compile returns k with empty names filled from DefaultKeyNames and its
+// marker regex compiled, so parsing many comments with the result doesn't
+// recompile it. Compiled names are returned unchanged.
+func (k KeyNames) Compile() KeyNames {
+	if k.marker != nil {
+		return k
+	}
+	k = k.withDefaults()
+	if strings.EqualFold(k.Marker, DefaultKeyNames.Marker) {
+		k.marker = defaultMarkerRegex
+	} else {
+		k.marker = markerRegex(k.Marker)
+	}
+	return k
+}
+
+// markerRegex matches the report marker for key, case-insensitively
+func markerRegex(key string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)<!--\s*data\s+key\s*=\s*"` + regexp.QuoteMeta(key) + `"\s+value\s*=\s*"true"\s*-->`)
+}
+
+// KeySummaryHint is the optional data key carrying per-issue summarization guidance
+const KeySummaryHint = "summary_hint"
+
+// KeyStatusOverride is the optional data key whose status, when recognized, replaces the trending value
+const KeyStatusOverride = "status_override"
+
+// KeyVerbatim is the optional data key that, when "true", publishes the update as written
+const KeyVerbatim = "verbatim"
+
+// KeyExclude is the optional data key that, when "true", leaves the issue out of the report
+const KeyExclude = "exclude"
+
 // Report represents a structured status report extracted from a comment
 type Report struct {
 	TrendingRaw string    // Raw trending/status value
@@ -18,24 +111,42 @@ type Report struct {
 	UpdateRaw   string    // Raw update text (may be multiline)
 	CreatedAt   time.Time // When the comment was created
 	SourceURL   string    // URL of the source comment
+
+	// Extras holds any additional data keys found in the report (lowercased key -> value).
+	// Both block form (<!-- data key="x" start -->...<!-- data end -->) and inline
+	// form (<!-- data key="x" value="..." -->) are captured.
+	Extras map[string]string
 }
 
 var (
-	// Case-insensitive regex for the report marker
-	reportMarkerRegex = regexp.MustCompile(`(?i)<!--\s*data\s+key\s*=\s*"isReport"\s+value\s*=\s*"true"\s*-->`)
+	// Case-insensitive regex for the report marker under DefaultKeyNames
+	defaultMarkerRegex = markerRegex(DefaultKeyNames.Marker)
 
 	// Regex for extracting keyed data blocks
 	// Matches: <!-- data key="<key>" start --> content <!-- data end -->
 	// (?s) enables dotall mode so . matches newlines
 	dataBlockRegex = regexp.MustCompile(`(?is)<!--\s*data\s+key\s*=\s*"([^"]+)"\s+start\s*-->(.*?)<!--\s*data\s+end\s*-->`)
+
+	// Regex for extracting inline keyed values
+	// Matches: <!-- data key="<key>" value="<value>" -->
+	dataValueRegex = regexp.MustCompile(`(?i)<!--\s*data\s+key\s*=\s*"([^"]+)"\s+value\s*=\s*"([^"]*)"\s*-->`)
 )
 
 // ParseReport extracts a structured report from comment body text
 // Returns (Report, true) if the comment contains a valid report marker and at least one data key
 // Returns (Report{}, false) if the comment is not a report or contains no valid data
 func ParseReport(body string, createdAt time.Time, sourceURL string) (Report, bool) {
+	return ParseReportWithKeys(body, createdAt, sourceURL, DefaultKeyNames)
+}
+
+// ParseReportWithKeys extracts a report like ParseReport, recognizing the
+// marker and fields by keys (empty names keep their defaults). Pass compiled
+// keys (see KeyNames.Compile) when parsing many comments.
+func ParseReportWithKeys(body string, createdAt time.Time, sourceURL string, keys KeyNames) (Report, bool) {
+	keys = keys.Compile()
+
 	// Check for report marker (case-insensitive)
-	if !reportMarkerRegex.MatchString(body) {
+	if !keys.marker.MatchString(body) {
 		return Report{}, false
 	}
 
@@ -68,16 +179,29 @@ func ParseReport(body string, createdAt time.Time, sourceURL string) (Report, bo
 		}
 
 		// Map keys to report fields
-		switch strings.ToLower(key) {
-		case "trending":
+		switch {
+		case strings.EqualFold(key, keys.Trending):
 			report.TrendingRaw = value
 			hasValidData = true
-		case "target_date":
+		case strings.EqualFold(key, keys.TargetDate):
 			report.TargetDate = value
 			hasValidData = true
-		case "update":
+		case strings.EqualFold(key, keys.Update):
 			report.UpdateRaw = value
 			hasValidData = true
+		default:
+			report.setExtra(key, value)
+		}
+	}
+
+	// Capture inline key/value markers (other than the report marker itself)
+	for _, match := range dataValueRegex.FindAllStringSubmatch(body, -1) {
+		key := strings.TrimSpace(match[1])
+		if strings.EqualFold(key, keys.Marker) {
+			continue
+		}
+		if value := strings.TrimSpace(match[2]); value != "" {
+			report.setExtra(key, value)
 		}
 	}
 
@@ -89,6 +213,20 @@ func ParseReport(body string, createdAt time.Time, sourceURL string) (Report, bo
 	return report, true
 }
 
+// setExtra records an additional data key on the report, keyed by lowercased name.
+func (r *Report) setExtra(key, value string) {
+	if r.Extras == nil {
+		r.Extras = make(map[string]string)
+	}
+	r.Extras[strings.ToLower(key)] = value
+}
+
+// Extra returns the value of an additional data key (case-insensitive),
+// or "" if the key was not present.
+func (r Report) Extra(key string) string {
+	return r.Extras[strings.ToLower(key)]
+}
+
 var (
 	// Matches a markdown heading containing "trending" (any level h1-h6)
 	semiTrendingHeadingRegex = regexp.MustCompile(`(?im)^#{1,6}\s+trending\s*$`)
@@ -145,8 +283,14 @@ func firstNonEmptyLine(s string) string {
 // a semantic dependency. Changes to statusMappings in derive will change what
 // the semi-structured parser accepts. This is desirable (they should stay in sync).
 func ParseSemiStructured(body string, createdAt time.Time, sourceURL string) (Report, bool) {
+	return ParseSemiStructuredWithKeys(body, createdAt, sourceURL, DefaultKeyNames)
+}
+
+// ParseSemiStructuredWithKeys parses like ParseSemiStructured, rejecting
+// comments that carry the report marker named by keys
+func ParseSemiStructuredWithKeys(body string, createdAt time.Time, sourceURL string, keys KeyNames) (Report, bool) {
 	// Reject if body contains structured report markers -- those belong to ParseReport()
-	if reportMarkerRegex.MatchString(body) {
+	if keys.Compile().marker.MatchString(body) {
 		return Report{}, false
 	}
 
@@ -500,3 +500,122 @@ Some text in between.
 		t.Errorf("expected update 'Latest progress update', got '%s'", report.UpdateRaw)
 	}
 }
+
+func TestParseReport_Extras(t *testing.T) {
+	body := `<!-- data key="isReport" value="true" -->
+<!-- data key="verbatim" value="true" -->
+<!-- data key="trending" start -->🟢 on track<!-- data end -->
+<!-- data key="update" start -->Shipped the beta<!-- data end -->
+<!-- data key="Summary_Hint" start -->Emphasize the customer impact<!-- data end -->
+<!-- data key="empty" start --> <!-- data end -->`
+
+	report, ok := ParseReport(body, time.Now(), "https://github.com/owner/repo/issues/1#issuecomment-1")
+	if !ok {
+		t.Fatal("expected successful report parsing")
+	}
+
+	if got := report.Extra(KeySummaryHint); got != "Emphasize the customer impact" {
+		t.Errorf("expected summary_hint extra, got %q", got)
+	}
+	if got := report.Extra("verbatim"); got != "true" {
+		t.Errorf("expected inline verbatim extra, got %q", got)
+	}
+	if _, ok := report.Extras["isreport"]; ok {
+		t.Error("report marker should not be captured as an extra")
+	}
+	if _, ok := report.Extras["empty"]; ok {
+		t.Error("empty values should not be captured as extras")
+	}
+	if _, ok := report.Extras["update"]; ok {
+		t.Error("core keys should not be duplicated into extras")
+	}
+}
+
+func TestParseReport_NoExtras(t *testing.T) {
+	body := `<!-- data key="isReport" value="true" -->
+<!-- data key="update" start -->Plain update<!-- data end -->`
+
+	report, ok := ParseReport(body, time.Now(), "")
+	if !ok {
+		t.Fatal("expected successful report parsing")
+	}
+	if report.Extras != nil {
+		t.Errorf("expected nil extras, got %v", report.Extras)
+	}
+	if report.Extra(KeySummaryHint) != "" {
+		t.Error("expected empty value for missing key")
+	}
+}
+
+func TestParseReport_CustomKeyNames(t *testing.T) {
+	keys := KeyNames{Marker: "weeklyStatus", Trending: "health", TargetDate: "eta", Update: "notes"}
+
+	body := `<!-- data key="weeklyStatus" value="true" -->
+<!-- data key="health" start -->🟡 at risk<!-- data end -->
+<!-- data key="ETA" start -->2025-09-30<!-- data end -->
+<!-- data key="notes" start -->Waiting on the vendor<!-- data end -->
+<!-- data key="update" start -->Not a core key under these names<!-- data end -->`
+
+	report, ok := ParseReportWithKeys(body, time.Now(), "", keys)
+	if !ok {
+		t.Fatal("expected a report with custom key names")
+	}
+	if report.TrendingRaw != "🟡 at risk" || report.TargetDate != "2025-09-30" || report.UpdateRaw != "Waiting on the vendor" {
+		t.Errorf("unexpected fields: %+v", report)
+	}
+	if report.Extra("update") != "Not a core key under these names" {
+		t.Errorf("expected the default update key to become an extra, got %v", report.Extras)
+	}
+	if _, ok := report.Extras["weeklystatus"]; ok {
+		t.Error("custom report marker should not be captured as an extra")
+	}
+
+	// The default marker no longer identifies a report
+	if _, ok := ParseReportWithKeys(`<!-- data key="isReport" value="true" -->
+<!-- data key="notes" start -->Update<!-- data end -->`, time.Now(), "", keys); ok {
+		t.Error("expected the default marker to be ignored under custom key names")
+	}
+
+	// Other callers keep the default names
+	if _, ok := ParseReport(body, time.Now(), ""); ok {
+		t.Error("expected ParseReport to keep the default key names")
+	}
+
+	// Compiled keys parse the same and keep their compiled marker
+	compiled := keys.Compile()
+	if compiled.marker == nil || compiled.Compile().marker != compiled.marker {
+		t.Error("expected Compile to compile the marker once")
+	}
+	if _, ok := ParseReportWithKeys(body, time.Now(), "", compiled); !ok {
+		t.Error("expected a report with compiled custom key names")
+	}
+}
+
+func TestKeyNames_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   KeyNames
+		wantErr string
+	}{
+		{name: "defaults", names: KeyNames{}},
+		{name: "custom", names: KeyNames{Marker: "weeklyStatus", Trending: "health", TargetDate: "eta", Update: "notes"}},
+		{name: "quote", names: KeyNames{Trending: `he"alth`}, wantErr: "must not contain quotes"},
+		{name: "whitespace", names: KeyNames{Update: "my notes"}, wantErr: "must not contain quotes or whitespace"},
+		{name: "collides with default", names: KeyNames{Trending: "Update"}, wantErr: "already used as the trending key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.names.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("got error %v, want it to mention %q", err, tt.wantErr)
+			}
+		})
+	}
+}
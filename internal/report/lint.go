@@ -0,0 +1,116 @@
+package report
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// LintResult describes how a comment body fares against ParseReport
+type LintResult struct {
+	HasMarker bool     // The report marker (isReport by default) is present
+	Parsed    bool     // ParseReport accepts the body as a report
+	Keys      []string // Data keys found in well-formed markers, in order of appearance
+	Problems  []string // Malformed or missing markers, in order of appearance
+}
+
+var (
+	// Matches any HTML comment starting with "data", well-formed or not
+	dataMarkerRegex = regexp.MustCompile(`(?is)<!--\s*data\b(.*?)-->`)
+
+	// Inner text of the marker forms ParseReport understands
+	markerStartRegex = regexp.MustCompile(`(?is)^\s+key\s*=\s*"([^"]+)"\s+start\s*$`)
+	markerEndRegex   = regexp.MustCompile(`(?is)^\s+end\s*$`)
+	markerValueRegex = regexp.MustCompile(`(?is)^\s+key\s*=\s*"([^"]+)"\s+value\s*=\s*"([^"]*)"\s*$`)
+)
+
+// LintReport checks body's data markers and explains why ParseReport would
+// reject it or lose data: a missing or wrong isReport marker, blocks without a
+// matching "data end", stray end markers, empty values, and markers the parser
+// doesn't recognize.
+func LintReport(body string) LintResult {
+	return LintReportWithKeys(body, DefaultKeyNames)
+}
+
+// LintReportWithKeys lints body like LintReport against the marker and field
+// names in keys (empty names keep their defaults)
+func LintReportWithKeys(body string, keys KeyNames) LintResult {
+	keys = keys.Compile()
+	var result LintResult
+	_, result.Parsed = ParseReportWithKeys(body, time.Time{}, "", keys)
+
+	openKey := ""
+	openEnd := 0
+	hasField := false
+
+	for _, loc := range dataMarkerRegex.FindAllStringSubmatchIndex(body, -1) {
+		marker := body[loc[0]:loc[1]]
+		inner := body[loc[2]:loc[3]]
+
+		switch {
+		case markerStartRegex.MatchString(inner):
+			key := strings.TrimSpace(markerStartRegex.FindStringSubmatch(inner)[1])
+			if openKey != "" {
+				result.Problems = append(result.Problems,
+					fmt.Sprintf("block %q has no <!-- data end --> before block %q starts", openKey, key))
+			}
+			openKey = key
+			openEnd = loc[1]
+
+		case markerEndRegex.MatchString(inner):
+			if openKey == "" {
+				result.Problems = append(result.Problems, "<!-- data end --> without a matching start marker")
+				continue
+			}
+			result.Keys = append(result.Keys, openKey)
+			if strings.TrimSpace(body[openEnd:loc[0]]) == "" {
+				result.Problems = append(result.Problems, fmt.Sprintf("block %q is empty", openKey))
+			} else if keys.isReportField(openKey) {
+				hasField = true
+			}
+			openKey = ""
+
+		case markerValueRegex.MatchString(inner):
+			match := markerValueRegex.FindStringSubmatch(inner)
+			key, value := strings.TrimSpace(match[1]), strings.TrimSpace(match[2])
+			if strings.EqualFold(key, keys.Marker) {
+				if strings.EqualFold(value, "true") {
+					result.HasMarker = true
+				} else {
+					result.Problems = append(result.Problems,
+						fmt.Sprintf("%s marker has value %q, want \"true\"", keys.Marker, value))
+				}
+				continue
+			}
+			result.Keys = append(result.Keys, key)
+			if value == "" {
+				result.Problems = append(result.Problems, fmt.Sprintf("inline key %q has an empty value", key))
+			}
+
+		default:
+			result.Problems = append(result.Problems, fmt.Sprintf("unrecognized data marker %s", marker))
+		}
+	}
+
+	if openKey != "" {
+		result.Problems = append(result.Problems, fmt.Sprintf("block %q is missing <!-- data end -->", openKey))
+	}
+	if !result.HasMarker {
+		result.Problems = append(result.Problems, "missing report marker "+keys.markerText())
+	}
+	if !hasField {
+		result.Problems = append(result.Problems, fmt.Sprintf("no %s, %s or %s block with a value",
+			keys.Trending, keys.TargetDate, keys.Update))
+	}
+
+	return result
+}
+
+// isReportField reports whether key is one of the block keys that makes a
+// comment a report
+func (k KeyNames) isReportField(key string) bool {
+	return strings.EqualFold(key, k.Trending) ||
+		strings.EqualFold(key, k.TargetDate) ||
+		strings.EqualFold(key, k.Update)
+}
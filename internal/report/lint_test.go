@@ -0,0 +1,133 @@
+package report
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintReport_WellFormed(t *testing.T) {
+	body := `<!-- data key="isReport" value="true" -->
+<!-- data key="trending" start -->🟢 on track<!-- data end -->
+<!-- data key="target_date" start -->2025-08-15<!-- data end -->
+<!-- data key="update" start -->
+Shipped the API.
+<!-- data end -->
+<!-- data key="verbatim" value="true" -->`
+
+	result := LintReport(body)
+	if !result.Parsed || !result.HasMarker {
+		t.Errorf("expected parsed report with marker, got %+v", result)
+	}
+	if len(result.Problems) != 0 {
+		t.Errorf("expected no problems, got %v", result.Problems)
+	}
+	want := []string{"trending", "target_date", "update", "verbatim"}
+	if strings.Join(result.Keys, ",") != strings.Join(want, ",") {
+		t.Errorf("got Keys=%v, want %v", result.Keys, want)
+	}
+}
+
+// The malformed cases mirror TestParseReport_InvalidCases
+func TestLintReport_Malformed(t *testing.T) {
+	testCases := []struct {
+		name         string
+		body         string
+		wantProblems []string
+	}{
+		{
+			name:         "no report marker",
+			body:         `<!-- data key="trending" start -->green<!-- data end -->`,
+			wantProblems: []string{"missing report marker"},
+		},
+		{
+			name: "wrong marker value",
+			body: `<!-- data key="isReport" value="false" -->
+<!-- data key="trending" start -->green<!-- data end -->`,
+			wantProblems: []string{`isReport marker has value "false"`, "missing report marker"},
+		},
+		{
+			name: "marker but no data blocks",
+			body: `<!-- data key="isReport" value="true" -->
+Some text without data blocks.`,
+			wantProblems: []string{"no trending, target_date or update block"},
+		},
+		{
+			name: "marker but empty data blocks",
+			body: `<!-- data key="isReport" value="true" -->
+<!-- data key="trending" start --><!-- data end -->
+<!-- data key="update" start -->   <!-- data end -->`,
+			wantProblems: []string{`block "trending" is empty`, `block "update" is empty`, "no trending, target_date or update block"},
+		},
+		{
+			name: "malformed data blocks",
+			body: `<!-- data key="isReport" value="true" -->
+<!-- data key="trending" start -->green
+<!-- missing end tag -->`,
+			wantProblems: []string{`block "trending" is missing <!-- data end -->`, "no trending, target_date or update block"},
+		},
+		{
+			name: "block started before the previous one ended",
+			body: `<!-- data key="isReport" value="true" -->
+<!-- data key="trending" start -->green
+<!-- data key="update" start -->Progress<!-- data end -->`,
+			wantProblems: []string{`block "trending" has no <!-- data end --> before block "update" starts`},
+		},
+		{
+			name: "stray end marker",
+			body: `<!-- data key="isReport" value="true" -->
+<!-- data key="update" start -->Progress<!-- data end --><!-- data end -->`,
+			wantProblems: []string{"<!-- data end --> without a matching start marker"},
+		},
+		{
+			name: "unquoted key",
+			body: `<!-- data key="isReport" value="true" -->
+<!-- data key=trending start -->green<!-- data end -->
+<!-- data key="update" start -->Progress<!-- data end -->`,
+			wantProblems: []string{"unrecognized data marker <!-- data key=trending start -->", "<!-- data end --> without a matching start marker"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := LintReport(tc.body)
+			if len(result.Problems) != len(tc.wantProblems) {
+				t.Fatalf("got problems %q, want %d matching %q", result.Problems, len(tc.wantProblems), tc.wantProblems)
+			}
+			for i, want := range tc.wantProblems {
+				if !strings.Contains(result.Problems[i], want) {
+					t.Errorf("problem %d = %q, want it to contain %q", i, result.Problems[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestLintReport_ParsedMatchesParseReport(t *testing.T) {
+	partial := `<!-- data key="isReport" value="true" -->
+<!-- data key="update" start -->Progress<!-- data end -->`
+	if result := LintReport(partial); !result.Parsed {
+		t.Errorf("expected report with only an update block to parse, got %+v", result)
+	}
+
+	missingEnd := `<!-- data key="isReport" value="true" -->
+<!-- data key="update" start -->Progress`
+	if result := LintReport(missingEnd); result.Parsed {
+		t.Errorf("expected report missing data end not to parse, got %+v", result)
+	}
+}
+
+func TestLintReport_CustomKeyNames(t *testing.T) {
+	keys := KeyNames{Marker: "weeklyStatus", Update: "notes"}
+
+	result := LintReportWithKeys(`<!-- data key="weeklyStatus" value="true" -->
+<!-- data key="notes" start -->Shipped<!-- data end -->`, keys)
+	if !result.Parsed || !result.HasMarker || len(result.Problems) != 0 {
+		t.Errorf("expected a clean report under custom key names, got %+v", result)
+	}
+
+	result = LintReportWithKeys(`<!-- data key="notes" start -->Shipped<!-- data end -->`, keys)
+	want := `missing report marker <!-- data key="weeklyStatus" value="true" -->`
+	if len(result.Problems) != 1 || result.Problems[0] != want {
+		t.Errorf("got problems %q, want [%q]", result.Problems, want)
+	}
+}
@@ -9,8 +9,15 @@ import (
 )
 
 // SelectReports extracts and filters reports from comments within a time window
-// Returns ALL valid reports within the specified time window, sorted newest-first
-func SelectReports(comments []github.Comment, since time.Time) []Report {
+// Returns ALL valid reports within the specified time window, sorted newest-first.
+// When authors is non-empty, only comments by those logins are considered.
+func SelectReports(comments []github.Comment, since time.Time, authors []string) []Report {
+	return SelectReportsWithKeys(comments, since, authors, DefaultKeyNames)
+}
+
+// SelectReportsWithKeys selects reports like SelectReports, parsing them with keys
+func SelectReportsWithKeys(comments []github.Comment, since time.Time, authors []string, keys KeyNames) []Report {
+	keys = keys.Compile()
 	var reports []Report
 
 	// Extract reports from each comment
@@ -20,8 +27,13 @@ func SelectReports(comments []github.Comment, since time.Time) []Report {
 			continue
 		}
 
+		// Skip reports from authors outside the allowlist
+		if !authorAllowed(comment.Author, authors) {
+			continue
+		}
+
 		// Try to parse a report from this comment
-		if report, ok := ParseReport(comment.Body, comment.CreatedAt, comment.URL); ok {
+		if report, ok := ParseReportWithKeys(comment.Body, comment.CreatedAt, comment.URL, keys); ok {
 			reports = append(reports, report)
 		}
 	}
@@ -34,10 +46,46 @@ func SelectReports(comments []github.Comment, since time.Time) []Report {
 	return reports
 }
 
+// authorAllowed reports whether login is in authors (case-insensitive);
+// an empty list allows every author
+func authorAllowed(login string, authors []string) bool {
+	if len(authors) == 0 {
+		return true
+	}
+	for _, author := range authors {
+		if strings.EqualFold(login, author) {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterByAuthor keeps the comments written by one of authors
+// (case-insensitive); an empty list keeps every comment
+func FilterByAuthor(comments []github.Comment, authors []string) []github.Comment {
+	if len(authors) == 0 {
+		return comments
+	}
+	kept := comments[:0:0]
+	for _, comment := range comments {
+		if authorAllowed(comment.Author, authors) {
+			kept = append(kept, comment)
+		}
+	}
+	return kept
+}
+
 // SelectSemiStructuredReports extracts reports from comments that use markdown
 // heading format but lack HTML markers. Only considers comments within the time
 // window. Returns reports sorted newest-first.
 func SelectSemiStructuredReports(comments []github.Comment, since time.Time) []Report {
+	return SelectSemiStructuredReportsWithKeys(comments, since, DefaultKeyNames)
+}
+
+// SelectSemiStructuredReportsWithKeys selects like SelectSemiStructuredReports,
+// skipping comments that carry the report marker named by keys
+func SelectSemiStructuredReportsWithKeys(comments []github.Comment, since time.Time, keys KeyNames) []Report {
+	keys = keys.Compile()
 	var reports []Report
 
 	for _, comment := range comments {
@@ -46,7 +94,7 @@ func SelectSemiStructuredReports(comments []github.Comment, since time.Time) []R
 			continue
 		}
 
-		if report, ok := ParseSemiStructured(comment.Body, comment.CreatedAt, comment.URL); ok {
+		if report, ok := ParseSemiStructuredWithKeys(comment.Body, comment.CreatedAt, comment.URL, keys); ok {
 			reports = append(reports, report)
 		}
 	}
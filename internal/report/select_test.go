@@ -1,6 +1,7 @@
 package report
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -39,7 +40,7 @@ func TestSelectReports_MultipleReports(t *testing.T) {
 		},
 	}
 
-	reports := SelectReports(comments, sinceTime)
+	reports := SelectReports(comments, sinceTime, nil)
 
 	// Should return all 3 reports
 	if len(reports) != 3 {
@@ -88,7 +89,7 @@ func TestSelectReports_TimeWindowFiltering(t *testing.T) {
 		},
 	}
 
-	reports := SelectReports(comments, sinceTime)
+	reports := SelectReports(comments, sinceTime, nil)
 
 	// Should include comments at or after since time
 	if len(reports) != 2 {
@@ -120,7 +121,7 @@ func TestSelectReports_NoReports(t *testing.T) {
 	sinceTime := time.Now()
 
 	// Test with no comments
-	reports := SelectReports([]github.Comment{}, sinceTime)
+	reports := SelectReports([]github.Comment{}, sinceTime, nil)
 	if len(reports) != 0 {
 		t.Errorf("expected 0 reports for empty input, got %d", len(reports))
 	}
@@ -140,7 +141,7 @@ func TestSelectReports_NoReports(t *testing.T) {
 		},
 	}
 
-	reports = SelectReports(comments, sinceTime)
+	reports = SelectReports(comments, sinceTime, nil)
 	if len(reports) != 0 {
 		t.Errorf("expected 0 reports for comments without valid reports, got %d", len(reports))
 	}
@@ -161,7 +162,7 @@ func TestSelectReports_OneReport(t *testing.T) {
 		},
 	}
 
-	reports := SelectReports(comments, sinceTime)
+	reports := SelectReports(comments, sinceTime, nil)
 
 	if len(reports) != 1 {
 		t.Fatalf("expected 1 report, got %d", len(reports))
@@ -211,7 +212,7 @@ func TestSelectReports_MixedValidAndInvalid(t *testing.T) {
 		},
 	}
 
-	reports := SelectReports(comments, sinceTime)
+	reports := SelectReports(comments, sinceTime, nil)
 
 	// Should only extract the 2 valid reports
 	if len(reports) != 2 {
@@ -229,6 +230,71 @@ func TestSelectReports_MixedValidAndInvalid(t *testing.T) {
 
 // ========== SelectSemiStructuredReports Tests ==========
 
+func TestSelectReports_AuthorAllowlist(t *testing.T) {
+	sinceTime := time.Now().Add(-1 * time.Hour)
+
+	body := `<!-- data key="isReport" value="true" -->
+<!-- data key="trending" start -->🟢 on track<!-- data end -->
+<!-- data key="update" start -->%s<!-- data end -->`
+
+	comments := []github.Comment{
+		{
+			Body:      fmt.Sprintf(body, "Owner update"),
+			CreatedAt: sinceTime.Add(10 * time.Minute),
+			Author:    "epic-owner",
+			URL:       "owner-url",
+		},
+		{
+			Body:      fmt.Sprintf(body, "Drive-by update"),
+			CreatedAt: sinceTime.Add(20 * time.Minute),
+			Author:    "someone-else",
+			URL:       "other-url",
+		},
+		{
+			Body:      fmt.Sprintf(body, "Bot update"),
+			CreatedAt: sinceTime.Add(30 * time.Minute),
+			Author:    "status-bot[bot]",
+			URL:       "bot-url",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		authors  []string
+		expected []string
+	}{
+		{
+			name:     "empty allowlist keeps every author",
+			authors:  nil,
+			expected: []string{"bot-url", "other-url", "owner-url"},
+		},
+		{
+			name:     "non-listed author is filtered out",
+			authors:  []string{"epic-owner", "status-bot[bot]"},
+			expected: []string{"bot-url", "owner-url"},
+		},
+		{
+			name:     "logins match case-insensitively",
+			authors:  []string{"Epic-Owner"},
+			expected: []string{"owner-url"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reports := SelectReports(comments, sinceTime, tt.authors)
+			if len(reports) != len(tt.expected) {
+				t.Fatalf("expected %d reports, got %d", len(tt.expected), len(reports))
+			}
+			for i, url := range tt.expected {
+				if reports[i].SourceURL != url {
+					t.Errorf("report %d: expected SourceURL %q, got %q", i, url, reports[i].SourceURL)
+				}
+			}
+		})
+	}
+}
+
 func TestSelectSemiStructuredReports_MixedComments(t *testing.T) {
 	baseTime := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
 	sinceTime := baseTime.Add(24 * time.Hour) // 2025-03-02
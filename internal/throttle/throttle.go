@@ -0,0 +1,160 @@
+package throttle
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+)
+
+const (
+	// rateLimitStreak is how many rate-limited responses without a success in
+	// between halve the limit
+	rateLimitStreak = 2
+	// restoreAfter is how many consecutive successes give back one permit
+	restoreAfter = 10
+)
+
+// Throttle bounds in-flight work to an adaptive limit between 1 and the
+// configured maximum. Repeated rate-limited responses halve the limit and
+// sustained successes restore it one permit at a time. Workers hold a permit
+// via Acquire/Release; Transport reports each response's outcome.
+type Throttle struct {
+	mu        sync.Mutex
+	max       int
+	limit     int
+	inFlight  int
+	limited   int           // Rate-limited responses since the last success
+	successes int           // Successes since the limit last changed
+	changed   chan struct{} // Closed and replaced whenever a permit may have freed up
+	logger    *slog.Logger
+}
+
+// New returns a throttle allowing up to maxPermits concurrent permits (at least 1)
+func New(maxPermits int, logger *slog.Logger) *Throttle {
+	if maxPermits < 1 {
+		maxPermits = 1
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &Throttle{max: maxPermits, limit: maxPermits, changed: make(chan struct{}), logger: logger}
+}
+
+// Acquire blocks until a permit is free under the current limit or ctx is done
+func (t *Throttle) Acquire(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+		if t.inFlight < t.limit {
+			t.inFlight++
+			t.mu.Unlock()
+			return nil
+		}
+		wait := t.changed
+		t.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Release returns a permit taken by Acquire
+func (t *Throttle) Release() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inFlight--
+	t.notify()
+}
+
+// Limit returns the current number of permits
+func (t *Throttle) Limit() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.limit
+}
+
+// RateLimited records a rate-limited response; every rateLimitStreak of them
+// without a success in between halves the limit
+func (t *Throttle) RateLimited() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.successes = 0
+	t.limited++
+	if t.limited < rateLimitStreak || t.limit == 1 {
+		return
+	}
+	t.limited = 0
+	t.limit = max(1, t.limit/2)
+	t.logger.Warn("Rate limited, reducing concurrency", "concurrency", t.limit)
+}
+
+// Succeeded records a successful response; every restoreAfter of them in a
+// row gives back one permit, up to the maximum
+func (t *Throttle) Succeeded() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limited = 0
+	if t.limit == t.max {
+		return
+	}
+	t.successes++
+	if t.successes < restoreAfter {
+		return
+	}
+	t.successes = 0
+	t.limit++
+	t.logger.Debug("Restoring concurrency", "concurrency", t.limit)
+	t.notify()
+}
+
+// notify wakes every goroutine waiting in Acquire; t.mu must be held
+func (t *Throttle) notify() {
+	close(t.changed)
+	t.changed = make(chan struct{})
+}
+
+// Transport wraps base (http.DefaultTransport when nil) so every response
+// feeds the throttle: 429s and rate-limit 403s count against it and other
+// non-error responses count toward restoring it
+func (t *Throttle) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &observingTransport{base: base, throttle: t}
+}
+
+// observingTransport reports response outcomes to a Throttle
+type observingTransport struct {
+	base     http.RoundTripper
+	throttle *Throttle
+}
+
+// RoundTrip implements http.RoundTripper
+func (o *observingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := o.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+	switch {
+	case IsRateLimited(resp):
+		o.throttle.RateLimited()
+	case resp.StatusCode < http.StatusBadRequest:
+		o.throttle.Succeeded()
+	}
+	return resp, nil
+}
+
+// IsRateLimited reports whether resp is a primary or secondary rate limit:
+// a 429, or a 403 with no remaining quota or a Retry-After header
+func IsRateLimited(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusForbidden:
+		return resp.Header.Get("X-RateLimit-Remaining") == "0" || resp.Header.Get("Retry-After") != ""
+	}
+	return false
+}
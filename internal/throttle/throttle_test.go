@@ -0,0 +1,141 @@
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// rateLimitServer answers the first limited requests with status and
+// Retry-After, then 200s
+func rateLimitServer(t *testing.T, limited int32, status int) *httptest.Server {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= limited {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func doRequests(t *testing.T, client *http.Client, url string, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		resp, err := client.Get(url)
+		if err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+		_ = resp.Body.Close()
+	}
+}
+
+func TestTransport_RateLimitsReduceConcurrency(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+	}{
+		{name: "429", status: http.StatusTooManyRequests},
+		{name: "secondary rate limit 403", status: http.StatusForbidden},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := rateLimitServer(t, 4, tc.status)
+			throttle := New(8, nil)
+			client := &http.Client{Transport: throttle.Transport(nil)}
+
+			doRequests(t, client, server.URL, 4)
+			if got := throttle.Limit(); got != 2 {
+				t.Fatalf("after 4 rate-limited responses got limit %d, want 2", got)
+			}
+
+			doRequests(t, client, server.URL, restoreAfter)
+			if got := throttle.Limit(); got != 3 {
+				t.Errorf("after %d successes got limit %d, want 3", restoreAfter, got)
+			}
+		})
+	}
+}
+
+func TestThrottle_SeparatedRateLimitsKeepConcurrency(t *testing.T) {
+	throttle := New(4, nil)
+	throttle.RateLimited()
+	throttle.Succeeded()
+	throttle.RateLimited()
+
+	if got := throttle.Limit(); got != 4 {
+		t.Errorf("got limit %d, want 4 when rate limits are separated by a success", got)
+	}
+}
+
+func TestTransport_PlainForbiddenIsNotRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	throttle := New(4, nil)
+	doRequests(t, &http.Client{Transport: throttle.Transport(nil)}, server.URL, 4)
+
+	if got := throttle.Limit(); got != 4 {
+		t.Errorf("got limit %d, want 4 for authorization 403s", got)
+	}
+}
+
+func TestThrottle_AcquireHonorsReducedLimit(t *testing.T) {
+	throttle := New(4, nil)
+	for i := 0; i < 4; i++ {
+		throttle.RateLimited()
+	}
+	if got := throttle.Limit(); got != 1 {
+		t.Fatalf("got limit %d, want 1", got)
+	}
+
+	ctx := context.Background()
+	if err := throttle.Acquire(ctx); err != nil {
+		t.Fatalf("first Acquire: %v", err)
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		if err := throttle.Acquire(ctx); err == nil {
+			close(acquired)
+		}
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("second Acquire succeeded while the only permit was held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	throttle.Release()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("second Acquire did not succeed after Release")
+	}
+	throttle.Release()
+}
+
+func TestThrottle_AcquireCanceled(t *testing.T) {
+	throttle := New(1, nil)
+	if err := throttle.Acquire(context.Background()); err != nil {
+		t.Fatalf("first Acquire: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := throttle.Acquire(ctx); err != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}